@@ -291,7 +291,10 @@ func (ht *HeadTracker[HTH, S, ID, BLOCK_HASH]) backfillLoop() {
 					break
 				}
 				{
-					err := ht.Backfill(ctx, head, uint(ht.config.FinalityDepth()))
+					depth, err := ht.backfillDepth(ctx, head)
+					if err == nil {
+						err = ht.Backfill(ctx, head, depth)
+					}
 					if err != nil {
 						ht.log.Warnw("Unexpected error while backfilling heads", "err", err)
 					} else if ctx.Err() != nil {
@@ -303,6 +306,24 @@ func (ht *HeadTracker[HTH, S, ID, BLOCK_HASH]) backfillLoop() {
 	}
 }
 
+// backfillDepth returns the number of heads to keep behind the given head. With the finality
+// tag enabled this reaches back to the latest finalized block, otherwise it is FinalityDepth.
+func (ht *HeadTracker[HTH, S, ID, BLOCK_HASH]) backfillDepth(ctx context.Context, head HTH) (uint, error) {
+	if !ht.config.FinalityTagEnabled() {
+		return uint(ht.config.FinalityDepth()), nil
+	}
+	finalized, err := ht.client.LatestFinalizedBlock(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to fetch latest finalized block")
+	} else if !finalized.IsValid() {
+		return 0, errors.New("got nil finalized block")
+	}
+	if finalized.BlockNumber() >= head.BlockNumber() {
+		return 1, nil
+	}
+	return uint(head.BlockNumber()-finalized.BlockNumber()) + 1, nil
+}
+
 // backfill fetches all missing heads up until the base height
 func (ht *HeadTracker[HTH, S, ID, BLOCK_HASH]) backfill(ctx context.Context, head types.Head[BLOCK_HASH], baseHeight int64) (err error) {
 	headBlockNumber := head.BlockNumber()
@@ -9,6 +9,8 @@ import (
 
 type Client[H types.Head[BLOCK_HASH], S types.Subscription, ID types.ID, BLOCK_HASH types.Hashable] interface {
 	HeadByNumber(ctx context.Context, number *big.Int) (head H, err error)
+	// LatestFinalizedBlock returns the latest block tagged as finalized by the RPC
+	LatestFinalizedBlock(ctx context.Context) (head H, err error)
 	// ConfiguredChainID returns the chain ID that the node is configured to connect to
 	ConfiguredChainID() (id ID)
 	// SubscribeNewHead is the method in which the client receives new Head.
@@ -915,7 +915,19 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Ens
 	} else {
 		ec.nConsecutiveBlocksChainTooShort = 0
 	}
-	etxs, err := ec.txStore.FindTransactionsConfirmedInBlockRange(head.BlockNumber(), head.EarliestHeadInChain().BlockNumber(), ec.chainID)
+	lowBlockNumber := head.EarliestHeadInChain().BlockNumber()
+	if ec.chainConfig.FinalityTagEnabled() {
+		// Transactions confirmed in finalized blocks can't be re-org'd out, so
+		// there is no need to keep checking their receipts.
+		finalizedBlockNumber, err := ec.client.LatestFinalizedBlockNumber(ctx)
+		if err != nil {
+			return errors.Wrap(err, "failed to fetch latest finalized block")
+		}
+		if finalizedBlockNumber >= lowBlockNumber {
+			lowBlockNumber = finalizedBlockNumber + 1
+		}
+	}
+	etxs, err := ec.txStore.FindTransactionsConfirmedInBlockRange(head.BlockNumber(), lowBlockNumber, ec.chainID)
 	if err != nil {
 		return errors.Wrap(err, "findTransactionsConfirmedInBlockRange failed")
 	}
@@ -1045,7 +1057,7 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) For
 			if overrideGasLimit != 0 {
 				etx.FeeLimit = overrideGasLimit
 			}
-			attempt, _, err := ec.NewCustomTxAttempt(*etx, fee, etx.FeeLimit, 0x0, ec.lggr)
+			attempt, _, err := ec.NewCustomTxAttempt(context.TODO(), *etx, fee, etx.FeeLimit, 0x0, ec.lggr)
 			if err != nil {
 				ec.lggr.Errorw("ForceRebroadcast: failed to create new attempt", "ethTxID", etx.ID, "err", err)
 				continue
@@ -2,6 +2,7 @@ package txmgr
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"math/big"
 	"sync"
@@ -10,6 +11,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"golang.org/x/exp/maps"
+	"gopkg.in/guregu/null.v4"
 
 	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
 	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
@@ -47,6 +49,7 @@ type TxManager[
 	RegisterResumeCallback(fn ResumeCallback)
 	SendNativeToken(chainID CHAIN_ID, from, to ADDR, value big.Int, gasLimit uint32) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
 	Reset(f func(), addr ADDR, abandon bool) error
+	Cancel(ctx context.Context, id int64) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
 }
 
 type reset struct {
@@ -218,6 +221,77 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Reset(call
 	return err
 }
 
+// Cancel stops the transaction with the given ID from being sent or mined as is:
+// - unstarted transactions are marked fatally errored
+// - unconfirmed transactions are replaced by a zero value transfer to self with the same sequence and a bumped fee,
+// which the Confirmer broadcasts on the next head
+// Like Reset, the Broadcaster and Confirmer are stopped while the transaction is updated.
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Cancel(ctx context.Context, id int64) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
+	ok := b.IfStarted(func() {
+		done := make(chan error)
+		f := func() {
+			etx, err = b.cancel(ctx, id)
+		}
+
+		b.reset <- reset{f, done}
+		if resetErr := <-done; resetErr != nil {
+			err = resetErr
+		}
+	})
+	if !ok {
+		return etx, errors.New("not started")
+	}
+	return etx, err
+}
+
+// cancel must not be run while Broadcaster or Confirmer are running
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) cancel(ctx context.Context, id int64) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
+	etx, err = b.txStore.FindTxWithAttempts(id)
+	if err != nil {
+		return etx, errors.Wrapf(err, "failed to find transaction %d", id)
+	}
+	if etx.ChainID.String() != b.chainID.String() {
+		return etx, errors.Errorf("transaction %d is for chain %s, not %s", id, etx.ChainID.String(), b.chainID.String())
+	}
+	lggr := b.logger.With("txID", etx.ID, "fromAddress", etx.FromAddress, "state", etx.State)
+
+	switch etx.State {
+	case TxUnstarted:
+		etx.Error = null.StringFrom("cancelled")
+		if err = b.txStore.UpdateTxUnstartedToFatalError(&etx); err != nil {
+			return etx, errors.Wrap(err, "failed to cancel unstarted transaction")
+		}
+	case TxUnconfirmed:
+		if len(etx.TxAttempts) == 0 {
+			return etx, errors.Errorf("expected unconfirmed transaction %d to have at least one attempt", id)
+		}
+		etx.ToAddress = etx.FromAddress
+		etx.Value = big.Int{}
+		etx.EncodedPayload = []byte{}
+		attempt, fee, feeLimit, _, bumpErr := b.txAttemptBuilder.NewBumpTxAttempt(ctx, etx, etx.TxAttempts[0], etx.TxAttempts, lggr)
+		if bumpErr != nil {
+			return etx, errors.Wrap(bumpErr, "failed to create replacement attempt")
+		}
+		if err = b.txStore.UpdateTxForCancel(&etx, &attempt); err != nil {
+			return etx, errors.Wrap(err, "failed to save replacement attempt")
+		}
+		lggr = lggr.With("sequence", etx.Sequence, "txHash", attempt.Hash, "fee", fee.String(), "feeLimit", feeLimit)
+	default:
+		return etx, errors.Errorf("transaction %d cannot be cancelled in state %s", id, etx.State)
+	}
+
+	if etx.PipelineTaskRunID.Valid && b.resumeCallback != nil {
+		err = b.resumeCallback(etx.PipelineTaskRunID.UUID, nil, errors.Errorf("transaction %d was cancelled", id))
+		if errors.Is(err, sql.ErrNoRows) {
+			lggr.Debugw("Callback missing or already resumed")
+		} else if err != nil {
+			return etx, errors.Wrap(err, "failed to resume pipeline")
+		}
+	}
+	lggr.Infow("Cancelled transaction")
+	return etx, nil
+}
+
 // abandon, scoped to the key of this txm:
 // - marks all pending and inflight transactions fatally errored (note: at this point all transactions are either confirmed or fatally errored)
 // this must not be run while Broadcaster or Confirmer are running
@@ -535,6 +609,11 @@ func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Res
 	return nil
 }
 
+// Cancel does nothing, null functionality
+func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Cancel(ctx context.Context, id int64) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
+	return etx, errors.New(n.ErrMsg)
+}
+
 // SendNativeToken does nothing, null functionality
 func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) SendNativeToken(chainID CHAIN_ID, from, to ADDR, value big.Int, gasLimit uint32) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
 	return etx, errors.New(n.ErrMsg)
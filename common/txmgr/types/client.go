@@ -30,6 +30,8 @@ type TxmClient[
 		ctx context.Context,
 		attempts []TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE],
 	) (txReceipt []R, txErr []error, err error)
+	// LatestFinalizedBlockNumber is used by the confirmer on chains with the finality tag enabled
+	LatestFinalizedBlockNumber(ctx context.Context) (int64, error)
 }
 
 // TransactionClient contains the methods for building, simulating, broadcasting transactions
@@ -58,7 +60,7 @@ type TransactionClient[
 	) (clienttypes.SendTxReturnCode, error)
 	SendEmptyTransaction(
 		ctx context.Context,
-		newTxAttempt func(seq SEQ, feeLimit uint32, fee FEE, fromAddress ADDR) (attempt TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error),
+		newTxAttempt func(ctx context.Context, seq SEQ, feeLimit uint32, fee FEE, fromAddress ADDR) (attempt TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error),
 		seq SEQ,
 		gasLimit uint32,
 		fee FEE,
@@ -52,6 +52,9 @@ type Client interface {
 	// correct hash from the RPC response.
 	HeadByNumber(ctx context.Context, n *big.Int) (*evmtypes.Head, error)
 	HeadByHash(ctx context.Context, n common.Hash) (*evmtypes.Head, error)
+	// LatestFinalizedBlock returns the latest block tagged as finalized by the RPC.
+	// Only usable on chains that support the finalized block tag.
+	LatestFinalizedBlock(ctx context.Context) (*evmtypes.Head, error)
 	SubscribeNewHead(ctx context.Context, ch chan<- *evmtypes.Head) (ethereum.Subscription, error)
 
 	SendTransactionReturnCode(ctx context.Context, tx *types.Transaction, fromAddress common.Address) (clienttypes.SendTxReturnCode, error)
@@ -263,8 +266,15 @@ func (client *client) LatestBlockHeight(ctx context.Context) (*big.Int, error) {
 }
 
 func (client *client) HeadByNumber(ctx context.Context, number *big.Int) (head *evmtypes.Head, err error) {
-	hex := ToBlockNumArg(number)
-	err = client.pool.CallContext(ctx, &head, "eth_getBlockByNumber", hex, false)
+	return client.headByNumber(ctx, ToBlockNumArg(number))
+}
+
+func (client *client) LatestFinalizedBlock(ctx context.Context) (head *evmtypes.Head, err error) {
+	return client.headByNumber(ctx, rpc.FinalizedBlockNumber.String())
+}
+
+func (client *client) headByNumber(ctx context.Context, number string) (head *evmtypes.Head, err error) {
+	err = client.pool.CallContext(ctx, &head, "eth_getBlockByNumber", number, false)
 	if err != nil {
 		return nil, err
 	}
@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"math/big"
+	"time"
 
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
@@ -134,6 +135,10 @@ func (e *erroringNode) Order() int32 {
 	return 100
 }
 
+func (e *erroringNode) RPCStats() (time.Duration, float64) {
+	return 0, 1
+}
+
 func (e *erroringNode) DeclareOutOfSync()            {}
 func (e *erroringNode) DeclareInSync()               {}
 func (e *erroringNode) DeclareUnreachable()          {}
@@ -70,3 +70,11 @@ const HeadResult = `{"difficulty":"0xf3a00","extraData":"0xd88301050384676574688
 func IsDialed(s SendOnlyNode) bool {
 	return s.(*sendOnlyNode).dialed
 }
+
+func SetReselectInterval(p *Pool, interval time.Duration) {
+	p.reselectInterval = interval
+}
+
+func SelectNode(p *Pool) Node {
+	return p.selectNode()
+}
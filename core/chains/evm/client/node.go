@@ -94,6 +94,8 @@ type Node interface {
 	Name() string
 	ChainID() *big.Int
 	Order() int32
+	// RPCStats returns the rolling average duration of successful RPC calls and the rolling rate (0-1) of failed RPC calls.
+	RPCStats() (latency time.Duration, errorRate float64)
 
 	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
 	BatchCallContext(ctx context.Context, b []rpc.BatchElem) error
@@ -149,6 +151,12 @@ type node struct {
 	stateLatestBlockNumber     int64
 	stateLatestTotalDifficulty *utils.Big
 
+	statsMu sync.RWMutex // protects stats* fields
+	// Rolling averages of RPC call duration and failure rate, used by the LatencyWeighted node selector
+	statsLatency   time.Duration
+	statsErrorRate float64
+	statsSampled   bool
+
 	// Need to track subscriptions because closing the RPC does not (always?)
 	// close the underlying subscription
 	subs []ethereum.Subscription
@@ -1021,6 +1029,44 @@ func (n *node) logResult(
 		Observe(float64(callDuration))
 }
 
+// rpcStatsDecay is the weight given to the latest sample in the rolling RPC stats
+const rpcStatsDecay = 0.1
+
+// recordRPCStats folds the result of a single latency probe into the rolling latency and error rate.
+// Only the poll RPC is recorded, so that every node is measured on the same call regardless of
+// whether it is currently serving traffic. Only successful probes count towards latency, since
+// failures can return arbitrarily fast or slow.
+func (n *node) recordRPCStats(err error, callDuration time.Duration) {
+	n.statsMu.Lock()
+	defer n.statsMu.Unlock()
+	failed := 0.0
+	if err != nil {
+		failed = 1.0
+	}
+	if !n.statsSampled {
+		n.statsSampled = true
+		n.statsErrorRate = failed
+		if err == nil {
+			n.statsLatency = callDuration
+		}
+		return
+	}
+	n.statsErrorRate = rpcStatsDecay*failed + (1-rpcStatsDecay)*n.statsErrorRate
+	if err == nil {
+		if n.statsLatency == 0 {
+			n.statsLatency = callDuration
+		} else {
+			n.statsLatency = time.Duration(rpcStatsDecay*float64(callDuration) + (1-rpcStatsDecay)*float64(n.statsLatency))
+		}
+	}
+}
+
+func (n *node) RPCStats() (latency time.Duration, errorRate float64) {
+	n.statsMu.RLock()
+	defer n.statsMu.RUnlock()
+	return n.statsLatency, n.statsErrorRate
+}
+
 func (n *node) wrapWS(err error) error {
 	err = wrap(err, fmt.Sprintf("primary websocket (%s)", n.ws.uri.Redacted()))
 	return err
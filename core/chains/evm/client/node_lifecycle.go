@@ -49,6 +49,23 @@ func zombieNodeCheckInterval(noNewHeadsThreshold time.Duration) time.Duration {
 	return utils.WithJitter(interval)
 }
 
+// latencyProbeInterval is how often an alive node is probed for latency when
+// polling is disabled but the LatencyWeighted selection mode needs samples.
+const latencyProbeInterval = 10 * time.Second
+
+// probeVersion calls web3_clientVersion, the same probe on every node, and
+// records its latency and result in the node's rolling RPC stats.
+func (n *node) probeVersion(timeout time.Duration) (version string, err error) {
+	ctx, cancel := context.WithTimeout(n.nodeCtx, timeout)
+	defer cancel()
+	ctx, cancel2 := n.makeQueryCtx(ctx)
+	defer cancel2()
+	start := time.Now()
+	err = n.CallContext(ctx, &version, "web3_clientVersion")
+	n.recordRPCStats(err, time.Since(start))
+	return
+}
+
 func (n *node) setLatestReceived(blockNumber int64, totalDifficulty *utils.Big) {
 	n.stateMu.Lock()
 	defer n.stateMu.Unlock()
@@ -126,6 +143,15 @@ func (n *node) aliveLoop() {
 		lggr.Debug("Polling disabled")
 	}
 
+	// LatencyWeighted ranks nodes on the latency of the poll RPC, so keep probing even with polling disabled
+	var probeCh <-chan time.Time
+	if pollInterval == 0 && n.nodePoolCfg.SelectionMode() == NodeSelectionMode_LatencyWeighted {
+		lggr.Debug("Latency probing enabled")
+		probeT := time.NewTicker(latencyProbeInterval)
+		defer probeT.Stop()
+		probeCh = probeT.C
+	}
+
 	_, highestReceivedBlockNumber, _ := n.StateAndLatest()
 	var pollFailures uint32
 
@@ -134,14 +160,9 @@ func (n *node) aliveLoop() {
 		case <-n.nodeCtx.Done():
 			return
 		case <-pollCh:
-			var version string
 			promEVMPoolRPCNodePolls.WithLabelValues(n.chainID.String(), n.name).Inc()
 			lggr.Tracew("Polling for version", "nodeState", n.State(), "pollFailures", pollFailures)
-			ctx, cancel := context.WithTimeout(n.nodeCtx, pollInterval)
-			ctx, cancel2 := n.makeQueryCtx(ctx)
-			err := n.CallContext(ctx, &version, "web3_clientVersion")
-			cancel2()
-			cancel()
+			version, err := n.probeVersion(pollInterval)
 			if err != nil {
 				// prevent overflow
 				if pollFailures < math.MaxUint32 {
@@ -176,6 +197,10 @@ func (n *node) aliveLoop() {
 				n.declareOutOfSync(n.isOutOfSync)
 				return
 			}
+		case <-probeCh:
+			if _, err := n.probeVersion(latencyProbeInterval); err != nil {
+				lggr.Debugw("Latency probe failed", "err", err, "nodeState", n.State())
+			}
 		case bh, open := <-headsC:
 			if !open {
 				lggr.Errorw("Subscription channel unexpectedly closed", "nodeState", n.State())
@@ -238,7 +263,7 @@ func (n *node) syncStatus(num int64, td *utils.Big) (outOfSync bool, liveNodes i
 	ln, highest, greatest := n.nLiveNodes()
 	mode := n.nodePoolCfg.SelectionMode()
 	switch mode {
-	case NodeSelectionMode_HighestHead, NodeSelectionMode_RoundRobin, NodeSelectionMode_PriorityLevel:
+	case NodeSelectionMode_HighestHead, NodeSelectionMode_RoundRobin, NodeSelectionMode_PriorityLevel, NodeSelectionMode_LatencyWeighted:
 		return num < highest-int64(threshold), ln
 	case NodeSelectionMode_TotalDifficulty:
 		bigThreshold := utils.NewBigI(int64(threshold))
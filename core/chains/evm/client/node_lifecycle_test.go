@@ -505,6 +505,40 @@ func TestUnit_NodeLifecycle_aliveLoop(t *testing.T) {
 		assert.Equal(t, int64(stall), num)
 
 	})
+
+	t.Run("records RPC stats from the poll only", func(t *testing.T) {
+		cfg := TestNodePoolConfig{NodePollInterval: testutils.TestInterval, NodeSelectionMode: NodeSelectionMode_LatencyWeighted}
+		n := newTestNodeWithCallback(t, cfg, 0*time.Second, func(method string, _ gjson.Result) (resp testutils.JSONRPCResponse) {
+			switch method {
+			case "eth_subscribe":
+				resp.Result = `"0x00"`
+				resp.Notify = makeHeadResult(0)
+			case "web3_clientVersion":
+				resp.Result = `"test client version"`
+			case "eth_chainId":
+				resp.Result = `"0x1"`
+			}
+			return
+		})
+		dial(t, n)
+		defer func() { assert.NoError(t, n.Close()) }()
+
+		var chainID string
+		require.NoError(t, n.CallContext(testutils.Context(t), &chainID, "eth_chainId"))
+		latency, errorRate := n.RPCStats()
+		assert.Zero(t, latency)
+		assert.Zero(t, errorRate)
+
+		n.wg.Add(1)
+		go n.aliveLoop()
+
+		testutils.AssertEventually(t, func() bool {
+			latency, _ := n.RPCStats()
+			return latency > 0
+		})
+		_, errorRate = n.RPCStats()
+		assert.Zero(t, errorRate)
+	})
 }
 
 func TestUnit_NodeLifecycle_outOfSyncLoop(t *testing.T) {
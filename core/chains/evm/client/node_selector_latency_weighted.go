@@ -0,0 +1,94 @@
+package client
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	// latencyWeightedErrorPenalty is added to a node's latency score for an error rate of 1, so that
+	// failing nodes are penalized even when they have no latency samples
+	latencyWeightedErrorPenalty = time.Second
+	// latencyWeightedUnsampledLatency is the latency assumed for a node that has no successful probe yet
+	latencyWeightedUnsampledLatency = time.Second
+	// latencyWeightedSwitchThreshold is the fraction by which another node must beat the current node's
+	// score before switching to it, to avoid flapping between nodes with similar performance
+	latencyWeightedSwitchThreshold = 0.2
+)
+
+type latencyWeightedNodeSelector struct {
+	nodes []Node
+
+	mu      sync.Mutex
+	current Node
+}
+
+// NewLatencyWeightedNodeSelector returns a NodeSelector that prefers the alive node with the lowest
+// rolling latency of the poll RPC, penalized by its rolling error rate. Nodes without any successful
+// probe yet are scored with a fixed latency of latencyWeightedUnsampledLatency.
+func NewLatencyWeightedNodeSelector(nodes []Node) NodeSelector {
+	return &latencyWeightedNodeSelector{
+		nodes: nodes,
+	}
+}
+
+func (s *latencyWeightedNodeSelector) Select() Node {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	type nodeStats struct {
+		node      Node
+		latency   time.Duration
+		errorRate float64
+	}
+	var alive []nodeStats
+	for _, n := range s.nodes {
+		if n.State() != NodeStateAlive {
+			continue
+		}
+		latency, errorRate := n.RPCStats()
+		alive = append(alive, nodeStats{n, latency, errorRate})
+	}
+
+	var best Node
+	bestScore := math.MaxFloat64
+	currentScore := math.MaxFloat64
+	for _, a := range alive {
+		n := a.node
+		latency := a.latency
+		if latency == 0 {
+			// Not sampled yet, or all its probes failed
+			latency = latencyWeightedUnsampledLatency
+		}
+		score := latencyWeightedScore(latency, a.errorRate)
+		if n == s.current {
+			currentScore = score
+		}
+		// Ties are broken by Order, same as HighestHead
+		if best == nil || score < bestScore || (score == bestScore && n.Order() < best.Order()) {
+			best = n
+			bestScore = score
+		}
+	}
+
+	if best == nil {
+		s.current = nil
+		return nil
+	}
+	// Stick to the current node unless the best one is meaningfully better
+	if currentScore != math.MaxFloat64 && bestScore >= currentScore*(1-latencyWeightedSwitchThreshold) {
+		return s.current
+	}
+	s.current = best
+	return best
+}
+
+func (s *latencyWeightedNodeSelector) Name() string {
+	return NodeSelectionMode_LatencyWeighted
+}
+
+// latencyWeightedScore returns the node's rolling latency plus the penalty of its error rate. Lower is better.
+func latencyWeightedScore(latency time.Duration, errorRate float64) float64 {
+	return float64(latency) + errorRate*float64(latencyWeightedErrorPenalty)
+}
@@ -0,0 +1,160 @@
+package client_test
+
+import (
+	"testing"
+	"time"
+
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	evmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/mocks"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLatencyWeightedNodeSelectorName(t *testing.T) {
+	selector := evmclient.NewLatencyWeightedNodeSelector(nil)
+	assert.Equal(t, selector.Name(), evmclient.NodeSelectionMode_LatencyWeighted)
+}
+
+func TestLatencyWeightedNodeSelector(t *testing.T) {
+	t.Parallel()
+
+	var nodes []evmclient.Node
+	secondLatency := 200 * time.Millisecond
+
+	for i := 0; i < 3; i++ {
+		node := evmmocks.NewNode(t)
+		if i == 0 {
+			// first node is out of sync
+			node.On("State").Return(evmclient.NodeStateOutOfSync)
+		} else if i == 1 {
+			// second node is alive, 200ms
+			node.On("State").Return(evmclient.NodeStateAlive)
+			node.On("RPCStats").Return(func() time.Duration { return secondLatency }, float64(0))
+		} else {
+			// third node is alive, 100ms (best node)
+			node.On("State").Return(evmclient.NodeStateAlive)
+			node.On("RPCStats").Return(100*time.Millisecond, float64(0))
+		}
+		node.On("Order").Maybe().Return(int32(1))
+		nodes = append(nodes, node)
+	}
+
+	selector := evmclient.NewLatencyWeightedNodeSelector(nodes)
+	assert.Same(t, nodes[2], selector.Select())
+
+	// second node becomes slightly faster than the third (not enough to switch)
+	secondLatency = 90 * time.Millisecond
+	assert.Same(t, nodes[2], selector.Select())
+	// a fresh selector has no current node and picks the fastest straight away
+	assert.Same(t, nodes[1], evmclient.NewLatencyWeightedNodeSelector(nodes).Select())
+
+	// second node becomes meaningfully faster than the third
+	secondLatency = 50 * time.Millisecond
+	assert.Same(t, nodes[1], selector.Select())
+}
+
+func TestLatencyWeightedNodeSelector_ErrorRate(t *testing.T) {
+	t.Parallel()
+
+	var nodes []evmclient.Node
+
+	for i := 0; i < 2; i++ {
+		node := evmmocks.NewNode(t)
+		node.On("State").Return(evmclient.NodeStateAlive)
+		if i == 0 {
+			// fast but failing half of the calls
+			node.On("RPCStats").Return(50*time.Millisecond, 0.5)
+		} else {
+			// slower but reliable
+			node.On("RPCStats").Return(150*time.Millisecond, float64(0))
+		}
+		node.On("Order").Maybe().Return(int32(1))
+		nodes = append(nodes, node)
+	}
+
+	selector := evmclient.NewLatencyWeightedNodeSelector(nodes)
+	assert.Same(t, nodes[1], selector.Select())
+}
+
+func TestLatencyWeightedNodeSelector_NoLatencySamples(t *testing.T) {
+	t.Parallel()
+
+	newNode := func(latency time.Duration, errorRate float64) evmclient.Node {
+		node := evmmocks.NewNode(t)
+		node.On("State").Return(evmclient.NodeStateAlive)
+		node.On("RPCStats").Return(latency, errorRate)
+		node.On("Order").Maybe().Return(int32(1))
+		return node
+	}
+
+	t.Run("all calls failing", func(t *testing.T) {
+		failing := newNode(0, 1.0)
+		healthy := newNode(300*time.Millisecond, 0)
+		selector := evmclient.NewLatencyWeightedNodeSelector([]evmclient.Node{failing, healthy})
+		assert.Same(t, healthy, selector.Select())
+	})
+
+	t.Run("not sampled yet", func(t *testing.T) {
+		unsampled := newNode(0, 0)
+		fast := newNode(100*time.Millisecond, 0)
+		slow := newNode(300*time.Millisecond, 0)
+		selector := evmclient.NewLatencyWeightedNodeSelector([]evmclient.Node{unsampled, slow, fast})
+		assert.Same(t, fast, selector.Select())
+	})
+
+	t.Run("not sampled yet, slower than the default", func(t *testing.T) {
+		unsampled := newNode(0, 0)
+		verySlow := newNode(2*time.Second, 0)
+		selector := evmclient.NewLatencyWeightedNodeSelector([]evmclient.Node{verySlow, unsampled})
+		assert.Same(t, unsampled, selector.Select())
+	})
+
+	t.Run("none sampled yet", func(t *testing.T) {
+		first := newNode(0, 0)
+		second := newNode(0, 0)
+		selector := evmclient.NewLatencyWeightedNodeSelector([]evmclient.Node{first, second})
+		assert.Same(t, first, selector.Select())
+	})
+}
+
+func TestLatencyWeightedNodeSelector_None(t *testing.T) {
+	t.Parallel()
+
+	var nodes []evmclient.Node
+
+	for i := 0; i < 3; i++ {
+		node := evmmocks.NewNode(t)
+		if i == 0 {
+			// first node is out of sync
+			node.On("State").Return(evmclient.NodeStateOutOfSync)
+		} else {
+			// others are unreachable
+			node.On("State").Return(evmclient.NodeStateUnreachable)
+		}
+		nodes = append(nodes, node)
+	}
+
+	selector := evmclient.NewLatencyWeightedNodeSelector(nodes)
+	assert.Nil(t, selector.Select())
+}
+
+func TestLatencyWeightedNodeSelector_Failover(t *testing.T) {
+	t.Parallel()
+
+	state := evmclient.NodeStateAlive
+	fast := evmmocks.NewNode(t)
+	fast.On("State").Return(func() evmclient.NodeState { return state })
+	fast.On("RPCStats").Maybe().Return(50*time.Millisecond, float64(0))
+	fast.On("Order").Maybe().Return(int32(1))
+
+	slow := evmmocks.NewNode(t)
+	slow.On("State").Return(evmclient.NodeStateAlive)
+	slow.On("RPCStats").Return(500*time.Millisecond, float64(0))
+	slow.On("Order").Maybe().Return(int32(1))
+
+	selector := evmclient.NewLatencyWeightedNodeSelector([]evmclient.Node{fast, slow})
+	assert.Same(t, fast, selector.Select())
+
+	state = evmclient.NodeStateUnreachable
+	assert.Same(t, slow, selector.Select())
+}
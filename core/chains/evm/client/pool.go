@@ -36,8 +36,13 @@ const (
 	NodeSelectionMode_RoundRobin      = "RoundRobin"
 	NodeSelectionMode_TotalDifficulty = "TotalDifficulty"
 	NodeSelectionMode_PriorityLevel   = "PriorityLevel"
+	NodeSelectionMode_LatencyWeighted = "LatencyWeighted"
 )
 
+// latencyWeightedReselectInterval controls how often the pool re-runs node selection when using
+// NodeSelectionMode_LatencyWeighted, so that it can move to a faster node while the active one is still alive
+const latencyWeightedReselectInterval = 15 * time.Second
+
 // NodeSelector represents a strategy to select the next node from the pool.
 type NodeSelector interface {
 	// Select returns a Node, or nil if none can be selected.
@@ -65,6 +70,7 @@ type Pool struct {
 	selectionMode       string
 	noNewHeadsThreshold time.Duration
 	nodeSelector        NodeSelector
+	reselectInterval    time.Duration
 
 	activeMu   sync.RWMutex
 	activeNode Node
@@ -88,6 +94,8 @@ func NewPool(logger logger.Logger, selectionMode string, noNewHeadsTreshold time
 			return NewTotalDifficultyNodeSelector(nodes)
 		case NodeSelectionMode_PriorityLevel:
 			return NewPriorityLevelNodeSelector(nodes)
+		case NodeSelectionMode_LatencyWeighted:
+			return NewLatencyWeightedNodeSelector(nodes)
 		default:
 			panic(fmt.Sprintf("unsupported NodeSelectionMode: %s", selectionMode))
 		}
@@ -106,6 +114,9 @@ func NewPool(logger logger.Logger, selectionMode string, noNewHeadsTreshold time
 		nodeSelector:        nodeSelector,
 		chStop:              make(chan struct{}),
 	}
+	if selectionMode == NodeSelectionMode_LatencyWeighted {
+		p.reselectInterval = latencyWeightedReselectInterval
+	}
 
 	p.logger.Debugf("The pool is configured to use NodeSelectionMode: %s", selectionMode)
 
@@ -183,10 +194,20 @@ func (p *Pool) runLoop() {
 	monitor := time.NewTicker(utils.WithJitter(reportInterval))
 	defer monitor.Stop()
 
+	// Only selectors that may prefer another alive node need periodic reselection
+	var reselect <-chan time.Time
+	if p.reselectInterval > 0 {
+		reselectTicker := time.NewTicker(utils.WithJitter(p.reselectInterval))
+		defer reselectTicker.Stop()
+		reselect = reselectTicker.C
+	}
+
 	for {
 		select {
 		case <-monitor.C:
 			p.report()
+		case <-reselect:
+			p.reselectNode()
 		case <-p.chStop:
 			return
 		}
@@ -274,6 +295,23 @@ func (p *Pool) selectNode() (node Node) {
 	return p.activeNode
 }
 
+// reselectNode re-runs the NodeSelector even if the active Node is still alive. The selector is responsible
+// for not switching between nodes of similar quality.
+func (p *Pool) reselectNode() {
+	p.activeMu.Lock()
+	defer p.activeMu.Unlock()
+
+	node := p.nodeSelector.Select()
+	if node == nil {
+		// Leave it to selectNode to report that no nodes are available
+		return
+	}
+	if node != p.activeNode {
+		p.logger.Debugw("Switching active RPC node", "NodeSelectionMode", p.nodeSelector.Name(), "node", node.String())
+	}
+	p.activeNode = node
+}
+
 func (p *Pool) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
 	return p.selectNode().CallContext(ctx, result, method, args...)
 }
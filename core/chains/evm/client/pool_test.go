@@ -2,9 +2,11 @@ package client_test
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 	"net/http/httptest"
 	"net/url"
+	"sync"
 	"testing"
 	"time"
 
@@ -330,3 +332,51 @@ func TestUnit_Pool_BatchCallContextAll(t *testing.T) {
 	assert.False(t, p.ChainType().IsL2())
 	require.NoError(t, p.BatchCallContextAll(ctx, b))
 }
+
+func TestUnit_Pool_LatencyWeightedReselection(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	n1Latency := 100 * time.Millisecond
+	n1 := evmmocks.NewNode(t)
+	n2 := evmmocks.NewNode(t)
+	nodes := []evmclient.Node{n1, n2}
+
+	for i, n := range []*evmmocks.Node{n1, n2} {
+		n.On("String").Maybe().Return(fmt.Sprintf("n%d", i+1))
+		n.On("Start", mock.Anything).Return(nil).Once()
+		n.On("Close").Maybe().Return(nil)
+		n.On("ChainID").Return(testutils.FixtureChainID).Once()
+		n.On("Order").Maybe().Return(int32(1))
+		// both nodes stay alive throughout
+		n.On("State").Return(evmclient.NodeStateAlive)
+	}
+	n1.On("RPCStats").Return(func() time.Duration {
+		mu.Lock()
+		defer mu.Unlock()
+		return n1Latency
+	}, float64(0))
+	n2.On("RPCStats").Return(200*time.Millisecond, float64(0))
+
+	p := evmclient.NewPool(logger.TestLogger(t), evmclient.NodeSelectionMode_LatencyWeighted, time.Second*0, nodes, []evmclient.SendOnlyNode{}, &cltest.FixtureChainID, "")
+	evmclient.SetReselectInterval(p, 10*time.Millisecond)
+	require.NoError(t, p.Dial(testutils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, p.Close()) })
+
+	assert.Same(t, n1, evmclient.SelectNode(p))
+
+	// n1 gets slightly slower than n2, which is not enough to switch
+	mu.Lock()
+	n1Latency = 220 * time.Millisecond
+	mu.Unlock()
+	time.Sleep(50 * time.Millisecond)
+	assert.Same(t, n1, evmclient.SelectNode(p))
+
+	// n1 gets meaningfully slower than n2
+	mu.Lock()
+	n1Latency = 500 * time.Millisecond
+	mu.Unlock()
+	testutils.AssertEventually(t, func() bool {
+		return evmclient.SelectNode(p) == n2
+	})
+}
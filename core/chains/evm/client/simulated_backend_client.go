@@ -246,6 +246,12 @@ func (c *SimulatedBackendClient) HeadByNumber(ctx context.Context, n *big.Int) (
 	}, nil
 }
 
+// LatestFinalizedBlock returns the latest head, since blocks committed to the
+// simulated backend can't be reorged.
+func (c *SimulatedBackendClient) LatestFinalizedBlock(ctx context.Context) (*evmtypes.Head, error) {
+	return c.HeadByNumber(ctx, nil)
+}
+
 // HeadByHash returns our own header type.
 func (c *SimulatedBackendClient) HeadByHash(ctx context.Context, h common.Hash) (*evmtypes.Head, error) {
 	header, err := c.b.HeaderByHash(ctx, h)
@@ -511,9 +517,17 @@ func (c *SimulatedBackendClient) BatchCallContext(ctx context.Context, b []rpc.B
 			if !is {
 				return errors.Errorf("SimulatedBackendClient expected second arg to be a boolean for eth_getBlockByNumber, got: %T", elem.Args[1])
 			}
-			n, ok := new(big.Int).SetString(blockNum, 0)
-			if !ok {
-				return errors.Errorf("error while converting block number string: %s to big.Int ", blockNum)
+			var n *big.Int
+			switch blockNum {
+			case "latest", "finalized":
+				// Blocks committed to the simulated backend are final immediately.
+				n = c.currentBlockNumber()
+			default:
+				var ok bool
+				n, ok = new(big.Int).SetString(blockNum, 0)
+				if !ok {
+					return errors.Errorf("error while converting block number string: %s to big.Int ", blockNum)
+				}
 			}
 			header, err := c.b.HeaderByNumber(ctx, n)
 			if err != nil {
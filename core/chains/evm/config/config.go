@@ -2,6 +2,7 @@ package config
 
 import (
 	"math/big"
+	"net/url"
 	"time"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
@@ -26,7 +27,9 @@ type EVM interface {
 	ChainID() *big.Int
 	ChainType() config.ChainType
 	FinalityDepth() uint32
+	FinalityTagEnabled() bool
 	FlagsContractAddress() string
+	KeySpecificExternalSignerURLs() map[gethcommon.Address]*url.URL
 	KeySpecificMaxGasPriceWei(addr gethcommon.Address) *assets.Wei
 	LinkContractAddress() string
 	LogBackfillBatchSize() uint32
@@ -63,6 +66,13 @@ type HeadTracker interface {
 
 type BalanceMonitor interface {
 	Enabled() bool
+	TreasuryAddress() *gethcommon.Address
+	MinBalance() *assets.Wei
+	TargetBalance() *assets.Wei
+	// KeySpecificMinBalance returns the MinBalance for addr, which may be overridden per key
+	KeySpecificMinBalance(addr gethcommon.Address) *assets.Wei
+	// KeySpecificTargetBalance returns the TargetBalance for addr, which may be overridden per key
+	KeySpecificTargetBalance(addr gethcommon.Address) *assets.Wei
 }
 
 type Transactions interface {
@@ -202,6 +202,35 @@ func TestChainScopedConfig(t *testing.T) {
 		})
 	})
 
+	t.Run("BalanceMonitor().KeySpecificMinBalance() and KeySpecificTargetBalance()", func(t *testing.T) {
+		addr, unsetAddr, minOnlyAddr := testutils.NewAddress(), testutils.NewAddress(), testutils.NewAddress()
+		gcfg3 := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+			c.EVM[0].BalanceMonitor.MinBalance = assets.NewWeiI(100)
+			c.EVM[0].BalanceMonitor.TargetBalance = assets.NewWeiI(500)
+			c.EVM[0].KeySpecific = v2.KeySpecificConfig{
+				{Key: ptr(ethkey.EIP55AddressFromAddress(addr)),
+					BalanceMonitor: v2.KeySpecificBalanceMonitor{
+						MinBalance:    assets.NewWeiI(1000),
+						TargetBalance: assets.NewWeiI(5000),
+					},
+				},
+				{Key: ptr(ethkey.EIP55AddressFromAddress(minOnlyAddr)),
+					BalanceMonitor: v2.KeySpecificBalanceMonitor{
+						MinBalance: assets.NewWeiI(200),
+					},
+				},
+			}
+		})
+		bm := evmtest.NewChainScopedConfig(t, gcfg3).EVM().BalanceMonitor()
+
+		assert.Equal(t, assets.NewWeiI(1000), bm.KeySpecificMinBalance(addr))
+		assert.Equal(t, assets.NewWeiI(5000), bm.KeySpecificTargetBalance(addr))
+		assert.Equal(t, assets.NewWeiI(200), bm.KeySpecificMinBalance(minOnlyAddr))
+		assert.Equal(t, assets.NewWeiI(500), bm.KeySpecificTargetBalance(minOnlyAddr))
+		assert.Equal(t, assets.NewWeiI(100), bm.KeySpecificMinBalance(unsetAddr))
+		assert.Equal(t, assets.NewWeiI(500), bm.KeySpecificTargetBalance(unsetAddr))
+	})
+
 	t.Run("LinkContractAddress", func(t *testing.T) {
 		t.Run("uses chain-specific default value when nothing is set", func(t *testing.T) {
 			assert.Equal(t, "", cfg.EVM().LinkContractAddress())
@@ -2,6 +2,7 @@ package v2
 
 import (
 	"math/big"
+	"net/url"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -51,7 +52,7 @@ type evmConfig struct {
 }
 
 func (e *evmConfig) BalanceMonitor() config.BalanceMonitor {
-	return &balanceMonitorConfig{c: e.c.BalanceMonitor}
+	return &balanceMonitorConfig{c: e.c.BalanceMonitor, keySpecific: e.c.KeySpecific}
 }
 
 func (e *evmConfig) Transactions() config.Transactions {
@@ -98,6 +99,10 @@ func (e *evmConfig) FinalityDepth() uint32 {
 	return *e.c.FinalityDepth
 }
 
+func (e *evmConfig) FinalityTagEnabled() bool {
+	return *e.c.FinalityTagEnabled
+}
+
 func (e *evmConfig) LogKeepBlocksDepth() uint32 {
 	return *e.c.LogKeepBlocksDepth
 }
@@ -143,6 +148,16 @@ func (e *evmConfig) KeySpecificMaxGasPriceWei(addr common.Address) *assets.Wei {
 	return e.GasEstimator().PriceMax()
 }
 
+func (e *evmConfig) KeySpecificExternalSignerURLs() map[common.Address]*url.URL {
+	urls := make(map[common.Address]*url.URL)
+	for _, ks := range e.c.KeySpecific {
+		if ks.Key != nil && ks.ExternalSignerURL != nil {
+			urls[ks.Key.Address()] = (*url.URL)(ks.ExternalSignerURL)
+		}
+	}
+	return urls
+}
+
 func (e *evmConfig) MinIncomingConfirmations() uint32 {
 	return *e.c.MinIncomingConfirmations
 }
@@ -1,9 +1,55 @@
 package v2
 
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/v2/core/assets"
+)
+
 type balanceMonitorConfig struct {
-	c BalanceMonitor
+	c           BalanceMonitor
+	keySpecific KeySpecificConfig
 }
 
 func (b *balanceMonitorConfig) Enabled() bool {
 	return *b.c.Enabled
 }
+
+func (b *balanceMonitorConfig) TreasuryAddress() *common.Address {
+	if b.c.TreasuryAddress == nil {
+		return nil
+	}
+	addr := b.c.TreasuryAddress.Address()
+	return &addr
+}
+
+func (b *balanceMonitorConfig) MinBalance() *assets.Wei {
+	return b.c.MinBalance
+}
+
+func (b *balanceMonitorConfig) TargetBalance() *assets.Wei {
+	return b.c.TargetBalance
+}
+
+func (b *balanceMonitorConfig) KeySpecificMinBalance(addr common.Address) *assets.Wei {
+	if ks := b.keySpecificFor(addr); ks != nil && ks.MinBalance != nil {
+		return ks.MinBalance
+	}
+	return b.c.MinBalance
+}
+
+func (b *balanceMonitorConfig) KeySpecificTargetBalance(addr common.Address) *assets.Wei {
+	if ks := b.keySpecificFor(addr); ks != nil && ks.TargetBalance != nil {
+		return ks.TargetBalance
+	}
+	return b.c.TargetBalance
+}
+
+func (b *balanceMonitorConfig) keySpecificFor(addr common.Address) *KeySpecificBalanceMonitor {
+	for i := range b.keySpecific {
+		if ks := &b.keySpecific[i]; ks.Key != nil && ks.Key.Address() == addr {
+			return &ks.BalanceMonitor
+		}
+	}
+	return nil
+}
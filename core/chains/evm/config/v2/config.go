@@ -330,6 +330,7 @@ type Chain struct {
 	BlockBackfillSkip        *bool
 	ChainType                *string
 	FinalityDepth            *uint32
+	FinalityTagEnabled       *bool
 	FlagsContractAddress     *ethkey.EIP55Address
 	LinkContractAddress      *ethkey.EIP55Address
 	LogBackfillBatchSize     *uint32
@@ -379,6 +380,22 @@ func (c *Chain) ValidateConfig() (err error) {
 		err = multierr.Append(err, v2.ErrInvalid{Name: "MinIncomingConfirmations", Value: *c.MinIncomingConfirmations,
 			Msg: "must be greater than or equal to 1"})
 	}
+	for _, ks := range c.KeySpecific {
+		if ks.BalanceMonitor.MinBalance == nil && ks.BalanceMonitor.TargetBalance == nil {
+			continue
+		}
+		minBalance, targetBalance := c.BalanceMonitor.MinBalance, c.BalanceMonitor.TargetBalance
+		if v := ks.BalanceMonitor.MinBalance; v != nil {
+			minBalance = v
+		}
+		if v := ks.BalanceMonitor.TargetBalance; v != nil {
+			targetBalance = v
+		}
+		if minBalance != nil && targetBalance != nil && targetBalance.Cmp(minBalance) <= 0 {
+			err = multierr.Append(err, v2.ErrInvalid{Name: "KeySpecific.BalanceMonitor.TargetBalance", Value: targetBalance,
+				Msg: fmt.Sprintf("must be greater than the MinBalance for key %s", ks.Key)})
+		}
+	}
 	return
 }
 
@@ -431,13 +448,42 @@ func (a *Automation) setFrom(f *Automation) {
 }
 
 type BalanceMonitor struct {
-	Enabled *bool
+	Enabled         *bool
+	TreasuryAddress *ethkey.EIP55Address
+	MinBalance      *assets.Wei
+	TargetBalance   *assets.Wei
 }
 
 func (m *BalanceMonitor) setFrom(f *BalanceMonitor) {
 	if v := f.Enabled; v != nil {
 		m.Enabled = v
 	}
+	if v := f.TreasuryAddress; v != nil {
+		m.TreasuryAddress = v
+	}
+	if v := f.MinBalance; v != nil {
+		m.MinBalance = v
+	}
+	if v := f.TargetBalance; v != nil {
+		m.TargetBalance = v
+	}
+}
+
+func (m *BalanceMonitor) ValidateConfig() (err error) {
+	if m.TreasuryAddress == nil {
+		return
+	}
+	if m.MinBalance == nil {
+		err = multierr.Append(err, v2.ErrMissing{Name: "MinBalance", Msg: "required when TreasuryAddress is set"})
+	}
+	if m.TargetBalance == nil {
+		err = multierr.Append(err, v2.ErrMissing{Name: "TargetBalance", Msg: "required when TreasuryAddress is set"})
+	}
+	if m.MinBalance != nil && m.TargetBalance != nil && m.TargetBalance.Cmp(m.MinBalance) <= 0 {
+		err = multierr.Append(err, v2.ErrInvalid{Name: "TargetBalance", Value: m.TargetBalance,
+			Msg: "must be greater than MinBalance"})
+	}
+	return
 }
 
 type GasEstimator struct {
@@ -629,13 +675,36 @@ func (ks KeySpecificConfig) ValidateConfig() (err error) {
 		} else {
 			addrs[addr] = struct{}{}
 		}
+		if u := k.ExternalSignerURL; u != nil {
+			switch u.Scheme {
+			case "http", "https":
+			default:
+				err = multierr.Append(err, v2.ErrInvalid{Name: "ExternalSignerURL", Value: u.Scheme, Msg: "must be http or https"})
+			}
+		}
 	}
 	return
 }
 
 type KeySpecific struct {
-	Key          *ethkey.EIP55Address
-	GasEstimator KeySpecificGasEstimator `toml:",omitempty"`
+	Key               *ethkey.EIP55Address
+	ExternalSignerURL *models.URL
+	BalanceMonitor    KeySpecificBalanceMonitor `toml:",omitempty"`
+	GasEstimator      KeySpecificGasEstimator   `toml:",omitempty"`
+}
+
+type KeySpecificBalanceMonitor struct {
+	MinBalance    *assets.Wei
+	TargetBalance *assets.Wei
+}
+
+func (m *KeySpecificBalanceMonitor) setFrom(f *KeySpecificBalanceMonitor) {
+	if v := f.MinBalance; v != nil {
+		m.MinBalance = v
+	}
+	if v := f.TargetBalance; v != nil {
+		m.TargetBalance = v
+	}
 }
 
 type KeySpecificGasEstimator struct {
@@ -119,6 +119,9 @@ func (c *Chain) SetFrom(f *Chain) {
 	if v := f.FinalityDepth; v != nil {
 		c.FinalityDepth = v
 	}
+	if v := f.FinalityTagEnabled; v != nil {
+		c.FinalityTagEnabled = v
+	}
 	if v := f.FlagsContractAddress; v != nil {
 		c.FlagsContractAddress = v
 	}
@@ -165,6 +168,10 @@ func (c *Chain) SetFrom(f *Chain) {
 			if i := slices.IndexFunc(c.KeySpecific, func(k KeySpecific) bool { return k.Key == v.Key }); i == -1 {
 				c.KeySpecific = append(c.KeySpecific, v)
 			} else {
+				if v.ExternalSignerURL != nil {
+					c.KeySpecific[i].ExternalSignerURL = v.ExternalSignerURL
+				}
+				c.KeySpecific[i].BalanceMonitor.setFrom(&v.BalanceMonitor)
 				c.KeySpecific[i].GasEstimator.setFrom(&v.GasEstimator)
 			}
 		}
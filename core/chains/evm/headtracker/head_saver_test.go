@@ -36,7 +36,8 @@ type config struct {
 	blockEmissionIdleWarningThreshold time.Duration
 }
 
-func (c *config) FinalityDepth() uint32 { return c.finalityDepth }
+func (c *config) FinalityDepth() uint32    { return c.finalityDepth }
+func (c *config) FinalityTagEnabled() bool { return false }
 func (c *config) BlockEmissionIdleWarningThreshold() time.Duration {
 	return c.blockEmissionIdleWarningThreshold
 }
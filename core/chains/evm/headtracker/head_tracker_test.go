@@ -410,6 +410,47 @@ func TestHeadTracker_Start_LoadsLatestChain(t *testing.T) {
 	assert.Equal(t, h.Number, int64(3))
 }
 
+func TestHeadTracker_Start_BackfillsToLatestFinalizedBlock(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	logger := logger.TestLogger(t)
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		finalityTagEnabled := true
+		c.EVM[0].FinalityTagEnabled = &finalityTagEnabled
+	})
+	config := evmtest.NewChainScopedConfig(t, cfg)
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+
+	var heads []*evmtypes.Head
+	var parentHash gethCommon.Hash
+	for i := 0; i <= 10; i++ {
+		h := cltest.Head(i)
+		if parentHash != (gethCommon.Hash{}) {
+			h.ParentHash = parentHash
+		}
+		parentHash = h.Hash
+		heads = append(heads, h)
+	}
+	ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(heads[10], nil)
+	ethClient.On("LatestFinalizedBlock", mock.Anything).Return(heads[7], nil)
+	// Only the heads after the latest finalized block are backfilled
+	for i := 7; i < 10; i++ {
+		ethClient.On("HeadByNumber", mock.Anything, big.NewInt(int64(i))).Return(heads[i], nil).Once()
+	}
+	mockEth := &evmtest.MockEth{EthClient: ethClient}
+	ethClient.On("SubscribeNewHead", mock.Anything, mock.Anything).Return(mockEth.NewSub(t), nil)
+
+	orm := headtracker.NewORM(db, logger, cfg.Database(), cltest.FixtureChainID)
+	ht := createHeadTracker(t, ethClient, config.EVM(), config.EVM().HeadTracker(), orm)
+	ht.Start(t)
+
+	gomega.NewWithT(t).Eventually(func() uint32 {
+		return ht.headSaver.Chain(heads[10].Hash).ChainLength()
+	}, 5*time.Second, testutils.TestInterval).Should(gomega.Equal(uint32(4)))
+	assert.Equal(t, int64(7), ht.headSaver.Chain(heads[10].Hash).EarliestInChain().BlockNumber())
+}
+
 func TestHeadTracker_SwitchesToLongestChainWithHeadSamplingEnabled(t *testing.T) {
 	t.Parallel()
 
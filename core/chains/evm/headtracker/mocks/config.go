@@ -41,6 +41,20 @@ func (_m *Config) FinalityDepth() uint32 {
 	return r0
 }
 
+// FinalityTagEnabled provides a mock function with given fields:
+func (_m *Config) FinalityTagEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewConfig interface {
 	mock.TestingT
 	Cleanup(func())
@@ -33,6 +33,12 @@ func (disabled) Replay(ctx context.Context, fromBlock int64) error { return ErrD
 
 func (disabled) ReplayAsync(fromBlock int64) {}
 
+func (disabled) ReplayFilter(ctx context.Context, filterName string, fromBlock int64) error {
+	return ErrDisabled
+}
+
+func (disabled) ReplayFilterAsync(filterName string, fromBlock int64) error { return ErrDisabled }
+
 func (disabled) RegisterFilter(filter Filter) error { return ErrDisabled }
 
 func (disabled) UnregisterFilter(name string, q pg.Queryer) error { return ErrDisabled }
@@ -95,3 +101,11 @@ func (d disabled) LogsCreatedAfter(eventSig common.Hash, address common.Address,
 func (d disabled) IndexedLogsCreatedAfter(eventSig common.Hash, address common.Address, topicIndex int, topicValues []common.Hash, after time.Time, confs int, qopts ...pg.QOpt) ([]Log, error) {
 	return nil, ErrDisabled
 }
+
+func (d disabled) QueryLogs(query *LogQuery, qopts ...pg.QOpt) (LogPage, error) {
+	return LogPage{}, ErrDisabled
+}
+
+func (d disabled) Subscribe(filterName string) (*LogSubscription, error) {
+	return nil, ErrDisabled
+}
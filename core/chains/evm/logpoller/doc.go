@@ -19,4 +19,9 @@
 //   - After calling Replay(fromBlock), all blocks including that one to the latest chain tip will be polled
 //     with the current filter. This can be used on first time job add to specify a start block from which you wish to capture
 //     existing logs.
+//   - A filter registered with a StartBlock, or replayed with ReplayFilter(name, fromBlock), has its logs backfilled
+//     in the background from that block, without re-fetching the logs of the other filters. Pending backfills survive restarts.
+//   - Subscribe(filterName) delivers the logs matching a registered filter as soon as they are saved, and notifies of
+//     logs removed by reorgs, so clients need not poll the db. Delivery is at least once, since replays re-deliver logs.
+//     QueryLogs composes arbitrary predicates over the saved logs and pages through the results with a cursor.
 package logpoller
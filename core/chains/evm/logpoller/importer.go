@@ -0,0 +1,401 @@
+package logpoller
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/utils/mathutil"
+)
+
+// ImportFormat is the encoding of a bulk log import, see ImportLogs.
+type ImportFormat string
+
+const (
+	// ImportFormatJSONL is one JSON object per line, keyed by the evm_logs column names,
+	// e.g. as produced by `COPY (SELECT row_to_json(l) FROM evm_logs l) TO STDOUT`.
+	ImportFormatJSONL ImportFormat = "jsonl"
+	// ImportFormatCSV is a CSV file with a header row of evm_logs column names,
+	// e.g. as produced by `COPY evm_logs TO STDOUT WITH CSV HEADER`.
+	ImportFormatCSV ImportFormat = "csv"
+)
+
+// importBatchSize is the number of records verified and inserted at a time.
+const importBatchSize = 1000
+
+// ImportStats summarizes the outcome of ImportLogs.
+type ImportStats struct {
+	// Read is the number of records read from the input.
+	Read int
+	// Imported is the number of logs saved, including logs which were already present.
+	Imported int
+	// Unfiltered is the number of logs skipped because they do not match any registered filter.
+	Unfiltered int
+	// AheadOfPoller is the number of logs skipped because they are in blocks the log poller has not reached yet,
+	// which it will save itself.
+	AheadOfPoller int
+	// BlockHashMismatch is the number of logs skipped because their block hash differs from the one saved by the
+	// log poller, i.e. they are not canonical.
+	BlockHashMismatch int
+}
+
+// importRecord holds the columns of a single exported evm_logs row.
+type importRecord struct {
+	EvmChainID     json.Number `json:"evm_chain_id"`
+	LogIndex       json.Number `json:"log_index"`
+	BlockHash      string      `json:"block_hash"`
+	BlockNumber    json.Number `json:"block_number"`
+	BlockTimestamp string      `json:"block_timestamp"`
+	Address        string      `json:"address"`
+	EventSig       string      `json:"event_sig"`
+	Topics         []string    `json:"topics"`
+	TxHash         string      `json:"tx_hash"`
+	Data           string      `json:"data"`
+}
+
+// ImportLogs bulk loads logs exported from another node's evm_logs table into the log poller tables of orm's chain,
+// so that a new node can be bootstrapped without replaying the history of its filters.
+//
+// Only logs matching a registered filter are imported. Logs in blocks saved by the log poller must have the same
+// block hash, and logs in blocks the log poller has not reached yet are skipped. Imports are idempotent.
+//
+// An export is assumed to hold every log of an event,address pair between the first and the last one it contains. So
+// when the imported logs of a pair start at or before the pending start block of a filter, its backfill is advanced
+// past the last imported log, or cleared if the import reaches the latest block, instead of fetching them again.
+func ImportLogs(ctx context.Context, orm *ORM, r io.Reader, format ImportFormat, lggr logger.Logger) (stats ImportStats, err error) {
+	lggr = lggr.Named("LogImporter")
+	qopts := pg.WithParentCtx(ctx)
+
+	filters, err := orm.LoadFilters(qopts)
+	if err != nil {
+		return stats, errors.Wrap(err, "failed to load filters")
+	}
+	if len(filters) == 0 {
+		return stats, errors.Errorf("no filters registered for chain %s", orm.chainID)
+	}
+
+	latestBlock := int64(-1)
+	latest, err := orm.SelectLatestBlock(qopts)
+	if err == nil {
+		latestBlock = latest.BlockNumber
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return stats, errors.Wrap(err, "failed to load latest block")
+	}
+
+	var next func() (*importRecord, error)
+	switch format {
+	case ImportFormatJSONL:
+		next = jsonlRecords(r)
+	case ImportFormatCSV:
+		if next, err = csvRecords(r); err != nil {
+			return stats, err
+		}
+	default:
+		return stats, errors.Errorf("unsupported import format %q", format)
+	}
+
+	imported := make(map[importedPair]*importedRange)
+	batch := make([]Log, 0, importBatchSize)
+	for {
+		record, err := next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return stats, errors.Wrapf(err, "failed to read record %d", stats.Read+1)
+		}
+		stats.Read++
+
+		l, err := record.toLog()
+		if err != nil {
+			return stats, errors.Wrapf(err, "invalid record %d", stats.Read)
+		}
+		if l.EvmChainId.ToInt().Cmp(orm.chainID) != 0 {
+			return stats, errors.Errorf("record %d is for chain %s, expected %s", stats.Read, l.EvmChainId, orm.chainID)
+		}
+		if !matchesAnyFilter(filters, l) {
+			stats.Unfiltered++
+			continue
+		}
+		if latestBlock >= 0 && l.BlockNumber > latestBlock {
+			stats.AheadOfPoller++
+			continue
+		}
+		batch = append(batch, l)
+
+		if len(batch) == importBatchSize {
+			if err = importBatch(orm, batch, filters, imported, &stats, qopts, lggr); err != nil {
+				return stats, err
+			}
+			batch = batch[:0]
+		}
+	}
+	if len(batch) > 0 {
+		if err = importBatch(orm, batch, filters, imported, &stats, qopts, lggr); err != nil {
+			return stats, err
+		}
+	}
+	return stats, advanceStartBlocks(orm, imported, latestBlock, qopts, lggr)
+}
+
+// importedPair is an event,address pair of a filter which logs have been imported for.
+type importedPair struct {
+	filterName string
+	address    common.Address
+	eventSig   common.Hash
+}
+
+// importedRange is the range of blocks of the imported logs of an importedPair.
+type importedRange struct {
+	from, to int64
+}
+
+// advanceStartBlocks moves the pending start block of every pair whose imported logs start at or before it past the
+// last imported log, since the backfill would only fetch those logs again.
+func advanceStartBlocks(orm *ORM, imported map[importedPair]*importedRange, latestBlock int64, qopts pg.QOpt, lggr logger.Logger) error {
+	for pair, r := range imported {
+		to := r.to + 1
+		if latestBlock >= 0 && r.to >= latestBlock {
+			// The backfill stops at the latest block, there is nothing left to backfill
+			to = 0
+		}
+		filter := Filter{Name: pair.filterName, Addresses: []common.Address{pair.address}, EventSigs: []common.Hash{pair.eventSig}}
+		if err := orm.AdvanceFilterStartBlock(filter, r.from, to, qopts); err != nil {
+			return errors.Wrapf(err, "failed to advance start block of filter %s", pair.filterName)
+		}
+		lggr.Debugw("Advanced pending backfill past imported logs", "filter", pair.filterName, "address", pair.address, "eventSig", pair.eventSig, "fromBlock", r.from, "toBlock", r.to)
+	}
+	return nil
+}
+
+// importBatch drops the logs whose block hash does not match the block saved by the log poller, and saves the rest.
+// The block range of the saved logs is recorded in imported for every filter they match.
+func importBatch(orm *ORM, logs []Log, filters map[string]Filter, imported map[importedPair]*importedRange, stats *ImportStats, qopts pg.QOpt, lggr logger.Logger) error {
+	minBlock, maxBlock := logs[0].BlockNumber, logs[0].BlockNumber
+	for _, l := range logs[1:] {
+		if l.BlockNumber < minBlock {
+			minBlock = l.BlockNumber
+		}
+		if l.BlockNumber > maxBlock {
+			maxBlock = l.BlockNumber
+		}
+	}
+	blocks, err := orm.GetBlocksRange(uint64(minBlock), uint64(maxBlock), qopts)
+	if err != nil {
+		return errors.Wrap(err, "failed to load blocks")
+	}
+	blockHashes := make(map[int64]common.Hash, len(blocks))
+	for _, b := range blocks {
+		blockHashes[b.BlockNumber] = b.BlockHash
+	}
+
+	verified := make([]Log, 0, len(logs))
+	for _, l := range logs {
+		if h, ok := blockHashes[l.BlockNumber]; ok && h != l.BlockHash {
+			lggr.Debugw("Skipping non canonical log", "blockNumber", l.BlockNumber, "blockHash", l.BlockHash, "canonicalBlockHash", h, "logIndex", l.LogIndex)
+			stats.BlockHashMismatch++
+			continue
+		}
+		verified = append(verified, l)
+	}
+	if err = orm.InsertLogs(verified, qopts); err != nil {
+		return errors.Wrap(err, "failed to insert logs")
+	}
+	stats.Imported += len(verified)
+	for _, l := range verified {
+		for name, filter := range filters {
+			if !filter.matches(l) {
+				continue
+			}
+			pair := importedPair{name, l.Address, l.EventSig}
+			if r, ok := imported[pair]; !ok {
+				imported[pair] = &importedRange{l.BlockNumber, l.BlockNumber}
+			} else {
+				r.from = mathutil.Min(r.from, l.BlockNumber)
+				r.to = mathutil.Max(r.to, l.BlockNumber)
+			}
+		}
+	}
+	lggr.Debugw("Imported logs", "fromBlock", minBlock, "toBlock", maxBlock, "logs", len(verified))
+	return nil
+}
+
+func matchesAnyFilter(filters map[string]Filter, l Log) bool {
+	for _, filter := range filters {
+		if filter.matches(l) {
+			return true
+		}
+	}
+	return false
+}
+
+func jsonlRecords(r io.Reader) func() (*importRecord, error) {
+	scanner := bufio.NewScanner(r)
+	// logs can carry large data payloads
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	return func() (*importRecord, error) {
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" {
+				continue
+			}
+			var record importRecord
+			if err := json.Unmarshal([]byte(line), &record); err != nil {
+				return nil, err
+			}
+			return &record, nil
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+}
+
+func csvRecords(r io.Reader) (func() (*importRecord, error), error) {
+	reader := csv.NewReader(r)
+	header, err := reader.Read()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read CSV header")
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.TrimSpace(name)] = i
+	}
+	for _, name := range []string{"evm_chain_id", "log_index", "block_hash", "block_number", "block_timestamp", "address", "event_sig", "topics", "tx_hash", "data"} {
+		if _, ok := columns[name]; !ok {
+			return nil, errors.Errorf("CSV header is missing column %q", name)
+		}
+	}
+	return func() (*importRecord, error) {
+		row, err := reader.Read()
+		if err != nil {
+			return nil, err
+		}
+		column := func(name string) string { return row[columns[name]] }
+		topics, err := parseByteaArray(column("topics"))
+		if err != nil {
+			return nil, err
+		}
+		return &importRecord{
+			EvmChainID:     json.Number(column("evm_chain_id")),
+			LogIndex:       json.Number(column("log_index")),
+			BlockHash:      column("block_hash"),
+			BlockNumber:    json.Number(column("block_number")),
+			BlockTimestamp: column("block_timestamp"),
+			Address:        column("address"),
+			EventSig:       column("event_sig"),
+			Topics:         topics,
+			TxHash:         column("tx_hash"),
+			Data:           column("data"),
+		}, nil
+	}, nil
+}
+
+func (r *importRecord) toLog() (l Log, err error) {
+	chainID, ok := new(big.Int).SetString(r.EvmChainID.String(), 10)
+	if !ok {
+		return l, errors.Errorf("invalid evm_chain_id %q", r.EvmChainID)
+	}
+	if l.LogIndex, err = strconv.ParseInt(r.LogIndex.String(), 10, 64); err != nil {
+		return l, errors.Wrap(err, "invalid log_index")
+	}
+	if l.BlockNumber, err = strconv.ParseInt(r.BlockNumber.String(), 10, 64); err != nil {
+		return l, errors.Wrap(err, "invalid block_number")
+	}
+	if l.BlockTimestamp, err = parseTimestamp(r.BlockTimestamp); err != nil {
+		return l, errors.Wrap(err, "invalid block_timestamp")
+	}
+	var b []byte
+	if b, err = decodeBytea(r.BlockHash, common.HashLength); err != nil {
+		return l, errors.Wrap(err, "invalid block_hash")
+	}
+	l.BlockHash = common.BytesToHash(b)
+	if b, err = decodeBytea(r.Address, common.AddressLength); err != nil {
+		return l, errors.Wrap(err, "invalid address")
+	}
+	l.Address = common.BytesToAddress(b)
+	if b, err = decodeBytea(r.EventSig, common.HashLength); err != nil {
+		return l, errors.Wrap(err, "invalid event_sig")
+	}
+	l.EventSig = common.BytesToHash(b)
+	if b, err = decodeBytea(r.TxHash, common.HashLength); err != nil {
+		return l, errors.Wrap(err, "invalid tx_hash")
+	}
+	l.TxHash = common.BytesToHash(b)
+	if l.Data, err = decodeBytea(r.Data, -1); err != nil {
+		return l, errors.Wrap(err, "invalid data")
+	}
+	if len(r.Topics) == 0 {
+		return l, errors.New("missing topics")
+	}
+	for _, t := range r.Topics {
+		if b, err = decodeBytea(t, common.HashLength); err != nil {
+			return l, errors.Wrap(err, "invalid topic")
+		}
+		l.Topics = append(l.Topics, b)
+	}
+	if common.BytesToHash(l.Topics[0]) != l.EventSig {
+		return l, errors.New("first topic does not match event_sig")
+	}
+	l.EvmChainId = utils.NewBig(chainID)
+	return l, nil
+}
+
+// decodeBytea decodes a postgres bytea in hex format (\x...) or a 0x prefixed hex string.
+// A non-negative length is enforced.
+func decodeBytea(s string, length int) ([]byte, error) {
+	s = strings.TrimPrefix(strings.TrimPrefix(s, `\x`), "0x")
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if length >= 0 && len(b) != length {
+		return nil, errors.Errorf("expected %d bytes, got %d", length, len(b))
+	}
+	return b, nil
+}
+
+// parseByteaArray parses the text representation of a postgres bytea[], e.g. {"\\x01","\\x02"}.
+func parseByteaArray(s string) ([]string, error) {
+	s = strings.TrimSpace(s)
+	if !strings.HasPrefix(s, "{") || !strings.HasSuffix(s, "}") {
+		return nil, errors.Errorf("invalid array %q", s)
+	}
+	s = s[1 : len(s)-1]
+	if s == "" {
+		return nil, nil
+	}
+	var elems []string
+	for _, e := range strings.Split(s, ",") {
+		e = strings.Trim(e, `"`)
+		elems = append(elems, strings.ReplaceAll(e, `\\`, `\`))
+	}
+	return elems, nil
+}
+
+// parseTimestamp accepts the timestamptz formats of both row_to_json and COPY.
+func parseTimestamp(s string) (time.Time, error) {
+	var err error
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02 15:04:05.999999999-07:00", "2006-01-02 15:04:05.999999999-07"} {
+		var t time.Time
+		if t, err = time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, err
+}
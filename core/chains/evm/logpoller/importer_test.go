@@ -0,0 +1,145 @@
+package logpoller_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+func TestImportLogs(t *testing.T) {
+	t.Parallel()
+
+	event1 := EmitterABI.Events["Log1"].ID
+	event2 := EmitterABI.Events["Log2"].ID
+	address1 := common.HexToAddress("0x2ab9a2Dc53736b361b72d900CdF9F78F9406fbbb")
+	address2 := common.HexToAddress("0x6E225058950f237371261C985Db6bDe26df2200E")
+	canonical := common.HexToHash("0x11")
+	reorged := common.HexToHash("0x22")
+	bytea := func(b []byte) string { return fmt.Sprintf(`\\x%x`, b) }
+
+	type exported struct {
+		blockNumber int64
+		blockHash   common.Hash
+		address     common.Address
+		eventSig    common.Hash
+	}
+	// Poller has saved blocks 10 (canonical) and 20
+	records := []exported{
+		{5, common.HexToHash("0x5"), address1, event1},   // imported, no block to verify against
+		{10, canonical, address1, event1},                // imported, verified
+		{10, reorged, address1, event1},                  // block hash mismatch
+		{10, canonical, address2, event1},                // unfiltered
+		{15, common.HexToHash("0x15"), address1, event2}, // unfiltered
+		{21, common.HexToHash("0x21"), address1, event1}, // ahead of poller
+	}
+
+	setup := func(t *testing.T) TestHarness {
+		th := SetupTH(t, 2, 3, 2)
+		require.NoError(t, th.ORM.InsertFilter(logpoller.Filter{Name: "test", EventSigs: []common.Hash{event1}, Addresses: []common.Address{address1}}))
+		require.NoError(t, th.ORM.InsertBlock(canonical, 10, time.Now()))
+		require.NoError(t, th.ORM.InsertBlock(common.HexToHash("0x20"), 20, time.Now()))
+		return th
+	}
+
+	assertImported := func(t *testing.T, th TestHarness, stats logpoller.ImportStats) {
+		assert.Equal(t, logpoller.ImportStats{Read: 6, Imported: 2, Unfiltered: 2, AheadOfPoller: 1, BlockHashMismatch: 1}, stats)
+		lgs, err := th.ORM.SelectLogsByBlockRange(0, 100)
+		require.NoError(t, err)
+		require.Len(t, lgs, 2)
+		assert.Equal(t, int64(5), lgs[0].BlockNumber)
+		assert.Equal(t, int64(10), lgs[1].BlockNumber)
+		assert.Equal(t, canonical, lgs[1].BlockHash)
+		assert.Equal(t, address1, lgs[1].Address)
+		assert.Equal(t, []common.Hash{event1, logpoller.EvmWord(10)}, lgs[1].GetTopics())
+		assert.Equal(t, logpoller.EvmWord(100).Bytes(), lgs[1].Data)
+	}
+
+	jsonl := func(th TestHarness, records []exported) string {
+		var sb strings.Builder
+		for i, r := range records {
+			fmt.Fprintf(&sb, `{"evm_chain_id":%s,"log_index":%d,"block_hash":"%s","block_number":%d,"block_timestamp":"2023-06-01T12:00:00.123456+00:00","address":"%s","event_sig":"%s","topics":["%s","%s"],"tx_hash":"%s","data":"%s","created_at":"2023-06-01T12:00:01+00:00"}`+"\n",
+				th.ChainID, i, bytea(r.blockHash[:]), r.blockNumber, bytea(r.address[:]), bytea(r.eventSig[:]),
+				bytea(r.eventSig[:]), bytea(logpoller.EvmWord(uint64(r.blockNumber)).Bytes()), bytea(common.HexToHash("0x1234").Bytes()), bytea(logpoller.EvmWord(uint64(r.blockNumber*10)).Bytes()))
+		}
+		return sb.String()
+	}
+
+	t.Run("jsonl", func(t *testing.T) {
+		th := setup(t)
+		input := jsonl(th, records)
+
+		stats, err := logpoller.ImportLogs(testutils.Context(t), th.ORM, strings.NewReader(input), logpoller.ImportFormatJSONL, th.Lggr)
+		require.NoError(t, err)
+		assertImported(t, th, stats)
+
+		// importing again is a no-op
+		stats, err = logpoller.ImportLogs(testutils.Context(t), th.ORM, strings.NewReader(input), logpoller.ImportFormatJSONL, th.Lggr)
+		require.NoError(t, err)
+		assertImported(t, th, stats)
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		th := setup(t)
+		var sb strings.Builder
+		sb.WriteString("evm_chain_id,log_index,block_hash,block_number,address,event_sig,topics,tx_hash,data,created_at,block_timestamp\n")
+		for i, r := range records {
+			fmt.Fprintf(&sb, `%s,%d,%s,%d,%s,%s,"{""%s"",""%s""}",%s,%s,2023-06-01 12:00:01+00,2023-06-01 12:00:00.123456+00`+"\n",
+				th.ChainID, i, strings.ReplaceAll(bytea(r.blockHash[:]), `\\`, `\`), r.blockNumber, strings.ReplaceAll(bytea(r.address[:]), `\\`, `\`), strings.ReplaceAll(bytea(r.eventSig[:]), `\\`, `\`),
+				bytea(r.eventSig[:]), bytea(logpoller.EvmWord(uint64(r.blockNumber)).Bytes()), strings.ReplaceAll(bytea(common.HexToHash("0x1234").Bytes()), `\\`, `\`), strings.ReplaceAll(bytea(logpoller.EvmWord(uint64(r.blockNumber*10)).Bytes()), `\\`, `\`))
+		}
+
+		stats, err := logpoller.ImportLogs(testutils.Context(t), th.ORM, strings.NewReader(sb.String()), logpoller.ImportFormatCSV, th.Lggr)
+		require.NoError(t, err)
+		assertImported(t, th, stats)
+	})
+
+	t.Run("advances pending backfills covered by the import", func(t *testing.T) {
+		th := setup(t)
+		// the imported logs of address1,event1 span blocks 5 to 10
+		require.NoError(t, th.ORM.InsertFilter(logpoller.Filter{Name: "covered", EventSigs: []common.Hash{event1}, Addresses: []common.Address{address1}, StartBlock: 8}))
+		require.NoError(t, th.ORM.InsertFilter(logpoller.Filter{Name: "after import", EventSigs: []common.Hash{event1}, Addresses: []common.Address{address1}, StartBlock: 15}))
+		require.NoError(t, th.ORM.InsertFilter(logpoller.Filter{Name: "no logs imported", EventSigs: []common.Hash{event2}, Addresses: []common.Address{address2}, StartBlock: 8}))
+
+		_, err := logpoller.ImportLogs(testutils.Context(t), th.ORM, strings.NewReader(jsonl(th, records)), logpoller.ImportFormatJSONL, th.Lggr)
+		require.NoError(t, err)
+
+		filters, err := th.ORM.LoadFilters()
+		require.NoError(t, err)
+		assert.Equal(t, int64(11), filters["covered"].StartBlock)
+		assert.Equal(t, int64(15), filters["after import"].StartBlock)
+		assert.Equal(t, int64(8), filters["no logs imported"].StartBlock)
+
+		// an import reaching the latest block leaves nothing to backfill
+		latest := []exported{{20, common.HexToHash("0x20"), address1, event1}}
+		_, err = logpoller.ImportLogs(testutils.Context(t), th.ORM, strings.NewReader(jsonl(th, append(records[:2:2], latest...))), logpoller.ImportFormatJSONL, th.Lggr)
+		require.NoError(t, err)
+
+		filters, err = th.ORM.LoadFilters()
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), filters["covered"].StartBlock)
+		assert.Equal(t, int64(0), filters["after import"].StartBlock)
+	})
+
+	t.Run("rejects logs of another chain", func(t *testing.T) {
+		th := setup(t)
+		line := fmt.Sprintf(`{"evm_chain_id":%s,"log_index":0,"block_hash":"%s","block_number":5,"block_timestamp":"2023-06-01T12:00:00Z","address":"%s","event_sig":"%s","topics":["%s"],"tx_hash":"%s","data":""}`,
+			th.ChainID2, bytea(canonical[:]), bytea(address1[:]), bytea(event1[:]), bytea(event1[:]), bytea(canonical[:]))
+
+		_, err := logpoller.ImportLogs(testutils.Context(t), th.ORM, strings.NewReader(line), logpoller.ImportFormatJSONL, th.Lggr)
+		assert.EqualError(t, err, fmt.Sprintf("record 1 is for chain %s, expected %s", th.ChainID2, th.ChainID))
+	})
+
+	t.Run("requires registered filters", func(t *testing.T) {
+		th := SetupTH(t, 2, 3, 2)
+		_, err := logpoller.ImportLogs(testutils.Context(t), th.ORM, strings.NewReader(""), logpoller.ImportFormatJSONL, th.Lggr)
+		assert.EqualError(t, err, fmt.Sprintf("no filters registered for chain %s", th.ChainID))
+	})
+}
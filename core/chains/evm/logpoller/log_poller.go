@@ -33,6 +33,8 @@ type LogPoller interface {
 	services.ServiceCtx
 	Replay(ctx context.Context, fromBlock int64) error
 	ReplayAsync(fromBlock int64)
+	ReplayFilter(ctx context.Context, filterName string, fromBlock int64) error
+	ReplayFilterAsync(filterName string, fromBlock int64) error
 	RegisterFilter(filter Filter) error
 	UnregisterFilter(name string, q pg.Queryer) error
 	LatestBlock(qopts ...pg.QOpt) (int64, error)
@@ -54,6 +56,12 @@ type LogPoller interface {
 	IndexedLogsWithSigsExcluding(address common.Address, eventSigA, eventSigB common.Hash, topicIndex int, fromBlock, toBlock int64, confs int, qopts ...pg.QOpt) ([]Log, error)
 	LogsDataWordRange(eventSig common.Hash, address common.Address, wordIndex int, wordValueMin, wordValueMax common.Hash, confs int, qopts ...pg.QOpt) ([]Log, error)
 	LogsDataWordGreaterThan(eventSig common.Hash, address common.Address, wordIndex int, wordValueMin common.Hash, confs int, qopts ...pg.QOpt) ([]Log, error)
+
+	// Composable querying
+	QueryLogs(query *LogQuery, qopts ...pg.QOpt) (LogPage, error)
+
+	// Streaming
+	Subscribe(filterName string) (*LogSubscription, error)
 }
 
 type LogPollerTest interface {
@@ -67,6 +75,7 @@ type LogPollerTest interface {
 type Client interface {
 	HeadByNumber(ctx context.Context, n *big.Int) (*evmtypes.Head, error)
 	HeadByHash(ctx context.Context, n common.Hash) (*evmtypes.Head, error)
+	LatestFinalizedBlock(ctx context.Context) (*evmtypes.Head, error)
 	BatchCallContext(ctx context.Context, b []rpc.BatchElem) error
 	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
 	ConfiguredChainID() *big.Int
@@ -77,6 +86,8 @@ var (
 	ErrReplayRequestAborted               = errors.New("aborted, replay request cancelled")
 	ErrReplayInProgress                   = errors.New("replay request cancelled, but replay is already in progress")
 	ErrLogPollerShutdown                  = errors.New("replay aborted due to log poller shutdown")
+	ErrFilterNotFound                     = errors.New("filter not found")
+	ErrInvalidReplayBlock                 = errors.New("invalid replay block number")
 )
 
 type logPoller struct {
@@ -85,6 +96,7 @@ type logPoller struct {
 	orm                   *ORM
 	lggr                  logger.Logger
 	pollPeriod            time.Duration // poll period set by block production rate
+	useFinalityTag        bool          // indicates whether the chain's finalized block tag is used instead of finalityDepth
 	finalityDepth         int64         // finality depth is taken to mean that block (head - finality) is finalized
 	keepBlocksDepth       int64         // the number of blocks behind the head for which we keep the blocks. Must be greater than finality depth + 1.
 	backfillBatchSize     int64         // batch size to use when backfilling finalized logs
@@ -97,6 +109,14 @@ type logPoller struct {
 	cachedAddresses []common.Address
 	cachedEventSigs []common.Hash
 
+	subscriptionsMu sync.Mutex
+	subscriptions   map[*LogSubscription]struct{}
+
+	pollMu               sync.Mutex // held while polling, so that filter backfills know up to which block they must go
+	filterBackfillsMu    sync.Mutex
+	filterBackfills      map[string][]chan error // filters with a pending backfill, and the clients waiting for it
+	filterBackfillSignal chan struct{}
+
 	replayStart    chan int64
 	replayComplete chan error
 	ctx            context.Context
@@ -115,7 +135,7 @@ type logPoller struct {
 // How fast that can be done depends largely on network speed and DB, but even for the fastest
 // support chain, polygon, which has 2s block times, we need RPCs roughly with <= 500ms latency
 func NewLogPoller(orm *ORM, ec Client, lggr logger.Logger, pollPeriod time.Duration,
-	finalityDepth int64, backfillBatchSize int64, rpcBatchSize int64, keepBlocksDepth int64) *logPoller {
+	useFinalityTag bool, finalityDepth int64, backfillBatchSize int64, rpcBatchSize int64, keepBlocksDepth int64) *logPoller {
 
 	return &logPoller{
 		ec:                ec,
@@ -124,12 +144,17 @@ func NewLogPoller(orm *ORM, ec Client, lggr logger.Logger, pollPeriod time.Durat
 		replayStart:       make(chan int64),
 		replayComplete:    make(chan error),
 		pollPeriod:        pollPeriod,
+		useFinalityTag:    useFinalityTag,
 		finalityDepth:     finalityDepth,
 		backfillBatchSize: backfillBatchSize,
 		rpcBatchSize:      rpcBatchSize,
 		keepBlocksDepth:   keepBlocksDepth,
 		filters:           make(map[string]Filter),
 		filterDirty:       true, // Always build Filter on first call to cache an empty filter if nothing registered yet.
+		subscriptions:     make(map[*LogSubscription]struct{}),
+		filterBackfills:   make(map[string][]chan error),
+		// Buffered so that queueing a backfill never blocks, pending backfills are all processed on every signal.
+		filterBackfillSignal: make(chan struct{}, 1),
 	}
 }
 
@@ -138,6 +163,10 @@ type Filter struct {
 	EventSigs evmtypes.HashArray
 	Addresses evmtypes.AddressArray
 	Retention time.Duration
+	// StartBlock is the block from which the logs of a newly registered filter are backfilled, in the background and
+	// without touching the other filters. Zero means the filter only captures logs from the blocks polled after it has
+	// been registered.
+	StartBlock int64
 }
 
 // FilterName is a suggested convenience function for clients to construct unique filter names
@@ -198,6 +227,8 @@ func (filter *Filter) Contains(other *Filter) bool {
 // Generally speaking this is harmless. We enforce that EventSigs and Addresses are non-empty,
 // which means that anonymous events are not supported and log.Topics >= 1 always (log.Topics[0] is the event signature).
 // The filter may be unregistered later by Filter.Name
+// If filter.StartBlock is set, the logs of the newly added events and addresses are backfilled from that block
+// in the background, while the other filters keep being polled as usual.
 func (lp *logPoller) RegisterFilter(filter Filter) error {
 	if len(filter.Addresses) == 0 {
 		return errors.Errorf("at least one address must be specified")
@@ -235,6 +266,9 @@ func (lp *logPoller) RegisterFilter(filter Filter) error {
 	}
 	lp.filters[filter.Name] = filter
 	lp.filterDirty = true
+	if filter.StartBlock > 0 {
+		lp.queueFilterBackfill(filter.Name, nil)
+	}
 	return nil
 }
 
@@ -253,9 +287,81 @@ func (lp *logPoller) UnregisterFilter(name string, q pg.Queryer) error {
 	}
 	delete(lp.filters, name)
 	lp.filterDirty = true
+	lp.closeSubscriptions(func(sub *LogSubscription) bool { return sub.filterName == name })
 	return nil
 }
 
+// Subscribe returns a subscription which receives the logs matching the named filter as soon as they are saved
+// by the poller, as well as an event whenever logs are removed because of a reorg. The filter must be registered.
+// The subscription is closed when the filter is unregistered or the poller is closed, otherwise the caller must
+// close it once it is no longer needed.
+func (lp *logPoller) Subscribe(filterName string) (*LogSubscription, error) {
+	lp.filterMu.RLock()
+	defer lp.filterMu.RUnlock()
+	if _, ok := lp.filters[filterName]; !ok {
+		return nil, errors.Wrapf(ErrFilterNotFound, "cannot subscribe to %s", filterName)
+	}
+
+	sub := newLogSubscription(filterName, lp.removeSubscription)
+	lp.subscriptionsMu.Lock()
+	defer lp.subscriptionsMu.Unlock()
+	lp.subscriptions[sub] = struct{}{}
+	return sub, nil
+}
+
+func (lp *logPoller) removeSubscription(sub *LogSubscription) {
+	lp.subscriptionsMu.Lock()
+	defer lp.subscriptionsMu.Unlock()
+	delete(lp.subscriptions, sub)
+}
+
+// closeSubscriptions closes every subscription for which match returns true.
+func (lp *logPoller) closeSubscriptions(match func(*LogSubscription) bool) {
+	var subs []*LogSubscription
+	lp.subscriptionsMu.Lock()
+	for sub := range lp.subscriptions {
+		if match(sub) {
+			subs = append(subs, sub)
+		}
+	}
+	lp.subscriptionsMu.Unlock()
+	// Close outside the lock, since closing a subscription removes it from lp.subscriptions
+	for _, sub := range subs {
+		sub.Close()
+	}
+}
+
+// notifySubscribers delivers newly saved logs to the subscriptions of the filters they match.
+func (lp *logPoller) notifySubscribers(logs []Log) {
+	if len(logs) == 0 {
+		return
+	}
+	lp.filterMu.RLock()
+	defer lp.filterMu.RUnlock()
+	lp.subscriptionsMu.Lock()
+	defer lp.subscriptionsMu.Unlock()
+	for sub := range lp.subscriptions {
+		filter, ok := lp.filters[sub.filterName]
+		if !ok {
+			continue
+		}
+		if sub.deliverLogs(filter, logs) {
+			lp.lggr.Warnw("Log subscription is not keeping up, dropped oldest event", "filter", sub.filterName)
+		}
+	}
+}
+
+// notifyRemoval tells every subscription that all logs at or after fromBlock were removed.
+func (lp *logPoller) notifyRemoval(fromBlock int64) {
+	lp.subscriptionsMu.Lock()
+	defer lp.subscriptionsMu.Unlock()
+	for sub := range lp.subscriptions {
+		if sub.deliverRemoval(fromBlock) {
+			lp.lggr.Warnw("Log subscription is not keeping up, dropped oldest event", "filter", sub.filterName)
+		}
+	}
+}
+
 func (lp *logPoller) Filter(from, to *big.Int, bh *common.Hash) ethereum.FilterQuery {
 	lp.filterMu.Lock()
 	defer lp.filterMu.Unlock()
@@ -335,6 +441,69 @@ func (lp *logPoller) Replay(ctx context.Context, fromBlock int64) error {
 	}
 }
 
+// ReplayFilter backfills the logs of a single registered filter from fromBlock up to latest, without re-fetching
+// the logs of the other filters. Blocks until the backfill is complete. If ctx is cancelled first, the backfill
+// continues in the background and ErrReplayInProgress is returned. The request is persisted, so a backfill
+// interrupted by a restart resumes where it left off.
+func (lp *logPoller) ReplayFilter(ctx context.Context, filterName string, fromBlock int64) error {
+	done := make(chan error, 1)
+	if err := lp.replayFilter(ctx, filterName, fromBlock, done); err != nil {
+		return err
+	}
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ErrReplayInProgress
+	}
+}
+
+// ReplayFilterAsync is like ReplayFilter, but returns as soon as the backfill has been requested.
+func (lp *logPoller) ReplayFilterAsync(filterName string, fromBlock int64) error {
+	return lp.replayFilter(context.Background(), filterName, fromBlock, nil)
+}
+
+func (lp *logPoller) replayFilter(ctx context.Context, filterName string, fromBlock int64, done chan error) error {
+	lp.lggr.Debugw("Replaying filter", "filter", filterName, "fromBlock", fromBlock)
+	latest, err := lp.ec.HeadByNumber(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if fromBlock < 1 || fromBlock > latest.Number {
+		return errors.Wrapf(ErrInvalidReplayBlock, "%v is outside the acceptable range [1, %v]", fromBlock, latest.Number)
+	}
+	lp.filterMu.RLock()
+	_, ok := lp.filters[filterName]
+	lp.filterMu.RUnlock()
+	if !ok {
+		return errors.Wrapf(ErrFilterNotFound, "cannot replay %s", filterName)
+	}
+	if err = lp.orm.UpdateFilterStartBlock(filterName, fromBlock, pg.WithParentCtx(ctx)); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.Wrapf(ErrFilterNotFound, "cannot replay %s", filterName)
+		}
+		return errors.Wrapf(err, "failed to save start block of filter %s", filterName)
+	}
+	lp.queueFilterBackfill(filterName, done)
+	return nil
+}
+
+// queueFilterBackfill schedules a backfill of the named filter from its pending start block.
+// If done is not nil, the result of the backfill is sent to it.
+func (lp *logPoller) queueFilterBackfill(name string, done chan error) {
+	lp.filterBackfillsMu.Lock()
+	waiters := lp.filterBackfills[name]
+	if done != nil {
+		waiters = append(waiters, done)
+	}
+	lp.filterBackfills[name] = waiters
+	lp.filterBackfillsMu.Unlock()
+	select {
+	case lp.filterBackfillSignal <- struct{}{}:
+	default:
+	}
+}
+
 func (lp *logPoller) recvReplayComplete() {
 	err := <-lp.replayComplete
 	if err != nil {
@@ -355,7 +524,8 @@ func (lp *logPoller) ReplayAsync(fromBlock int64) {
 }
 
 func (lp *logPoller) Start(parentCtx context.Context) error {
-	if lp.keepBlocksDepth < (lp.finalityDepth + 1) {
+	// With the finality tag, pruning never goes past the latest finalized block, see pruneOldBlocks.
+	if !lp.useFinalityTag && lp.keepBlocksDepth < (lp.finalityDepth+1) {
 		// We add 1 since for reorg detection on the first unfinalized block
 		// we need to keep 1 finalized block.
 		return errors.Errorf("keepBlocksDepth %d must be greater than finality %d + 1", lp.keepBlocksDepth, lp.finalityDepth)
@@ -364,8 +534,9 @@ func (lp *logPoller) Start(parentCtx context.Context) error {
 		ctx, cancel := context.WithCancel(parentCtx)
 		lp.ctx = ctx
 		lp.cancel = cancel
-		lp.wg.Add(1)
+		lp.wg.Add(2)
 		go lp.run()
+		go lp.runFilterBackfills()
 		return nil
 	})
 }
@@ -378,6 +549,7 @@ func (lp *logPoller) Close() error {
 		}
 		lp.cancel()
 		lp.wg.Wait()
+		lp.closeSubscriptions(func(*LogSubscription) bool { return true })
 		return nil
 	})
 }
@@ -427,6 +599,12 @@ func (lp *logPoller) run() {
 		lp.filters = filters
 		lp.filterDirty = true
 		filtersLoaded = true
+		// Resume the backfills interrupted by a restart.
+		for name, filter := range filters {
+			if filter.StartBlock > 0 {
+				lp.queueFilterBackfill(name, nil)
+			}
+		}
 		return nil
 	}
 
@@ -480,21 +658,20 @@ func (lp *logPoller) run() {
 				}
 				// Otherwise this is the first poll _ever_ on a new chain.
 				// Only safe thing to do is to start at the first finalized block.
-				latest, err := lp.ec.HeadByNumber(lp.ctx, nil)
+				latest, finalizedNum, err := lp.latestBlocks(lp.ctx)
 				if err != nil {
 					lp.lggr.Warnw("unable to get latest for first poll", "err", err)
 					continue
 				}
-				latestNum := latest.Number
 				// Do not support polling chains which don't even have finality depth worth of blocks.
 				// Could conceivably support this but not worth the effort.
 				// Need finality depth + 1, no block 0.
-				if latestNum <= lp.finalityDepth {
-					lp.lggr.Warnw("insufficient number of blocks on chain, waiting for finality depth", "err", err, "latest", latestNum, "finality", lp.finalityDepth)
+				if finalizedNum <= 0 {
+					lp.lggr.Warnw("insufficient number of blocks on chain, waiting for finality depth", "latest", latest.Number, "finalized", finalizedNum)
 					continue
 				}
 				// Starting at the first finalized block. We do not backfill the first finalized block.
-				start = latestNum - lp.finalityDepth
+				start = finalizedNum
 			} else {
 				start = lastProcessed.BlockNumber + 1
 			}
@@ -530,6 +707,53 @@ func (lp *logPoller) run() {
 	}
 }
 
+// runFilterBackfills serially processes the backfills of single filters, queued by RegisterFilter and ReplayFilter.
+// A failed backfill is retried on the next poll period, until it completes or the filter is unregistered.
+func (lp *logPoller) runFilterBackfills() {
+	defer lp.wg.Done()
+	var retryTick <-chan time.Time
+	for {
+		select {
+		case <-lp.ctx.Done():
+			lp.filterBackfillsMu.Lock()
+			defer lp.filterBackfillsMu.Unlock()
+			for _, waiters := range lp.filterBackfills {
+				for _, done := range waiters {
+					done <- ErrLogPollerShutdown
+				}
+			}
+			return
+		case <-lp.filterBackfillSignal:
+		case <-retryTick:
+		}
+
+		lp.filterBackfillsMu.Lock()
+		names := maps.Keys(lp.filterBackfills)
+		lp.filterBackfillsMu.Unlock()
+		for _, name := range names {
+			// Requests made while this backfill runs are queued again, and processed on the next round.
+			lp.filterBackfillsMu.Lock()
+			waiters := lp.filterBackfills[name]
+			delete(lp.filterBackfills, name)
+			lp.filterBackfillsMu.Unlock()
+
+			err := lp.backfillFilter(lp.ctx, name)
+			if err != nil {
+				if lp.ctx.Err() != nil {
+					err = ErrLogPollerShutdown
+				} else {
+					lp.lggr.Warnw("Unable to backfill filter, retrying later", "filter", name, "err", err)
+					lp.queueFilterBackfill(name, nil)
+					retryTick = time.After(utils.WithJitter(lp.pollPeriod))
+				}
+			}
+			for _, done := range waiters {
+				done <- err
+			}
+		}
+	}
+}
+
 func (lp *logPoller) BackupPollAndSaveLogs(ctx context.Context, backupPollerBlockDelay int64) {
 	if lp.backupPollerNextBlock == 0 {
 		lastProcessed, err := lp.orm.SelectLatestBlock(pg.WithParentCtx(ctx))
@@ -550,13 +774,13 @@ func (lp *logPoller) BackupPollAndSaveLogs(ctx context.Context, backupPollerBloc
 		}
 	}
 
-	latestBlock, err := lp.ec.HeadByNumber(ctx, nil)
+	_, latestFinalizedBlockNumber, err := lp.latestBlocks(ctx)
 	if err != nil {
 		lp.lggr.Warnw("Backup logpoller failed to get latest block", "err", err)
 		return
 	}
 
-	lastSafeBackfillBlock := latestBlock.Number - lp.finalityDepth - 1
+	lastSafeBackfillBlock := latestFinalizedBlockNumber - 1
 	if lastSafeBackfillBlock >= lp.backupPollerNextBlock {
 		lp.lggr.Infow("Backup poller backfilling logs", "start", lp.backupPollerNextBlock, "end", lastSafeBackfillBlock)
 		if err = lp.backfill(ctx, lp.backupPollerNextBlock, lastSafeBackfillBlock); err != nil {
@@ -629,9 +853,14 @@ func (lp *logPoller) blocksFromLogs(ctx context.Context, logs []types.Log) (bloc
 // Retries until ctx cancelled. Will return an error if cancelled
 // or if there is an error backfilling.
 func (lp *logPoller) backfill(ctx context.Context, start, end int64) error {
+	return lp.backfillQuery(ctx, start, end, lp.Filter)
+}
+
+// backfillQuery is backfill with the given filter query instead of the merged filter of all the registered filters.
+func (lp *logPoller) backfillQuery(ctx context.Context, start, end int64, query func(from, to *big.Int, bh *common.Hash) ethereum.FilterQuery) error {
 	for from := start; from <= end; from += lp.backfillBatchSize {
 		to := mathutil.Min(from+lp.backfillBatchSize-1, end)
-		gethLogs, err := lp.ec.FilterLogs(ctx, lp.Filter(big.NewInt(from), big.NewInt(to), nil))
+		gethLogs, err := lp.ec.FilterLogs(ctx, query(big.NewInt(from), big.NewInt(to), nil))
 		if err != nil {
 			lp.lggr.Warnw("Unable query for logs, retrying", "err", err, "from", from, "to", to)
 			return err
@@ -645,17 +874,69 @@ func (lp *logPoller) backfill(ctx context.Context, start, end int64) error {
 		}
 
 		lp.lggr.Debugw("Backfill found logs", "from", from, "to", to, "logs", len(gethLogs), "blocks", blocks)
+		lgs := convertLogs(gethLogs, blocks, lp.lggr, lp.ec.ConfiguredChainID())
 		err = lp.orm.q.WithOpts(pg.WithParentCtx(ctx)).Transaction(func(tx pg.Queryer) error {
-			return lp.orm.InsertLogs(convertLogs(gethLogs, blocks, lp.lggr, lp.ec.ConfiguredChainID()), pg.WithQueryer(tx))
+			return lp.orm.InsertLogs(lgs, pg.WithQueryer(tx))
 		})
 		if err != nil {
 			lp.lggr.Warnw("Unable to insert logs, retrying", "err", err, "from", from, "to", to)
 			return err
 		}
+		lp.notifySubscribers(lgs)
 	}
 	return nil
 }
 
+// backfillFilter backfills the logs of the named filter from its pending start block up to the latest block saved by
+// the poller, since the blocks polled after that already use the filter. Progress is saved after every batch.
+func (lp *logPoller) backfillFilter(ctx context.Context, name string) error {
+	for {
+		// Only the pairs saved with a pending start block need to be backfilled. The addresses and events of several
+		// pairs are queried together, which may fetch logs of already indexed pairs of the filter too. Saving those
+		// again is a no-op.
+		filter, err := lp.orm.LoadPendingFilter(name, pg.WithParentCtx(ctx))
+		if errors.Is(err, sql.ErrNoRows) {
+			// Done, or the filter has been unregistered.
+			return nil
+		} else if err != nil {
+			return err
+		}
+		start := filter.StartBlock
+		query := func(from, to *big.Int, bh *common.Hash) ethereum.FilterQuery {
+			return ethereum.FilterQuery{FromBlock: from, ToBlock: to, BlockHash: bh, Topics: [][]common.Hash{filter.EventSigs}, Addresses: filter.Addresses}
+		}
+
+		// Wait for the poll in progress, if any, so that it cannot save blocks without this filter after we read the latest.
+		lp.pollMu.Lock()
+		latest, err := lp.orm.SelectLatestBlock(pg.WithParentCtx(ctx))
+		lp.pollMu.Unlock()
+		if err != nil {
+			// Including sql.ErrNoRows, the first poll is yet to happen.
+			return errors.Wrap(err, "unable to get latest block")
+		}
+		end := latest.BlockNumber
+		if start <= end {
+			lp.lggr.Infow("Backfilling logs of filter", "filter", name, "start", start, "end", end)
+		}
+		for from := start; from <= end; from += lp.backfillBatchSize {
+			to := mathutil.Min(from+lp.backfillBatchSize-1, end)
+			if err = lp.backfillQuery(ctx, from, to, query); err != nil {
+				return err
+			}
+			if to < end {
+				if err = lp.orm.AdvanceFilterStartBlock(filter, start, to+1, pg.WithParentCtx(ctx)); err != nil {
+					return err
+				}
+				start = to + 1
+			}
+		}
+		if err = lp.orm.AdvanceFilterStartBlock(filter, start, 0, pg.WithParentCtx(ctx)); err != nil {
+			return err
+		}
+		// Loop, in case an earlier start block has been requested meanwhile.
+	}
+}
+
 // getCurrentBlockMaybeHandleReorg accepts a block number
 // and will return that block if its parent points to our last saved block.
 // One can optionally pass the block header if it has already been queried to avoid an extra RPC call.
@@ -736,6 +1017,7 @@ func (lp *logPoller) getCurrentBlockMaybeHandleReorg(ctx context.Context, curren
 			// We return an error here which will cause us to restart polling from lastBlockSaved + 1
 			return nil, err2
 		}
+		lp.notifyRemoval(blockAfterLCA.Number)
 		return blockAfterLCA, nil
 	}
 	// No reorg, return current block.
@@ -746,8 +1028,10 @@ func (lp *logPoller) getCurrentBlockMaybeHandleReorg(ctx context.Context, curren
 // currentBlockNumber is the block from where new logs are to be polled & saved. Under normal
 // conditions this would be equal to lastProcessed.BlockNumber + 1.
 func (lp *logPoller) PollAndSaveLogs(ctx context.Context, currentBlockNumber int64) {
+	lp.pollMu.Lock()
+	defer lp.pollMu.Unlock()
 	lp.lggr.Debugw("Polling for logs", "currentBlockNumber", currentBlockNumber)
-	latestBlock, err := lp.ec.HeadByNumber(ctx, nil)
+	latestBlock, latestFinalizedBlockNumber, err := lp.latestBlocks(ctx)
 	if err != nil {
 		lp.lggr.Warnw("Unable to get latestBlockNumber block", "err", err, "currentBlockNumber", currentBlockNumber)
 		return
@@ -780,7 +1064,8 @@ func (lp *logPoller) PollAndSaveLogs(ctx context.Context, currentBlockNumber int
 	// E.g. 1<-2<-3(currentBlockNumber)<-4<-5<-6<-7(latestBlockNumber), finality is 2. So 3,4 can be batched.
 	// Although 5 is finalized, we still need to save it to the db for reorg detection if 6 is a reorg.
 	// start = currentBlockNumber = 3, end = latestBlockNumber - finality - 1 = 7-2-1 = 4 (inclusive range).
-	lastSafeBackfillBlock := latestBlockNumber - lp.finalityDepth - 1
+	// With the finality tag enabled, the latest finalized block reported by the RPC is used instead.
+	lastSafeBackfillBlock := latestFinalizedBlockNumber - 1
 	if lastSafeBackfillBlock >= currentBlockNumber {
 		lp.lggr.Infow("Backfilling logs", "start", currentBlockNumber, "end", lastSafeBackfillBlock)
 		if err = lp.backfill(ctx, currentBlockNumber, lastSafeBackfillBlock); err != nil {
@@ -813,24 +1098,26 @@ func (lp *logPoller) PollAndSaveLogs(ctx context.Context, currentBlockNumber int
 			return
 		}
 		lp.lggr.Debugw("Unfinalized log query", "logs", len(logs), "currentBlockNumber", currentBlockNumber, "blockHash", currentBlock.Hash, "timestamp", currentBlock.Timestamp.Unix())
+		lgs := convertLogs(logs,
+			[]LogPollerBlock{{BlockNumber: currentBlockNumber,
+				BlockTimestamp: currentBlock.Timestamp}},
+			lp.lggr,
+			lp.ec.ConfiguredChainID(),
+		)
 		err = lp.orm.q.WithOpts(pg.WithParentCtx(ctx)).Transaction(func(tx pg.Queryer) error {
 			if err2 := lp.orm.InsertBlock(h, currentBlockNumber, currentBlock.Timestamp, pg.WithQueryer(tx)); err2 != nil {
 				return err2
 			}
-			if len(logs) == 0 {
+			if len(lgs) == 0 {
 				return nil
 			}
-			return lp.orm.InsertLogs(convertLogs(logs,
-				[]LogPollerBlock{{BlockNumber: currentBlockNumber,
-					BlockTimestamp: currentBlock.Timestamp}},
-				lp.lggr,
-				lp.ec.ConfiguredChainID(),
-			), pg.WithQueryer(tx))
+			return lp.orm.InsertLogs(lgs, pg.WithQueryer(tx))
 		})
 		if err != nil {
 			lp.lggr.Warnw("Unable to save logs resuming from last saved block + 1", "err", err, "block", currentBlockNumber)
 			return
 		}
+		lp.notifySubscribers(lgs)
 		// Update current block.
 		// Same reorg detection on unfinalized blocks.
 		currentBlockNumber++
@@ -861,9 +1148,21 @@ func (lp *logPoller) findBlockAfterLCA(ctx context.Context, current *evmtypes.He
 	reorgStart := parent.Number
 	// We expect reorgs up to the block after (current - finalityDepth),
 	// since the block at (current - finalityDepth) is finalized.
+	// With the finality tag enabled, we expect reorgs up to the block after the latest finalized block.
 	// We loop via parent instead of current so current always holds the LCA+1.
 	// If the parent block number becomes < the first finalized block our reorg is too deep.
-	for parent.Number >= (reorgStart - lp.finalityDepth) {
+	firstFinalizedBlockNumber := reorgStart - lp.finalityDepth
+	if lp.useFinalityTag {
+		finalized, err2 := lp.ec.LatestFinalizedBlock(ctx)
+		if err2 != nil {
+			return nil, err2
+		}
+		if finalized == nil {
+			return nil, errors.New("received nil finalized block from RPC")
+		}
+		firstFinalizedBlockNumber = finalized.Number
+	}
+	for parent.Number >= firstFinalizedBlockNumber {
 		ourParentBlockHash, err := lp.orm.SelectBlockByNumber(parent.Number, pg.WithParentCtx(ctx))
 		if err != nil {
 			return nil, err
@@ -879,28 +1178,66 @@ func (lp *logPoller) findBlockAfterLCA(ctx context.Context, current *evmtypes.He
 			return nil, err
 		}
 	}
-	lp.lggr.Criticalw("Reorg greater than finality depth detected", "max reorg depth", lp.finalityDepth-1)
+	lp.lggr.Criticalw("Reorg greater than finality depth detected", "finalityTagEnabled", lp.useFinalityTag, "finalizedBlockNumber", firstFinalizedBlockNumber)
 	rerr := errors.New("Reorg greater than finality depth")
 	lp.SvcErrBuffer.Append(rerr)
 	return nil, rerr
 }
 
-// pruneOldBlocks removes blocks that are > lp.ancientBlockDepth behind the head.
+// pruneOldBlocks removes blocks that are > lp.keepBlocksDepth behind the head.
+// The latest finalized block is always kept, since it is needed for reorg detection.
 func (lp *logPoller) pruneOldBlocks(ctx context.Context) error {
-	latest, err := lp.ec.HeadByNumber(ctx, nil)
+	latest, latestFinalizedBlockNumber, err := lp.latestBlocks(ctx)
 	if err != nil {
 		return err
 	}
-	if latest == nil {
-		return errors.Errorf("received nil block from RPC")
-	}
 	if latest.Number <= lp.keepBlocksDepth {
 		// No-op, keep all blocks
 		return nil
 	}
 	// 1-2-3-4-5(latest), keepBlocksDepth=3
 	// Remove <= 2
-	return lp.orm.DeleteBlocksBefore(latest.Number-lp.keepBlocksDepth, pg.WithParentCtx(ctx))
+	return lp.orm.DeleteBlocksBefore(mathutil.Min(latest.Number-lp.keepBlocksDepth, latestFinalizedBlockNumber-1), pg.WithParentCtx(ctx))
+}
+
+// latestBlocks returns the latest block and the number of the latest finalized block.
+// Without the finality tag, the block finalityDepth behind the latest block is taken to be finalized.
+func (lp *logPoller) latestBlocks(ctx context.Context) (*evmtypes.Head, int64, error) {
+	if !lp.useFinalityTag {
+		latest, err := lp.ec.HeadByNumber(ctx, nil)
+		if err != nil {
+			return nil, 0, err
+		}
+		if latest == nil {
+			return nil, 0, errors.Errorf("received nil block from RPC")
+		}
+		return latest, latest.Number - lp.finalityDepth, nil
+	}
+	// Fetch both blocks in a single round trip.
+	reqs := []rpc.BatchElem{
+		{Method: "eth_getBlockByNumber", Args: []interface{}{rpc.LatestBlockNumber.String(), false}, Result: &evmtypes.Head{}},
+		{Method: "eth_getBlockByNumber", Args: []interface{}{rpc.FinalizedBlockNumber.String(), false}, Result: &evmtypes.Head{}},
+	}
+	if err := lp.ec.BatchCallContext(ctx, reqs); err != nil {
+		return nil, 0, err
+	}
+	var blocks []*evmtypes.Head
+	for _, r := range reqs {
+		if r.Error != nil {
+			return nil, 0, r.Error
+		}
+		block, is := r.Result.(*evmtypes.Head)
+		if !is {
+			return nil, 0, errors.Errorf("expected result to be a %T, got %T", &evmtypes.Head{}, r.Result)
+		}
+		if block == nil || block.Hash == (common.Hash{}) {
+			return nil, 0, errors.Errorf("received nil %s block from RPC", r.Args[0])
+		}
+		blocks = append(blocks, block)
+	}
+	latest, finalized := blocks[0], blocks[1]
+	latest.EVMChainID = utils.NewBig(lp.ec.ConfiguredChainID())
+	return latest, finalized.Number, nil
 }
 
 // Logs returns logs matching topics and address (exactly) in the given block range,
@@ -909,6 +1246,15 @@ func (lp *logPoller) Logs(start, end int64, eventSig common.Hash, address common
 	return lp.orm.SelectLogsByBlockRangeFilter(start, end, address, eventSig, qopts...)
 }
 
+// QueryLogs returns a page of the logs matching query, see LogQuery.
+func (lp *logPoller) QueryLogs(query *LogQuery, qopts ...pg.QOpt) (LogPage, error) {
+	logs, err := lp.orm.SelectLogsByQuery(query, qopts...)
+	if err != nil {
+		return LogPage{}, err
+	}
+	return newLogPage(logs, query.limit), nil
+}
+
 func (lp *logPoller) LogsWithSigs(start, end int64, eventSigs []common.Hash, address common.Address, qopts ...pg.QOpt) ([]Log, error) {
 	return lp.orm.SelectLogsWithSigsByBlockRangeFilter(start, end, address, eventSigs, qopts...)
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/jackc/pgconn"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
@@ -59,9 +60,9 @@ func TestLogPoller_RegisterFilter(t *testing.T) {
 	db := pgtest.NewSqlxDB(t)
 
 	orm := NewORM(chainID, db, lggr, pgtest.NewQConfig(true))
-	lp := NewLogPoller(orm, nil, lggr, time.Hour, 1, 1, 2, 1000)
+	lp := NewLogPoller(orm, nil, lggr, time.Hour, false, 1, 1, 2, 1000)
 
-	filter := Filter{"test Filter", []common.Hash{EmitterABI.Events["Log1"].ID}, []common.Address{a1}, 0}
+	filter := Filter{"test Filter", []common.Hash{EmitterABI.Events["Log1"].ID}, []common.Address{a1}, 0, 0}
 	err := lp.RegisterFilter(filter)
 	require.Error(t, err, "RegisterFilter failed to save Filter to db")
 	require.Equal(t, 1, observedLogs.Len())
@@ -75,38 +76,38 @@ func TestLogPoller_RegisterFilter(t *testing.T) {
 	require.NoError(t, utils.JustError(db.Exec(`SET CONSTRAINTS evm_log_poller_filters_evm_chain_id_fkey DEFERRED`)))
 	// Set up a test chain with a log emitting contract deployed.
 
-	lp = NewLogPoller(orm, nil, lggr, time.Hour, 1, 1, 2, 1000)
+	lp = NewLogPoller(orm, nil, lggr, time.Hour, false, 1, 1, 2, 1000)
 
 	// We expect a zero Filter if nothing registered yet.
 	f := lp.Filter(nil, nil, nil)
 	require.Equal(t, 1, len(f.Addresses))
 	assert.Equal(t, common.HexToAddress("0x0000000000000000000000000000000000000000"), f.Addresses[0])
 
-	err = lp.RegisterFilter(Filter{"Emitter Log 1", []common.Hash{EmitterABI.Events["Log1"].ID}, []common.Address{a1}, 0})
+	err = lp.RegisterFilter(Filter{"Emitter Log 1", []common.Hash{EmitterABI.Events["Log1"].ID}, []common.Address{a1}, 0, 0})
 	require.NoError(t, err)
 	assert.Equal(t, []common.Address{a1}, lp.Filter(nil, nil, nil).Addresses)
 	assert.Equal(t, [][]common.Hash{{EmitterABI.Events["Log1"].ID}}, lp.Filter(nil, nil, nil).Topics)
 	validateFiltersTable(t, lp, orm)
 
 	// Should de-dupe EventSigs
-	err = lp.RegisterFilter(Filter{"Emitter Log 1 + 2", []common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, []common.Address{a2}, 0})
+	err = lp.RegisterFilter(Filter{"Emitter Log 1 + 2", []common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, []common.Address{a2}, 0, 0})
 	require.NoError(t, err)
 	assert.Equal(t, []common.Address{a1, a2}, lp.Filter(nil, nil, nil).Addresses)
 	assert.Equal(t, [][]common.Hash{{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}}, lp.Filter(nil, nil, nil).Topics)
 	validateFiltersTable(t, lp, orm)
 
 	// Should de-dupe Addresses
-	err = lp.RegisterFilter(Filter{"Emitter Log 1 + 2 dupe", []common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, []common.Address{a2}, 0})
+	err = lp.RegisterFilter(Filter{"Emitter Log 1 + 2 dupe", []common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, []common.Address{a2}, 0, 0})
 	require.NoError(t, err)
 	assert.Equal(t, []common.Address{a1, a2}, lp.Filter(nil, nil, nil).Addresses)
 	assert.Equal(t, [][]common.Hash{{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}}, lp.Filter(nil, nil, nil).Topics)
 	validateFiltersTable(t, lp, orm)
 
 	// Address required.
-	err = lp.RegisterFilter(Filter{"no address", []common.Hash{EmitterABI.Events["Log1"].ID}, []common.Address{}, 0})
+	err = lp.RegisterFilter(Filter{"no address", []common.Hash{EmitterABI.Events["Log1"].ID}, []common.Address{}, 0, 0})
 	require.Error(t, err)
 	// Event required
-	err = lp.RegisterFilter(Filter{"No event", []common.Hash{}, []common.Address{a1}, 0})
+	err = lp.RegisterFilter(Filter{"No event", []common.Hash{}, []common.Address{a1}, 0, 0})
 	require.Error(t, err)
 	validateFiltersTable(t, lp, orm)
 
@@ -249,7 +250,7 @@ func TestLogPoller_BackupPollerStartup(t *testing.T) {
 
 	ctx := testutils.Context(t)
 
-	lp := NewLogPoller(orm, ec, lggr, 1*time.Hour, 2, 3, 2, 1000)
+	lp := NewLogPoller(orm, ec, lggr, 1*time.Hour, false, 2, 3, 2, 1000)
 	lp.BackupPollAndSaveLogs(ctx, 100)
 	assert.Equal(t, int64(0), lp.backupPollerNextBlock)
 	assert.Equal(t, 1, observedLogs.FilterMessageSnippet("ran before first successful log poller run").Len())
@@ -290,7 +291,7 @@ func TestLogPoller_Replay(t *testing.T) {
 	ec.On("HeadByNumber", mock.Anything, mock.Anything).Return(&head, nil)
 	ec.On("FilterLogs", mock.Anything, mock.Anything).Return([]types.Log{log1}, nil).Once()
 	ec.On("ConfiguredChainID").Return(chainID, nil)
-	lp := NewLogPoller(orm, ec, lggr, time.Hour, 3, 3, 3, 20)
+	lp := NewLogPoller(orm, ec, lggr, time.Hour, false, 3, 3, 3, 20)
 
 	// process 1 log in block 3
 	lp.PollAndSaveLogs(tctx, 4)
@@ -494,9 +495,47 @@ func TestLogPoller_Replay(t *testing.T) {
 	})
 }
 
+func TestLogPoller_FinalityTag(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	chainID := testutils.FixtureChainID
+	db := pgtest.NewSqlxDB(t)
+	orm := NewORM(chainID, db, lggr, pgtest.NewQConfig(true))
+	ctx := testutils.Context(t)
+
+	ec := evmclimocks.NewClient(t)
+	ec.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(b []rpc.BatchElem) bool {
+		return len(b) == 2 && b[0].Args[0] == "latest" && b[1].Args[0] == "finalized"
+	})).Return(nil).Run(func(args mock.Arguments) {
+		elems := args.Get(1).([]rpc.BatchElem)
+		*(elems[0].Result.(*evmtypes.Head)) = evmtypes.Head{Number: 100, Hash: utils.RandomBytes32()}
+		*(elems[1].Result.(*evmtypes.Head)) = evmtypes.Head{Number: 80, Hash: utils.RandomBytes32()}
+	})
+	ec.On("ConfiguredChainID").Return(chainID)
+
+	// keepBlocksDepth is smaller than the distance to the finalized block, which is only allowed with the finality tag.
+	lp := NewLogPoller(orm, ec, lggr, time.Hour, true, 2, 3, 2, 10)
+
+	latest, finalized, err := lp.latestBlocks(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), latest.Number)
+	assert.Equal(t, int64(80), finalized)
+
+	for i := int64(70); i <= 100; i++ {
+		require.NoError(t, orm.InsertBlock(utils.RandomBytes32(), i, time.Now()))
+	}
+	require.NoError(t, lp.pruneOldBlocks(ctx))
+
+	// Blocks before the latest finalized block are pruned, the finalized block itself is kept.
+	_, err = orm.SelectBlockByNumber(79)
+	require.Error(t, err)
+	b, err := orm.SelectBlockByNumber(80)
+	require.NoError(t, err)
+	assert.Equal(t, int64(80), b.BlockNumber)
+}
+
 func benchmarkFilter(b *testing.B, nFilters, nAddresses, nEvents int) {
 	lggr := logger.TestLogger(b)
-	lp := NewLogPoller(nil, nil, lggr, 1*time.Hour, 2, 3, 2, 1000)
+	lp := NewLogPoller(nil, nil, lggr, 1*time.Hour, false, 2, 3, 2, 1000)
 	for i := 0; i < nFilters; i++ {
 		var addresses []common.Address
 		var events []common.Hash
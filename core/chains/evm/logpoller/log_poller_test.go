@@ -115,7 +115,7 @@ func TestLogPoller_Integration(t *testing.T) {
 	th := SetupTH(t, 2, 3, 2)
 	th.Client.Commit() // Block 2. Ensure we have finality number of blocks
 
-	err := th.LogPoller.RegisterFilter(logpoller.Filter{"Integration test", []common.Hash{EmitterABI.Events["Log1"].ID}, []common.Address{th.EmitterAddress1}, 0})
+	err := th.LogPoller.RegisterFilter(logpoller.Filter{"Integration test", []common.Hash{EmitterABI.Events["Log1"].ID}, []common.Address{th.EmitterAddress1}, 0, 0})
 	require.NoError(t, err)
 	require.Len(t, th.LogPoller.Filter(nil, nil, nil).Addresses, 1)
 	require.Len(t, th.LogPoller.Filter(nil, nil, nil).Topics, 1)
@@ -145,7 +145,7 @@ func TestLogPoller_Integration(t *testing.T) {
 	// Now let's update the Filter and replay to get Log2 logs.
 	err = th.LogPoller.RegisterFilter(logpoller.Filter{
 		"Emitter - log2", []common.Hash{EmitterABI.Events["Log2"].ID},
-		[]common.Address{th.EmitterAddress1}, 0,
+		[]common.Address{th.EmitterAddress1}, 0, 0,
 	})
 	require.NoError(t, err)
 	// Replay an invalid block should error
@@ -189,7 +189,7 @@ func Test_BackupLogPoller(t *testing.T) {
 		EmitterABI.Events["Log1"].ID,
 		EmitterABI.Events["Log2"].ID},
 		[]common.Address{th.EmitterAddress1},
-		0}
+		0, 0}
 	err := th.LogPoller.RegisterFilter(filter1)
 	require.NoError(t, err)
 
@@ -201,7 +201,7 @@ func Test_BackupLogPoller(t *testing.T) {
 	err = th.LogPoller.RegisterFilter(
 		logpoller.Filter{"filter2",
 			[]common.Hash{EmitterABI.Events["Log1"].ID},
-			[]common.Address{th.EmitterAddress2}, 0})
+			[]common.Address{th.EmitterAddress2}, 0, 0})
 	require.NoError(t, err)
 
 	defer func() {
@@ -319,7 +319,7 @@ func TestLogPoller_BlockTimestamps(t *testing.T) {
 	addresses := []common.Address{th.EmitterAddress1, th.EmitterAddress2}
 	topics := []common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}
 
-	err := th.LogPoller.RegisterFilter(logpoller.Filter{"convertLogs", topics, addresses, 0})
+	err := th.LogPoller.RegisterFilter(logpoller.Filter{"convertLogs", topics, addresses, 0, 0})
 	require.NoError(t, err)
 
 	blk, err := th.Client.BlockByNumber(ctx, nil)
@@ -420,7 +420,7 @@ func TestLogPoller_SynchronizedWithGeth(t *testing.T) {
 		}, 10e6)
 		_, _, emitter1, err := log_emitter.DeployLogEmitter(owner, ec)
 		require.NoError(t, err)
-		lp := logpoller.NewLogPoller(orm, client.NewSimulatedBackendClient(t, ec, chainID), lggr, 15*time.Second, int64(finalityDepth), 3, 2, 1000)
+		lp := logpoller.NewLogPoller(orm, client.NewSimulatedBackendClient(t, ec, chainID), lggr, 15*time.Second, false, int64(finalityDepth), 3, 2, 1000)
 		for i := 0; i < finalityDepth; i++ { // Have enough blocks that we could reorg the full finalityDepth-1.
 			ec.Commit()
 		}
@@ -492,7 +492,7 @@ func TestLogPoller_PollAndSaveLogs(t *testing.T) {
 	// Set up a log poller listening for log emitter logs.
 	err := th.LogPoller.RegisterFilter(logpoller.Filter{
 		"Test Emitter 1 & 2", []common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID},
-		[]common.Address{th.EmitterAddress1, th.EmitterAddress2}, 0,
+		[]common.Address{th.EmitterAddress1, th.EmitterAddress2}, 0, 0,
 	})
 	require.NoError(t, err)
 
@@ -706,11 +706,11 @@ func TestLogPoller_LoadFilters(t *testing.T) {
 	th := SetupTH(t, 2, 3, 2)
 
 	filter1 := logpoller.Filter{"first Filter", []common.Hash{
-		EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, []common.Address{th.EmitterAddress1, th.EmitterAddress2}, 0}
+		EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, []common.Address{th.EmitterAddress1, th.EmitterAddress2}, 0, 0}
 	filter2 := logpoller.Filter{"second Filter", []common.Hash{
-		EmitterABI.Events["Log2"].ID, EmitterABI.Events["Log3"].ID}, []common.Address{th.EmitterAddress2}, 0}
+		EmitterABI.Events["Log2"].ID, EmitterABI.Events["Log3"].ID}, []common.Address{th.EmitterAddress2}, 0, 0}
 	filter3 := logpoller.Filter{"third Filter", []common.Hash{
-		EmitterABI.Events["Log1"].ID}, []common.Address{th.EmitterAddress1, th.EmitterAddress2}, 0}
+		EmitterABI.Events["Log1"].ID}, []common.Address{th.EmitterAddress1, th.EmitterAddress2}, 0, 0}
 
 	assert.True(t, filter1.Contains(nil))
 	assert.False(t, filter1.Contains(&filter2))
@@ -750,7 +750,7 @@ func TestLogPoller_GetBlocks_Range(t *testing.T) {
 	th := SetupTH(t, 2, 3, 2)
 
 	err := th.LogPoller.RegisterFilter(logpoller.Filter{"GetBlocks Test", []common.Hash{
-		EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, []common.Address{th.EmitterAddress1, th.EmitterAddress2}, 0},
+		EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, []common.Address{th.EmitterAddress1, th.EmitterAddress2}, 0, 0},
 	)
 	require.NoError(t, err)
 
@@ -918,7 +918,7 @@ func TestLogPoller_DBErrorHandling(t *testing.T) {
 	ec.Commit()
 	ec.Commit()
 
-	lp := logpoller.NewLogPoller(o, client.NewSimulatedBackendClient(t, ec, chainID2), lggr, 1*time.Hour, 2, 3, 2, 1000)
+	lp := logpoller.NewLogPoller(o, client.NewSimulatedBackendClient(t, ec, chainID2), lggr, 1*time.Hour, false, 2, 3, 2, 1000)
 
 	err = lp.Replay(ctx, 5) // block number too high
 	require.ErrorContains(t, err, "Invalid replay block number")
@@ -1007,3 +1007,135 @@ func TestNotifyAfterInsert(t *testing.T) {
 		}
 	})
 }
+
+func TestLogPoller_Subscribe(t *testing.T) {
+	t.Parallel()
+	th := SetupTH(t, 2, 3, 2)
+	ctx := testutils.Context(t)
+
+	require.NoError(t, th.LogPoller.RegisterFilter(logpoller.Filter{
+		Name: "Emitter 1", EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID}, Addresses: []common.Address{th.EmitterAddress1},
+	}))
+	require.NoError(t, th.LogPoller.RegisterFilter(logpoller.Filter{
+		Name: "Emitter 2", EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID}, Addresses: []common.Address{th.EmitterAddress2},
+	}))
+
+	_, err := th.LogPoller.Subscribe("Unknown")
+	require.ErrorIs(t, err, logpoller.ErrFilterNotFound)
+	sub, err := th.LogPoller.Subscribe("Emitter 1")
+	require.NoError(t, err)
+	t.Cleanup(sub.Close)
+
+	nextEvent := func() logpoller.LogEvent {
+		select {
+		case event, ok := <-sub.Events():
+			require.True(t, ok, "subscription closed")
+			return event
+		case <-time.After(testutils.WaitTimeout(t)):
+			t.Fatal("timed out waiting for log event")
+		}
+		return logpoller.LogEvent{}
+	}
+
+	// Chain gen <- 1
+	newStart := th.PollAndSaveLogs(ctx, 1)
+
+	// Chain gen <- 1 <- 2 (L1_1 from both emitters)
+	_, err = th.Emitter1.EmitLog1(th.Owner, []*big.Int{big.NewInt(1)})
+	require.NoError(t, err)
+	_, err = th.Emitter2.EmitLog1(th.Owner, []*big.Int{big.NewInt(1)})
+	require.NoError(t, err)
+	th.Client.Commit()
+	newStart = th.PollAndSaveLogs(ctx, newStart)
+
+	// Only the log matching the subscribed filter is delivered.
+	event := nextEvent()
+	assert.Zero(t, event.RemovedFromBlock)
+	require.Len(t, event.Logs, 1)
+	assert.Equal(t, th.EmitterAddress1, event.Logs[0].Address)
+	assert.Equal(t, int64(2), event.Logs[0].BlockNumber)
+	assert.Equal(t, logpoller.EvmWord(1).Bytes(), event.Logs[0].Data)
+
+	// Chain gen <- 1 <- 2 (L1_1)
+	//                \ 2'(L1_2) <- 3
+	lca, err := th.Client.BlockByNumber(ctx, big.NewInt(1))
+	require.NoError(t, err)
+	require.NoError(t, th.Client.Fork(ctx, lca.Hash()))
+	_, err = th.Emitter1.EmitLog1(th.Owner, []*big.Int{big.NewInt(2)})
+	require.NoError(t, err)
+	th.Client.Commit()
+	th.Client.Commit()
+	th.PollAndSaveLogs(ctx, newStart)
+
+	// The removal of the reorged logs is delivered before the logs of the new canonical chain.
+	event = nextEvent()
+	assert.Equal(t, int64(2), event.RemovedFromBlock)
+	assert.Empty(t, event.Logs)
+	event = nextEvent()
+	require.Len(t, event.Logs, 1)
+	assert.Equal(t, int64(2), event.Logs[0].BlockNumber)
+	assert.Equal(t, logpoller.EvmWord(2).Bytes(), event.Logs[0].Data)
+
+	// Unregistering the filter closes its subscriptions.
+	require.NoError(t, th.LogPoller.UnregisterFilter("Emitter 1", nil))
+	select {
+	case _, ok := <-sub.Events():
+		assert.False(t, ok)
+	case <-time.After(testutils.WaitTimeout(t)):
+		t.Fatal("timed out waiting for subscription to close")
+	}
+}
+
+func TestLogPoller_FilterStartBlock(t *testing.T) {
+	t.Parallel()
+	th := SetupTH(t, 2, 3, 2)
+	ctx := testutils.Context(t)
+	log1 := EmitterABI.Events["Log1"].ID
+
+	require.NoError(t, th.LogPoller.RegisterFilter(logpoller.Filter{
+		Name: "Emitter 1", EventSigs: []common.Hash{log1}, Addresses: []common.Address{th.EmitterAddress1},
+	}))
+	countLogs := func(address common.Address) int {
+		lgs, err := th.ORM.SelectLogsByBlockRange(1, 100)
+		require.NoError(t, err)
+		var count int
+		for _, lg := range lgs {
+			if lg.Address == address {
+				count++
+			}
+		}
+		return count
+	}
+
+	// Chain gen <- 1 <- 2 <- ... <- 11, with a log from both emitters in blocks 2 to 11
+	for i := 0; i < 10; i++ {
+		_, err := th.Emitter1.EmitLog1(th.Owner, []*big.Int{big.NewInt(int64(i))})
+		require.NoError(t, err)
+		_, err = th.Emitter2.EmitLog1(th.Owner, []*big.Int{big.NewInt(int64(i))})
+		require.NoError(t, err)
+		th.Client.Commit()
+	}
+	th.PollAndSaveLogs(ctx, 1)
+	require.Equal(t, 10, countLogs(th.EmitterAddress1))
+	require.Zero(t, countLogs(th.EmitterAddress2))
+
+	require.NoError(t, th.LogPoller.Start(ctx))
+	t.Cleanup(func() { assert.NoError(t, th.LogPoller.Close()) })
+
+	// A new filter is backfilled from its start block in the background.
+	require.NoError(t, th.LogPoller.RegisterFilter(logpoller.Filter{
+		Name: "Emitter 2", EventSigs: []common.Hash{log1}, Addresses: []common.Address{th.EmitterAddress2}, StartBlock: 5,
+	}))
+	require.Eventually(t, func() bool {
+		filter, err := th.ORM.LoadFilter("Emitter 2")
+		return err == nil && filter.StartBlock == 0
+	}, testutils.WaitTimeout(t), 100*time.Millisecond)
+	assert.Equal(t, 7, countLogs(th.EmitterAddress2))
+	assert.Equal(t, 10, countLogs(th.EmitterAddress1))
+
+	// A single filter can be replayed.
+	require.ErrorIs(t, th.LogPoller.ReplayFilter(ctx, "Unknown", 1), logpoller.ErrFilterNotFound)
+	require.ErrorIs(t, th.LogPoller.ReplayFilter(ctx, "Emitter 2", 1000), logpoller.ErrInvalidReplayBlock)
+	require.NoError(t, th.LogPoller.ReplayFilter(ctx, "Emitter 2", 2))
+	assert.Equal(t, 10, countLogs(th.EmitterAddress2))
+}
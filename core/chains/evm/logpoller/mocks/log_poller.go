@@ -557,6 +557,37 @@ func (_m *LogPoller) Name() string {
 	return r0
 }
 
+// QueryLogs provides a mock function with given fields: query, qopts
+func (_m *LogPoller) QueryLogs(query *logpoller.LogQuery, qopts ...pg.QOpt) (logpoller.LogPage, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, query)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 logpoller.LogPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*logpoller.LogQuery, ...pg.QOpt) (logpoller.LogPage, error)); ok {
+		return rf(query, qopts...)
+	}
+	if rf, ok := ret.Get(0).(func(*logpoller.LogQuery, ...pg.QOpt) logpoller.LogPage); ok {
+		r0 = rf(query, qopts...)
+	} else {
+		r0 = ret.Get(0).(logpoller.LogPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(*logpoller.LogQuery, ...pg.QOpt) error); ok {
+		r1 = rf(query, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Ready provides a mock function with given fields:
 func (_m *LogPoller) Ready() error {
 	ret := _m.Called()
@@ -604,6 +635,34 @@ func (_m *LogPoller) ReplayAsync(fromBlock int64) {
 	_m.Called(fromBlock)
 }
 
+// ReplayFilter provides a mock function with given fields: ctx, filterName, fromBlock
+func (_m *LogPoller) ReplayFilter(ctx context.Context, filterName string, fromBlock int64) error {
+	ret := _m.Called(ctx, filterName, fromBlock)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) error); ok {
+		r0 = rf(ctx, filterName, fromBlock)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReplayFilterAsync provides a mock function with given fields: filterName, fromBlock
+func (_m *LogPoller) ReplayFilterAsync(filterName string, fromBlock int64) error {
+	ret := _m.Called(filterName, fromBlock)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64) error); ok {
+		r0 = rf(filterName, fromBlock)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *LogPoller) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
@@ -618,6 +677,32 @@ func (_m *LogPoller) Start(_a0 context.Context) error {
 	return r0
 }
 
+// Subscribe provides a mock function with given fields: filterName
+func (_m *LogPoller) Subscribe(filterName string) (*logpoller.LogSubscription, error) {
+	ret := _m.Called(filterName)
+
+	var r0 *logpoller.LogSubscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*logpoller.LogSubscription, error)); ok {
+		return rf(filterName)
+	}
+	if rf, ok := ret.Get(0).(func(string) *logpoller.LogSubscription); ok {
+		r0 = rf(filterName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*logpoller.LogSubscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(filterName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UnregisterFilter provides a mock function with given fields: name, q
 func (_m *LogPoller) UnregisterFilter(name string, q pg.Queryer) error {
 	ret := _m.Called(name, q)
@@ -47,10 +47,10 @@ type ObservedLogPoller struct {
 // NewObservedLogPoller creates an observed version of log poller created by NewLogPoller
 // Please see ObservedLogPoller for more details on how latencies are measured
 func NewObservedLogPoller(orm *ORM, ec Client, lggr logger.Logger, pollPeriod time.Duration,
-	finalityDepth int64, backfillBatchSize int64, rpcBatchSize int64, keepBlocksDepth int64) LogPoller {
+	useFinalityTag bool, finalityDepth int64, backfillBatchSize int64, rpcBatchSize int64, keepBlocksDepth int64) LogPoller {
 
 	return &ObservedLogPoller{
-		LogPoller: NewLogPoller(orm, ec, lggr, pollPeriod, finalityDepth, backfillBatchSize, rpcBatchSize, keepBlocksDepth),
+		LogPoller: NewLogPoller(orm, ec, lggr, pollPeriod, useFinalityTag, finalityDepth, backfillBatchSize, rpcBatchSize, keepBlocksDepth),
 		histogram: lpQueryHistogram,
 	}
 }
@@ -121,6 +121,12 @@ func (o *ObservedLogPoller) LogsDataWordGreaterThan(eventSig common.Hash, addres
 	})
 }
 
+func (o *ObservedLogPoller) QueryLogs(query *LogQuery, qopts ...pg.QOpt) (LogPage, error) {
+	return withObservedQuery(o.histogram, "QueryLogs", common.Address{}, func() (LogPage, error) {
+		return o.LogPoller.QueryLogs(query, qopts...)
+	})
+}
+
 func withObservedQuery[T any](histogram *prometheus.HistogramVec, queryName string, address common.Address, query func() (T, error)) (T, error) {
 	queryStarted := time.Now()
 	defer func() {
@@ -69,7 +69,7 @@ func createObservedPollLogger(t *testing.T) *ObservedLogPoller {
 	db := pgtest.NewSqlxDB(t)
 	orm := NewORM(testutils.NewRandomEVMChainID(), db, lggr, pgtest.NewQConfig(true))
 	return NewObservedLogPoller(
-		orm, nil, lggr, 1, 1, 1, 1, 1000,
+		orm, nil, lggr, 1, false, 1, 1, 1, 1000,
 	).(*ObservedLogPoller)
 }
 
@@ -53,14 +53,86 @@ func (o *ORM) InsertFilter(filter Filter, qopts ...pg.QOpt) (err error) {
 	for _, ev := range filter.EventSigs {
 		events = append(events, ev.Bytes())
 	}
+	// Only the newly added event,address pairs get the start block, the existing ones have already been backfilled
+	// (or are still pending, in which case their start block is kept).
 	return q.ExecQ(`INSERT INTO evm_log_poller_filters
-	  (name, evm_chain_id, retention, created_at, address, event)
+	  (name, evm_chain_id, retention, created_at, start_block, address, event)
 		SELECT * FROM
-			(SELECT $1, $2::NUMERIC, $3::BIGINT, NOW()) x,
+			(SELECT $1, $2::NUMERIC, $3::BIGINT, NOW(), NULLIF($6::BIGINT, 0)) x,
 			(SELECT unnest($4::BYTEA[]) addr) a,
 			(SELECT unnest($5::BYTEA[]) ev) e
 		ON CONFLICT (name, evm_chain_id, address, event) DO UPDATE SET retention=$3::BIGINT;`,
-		filter.Name, utils.NewBig(o.chainID), filter.Retention, addresses, events)
+		filter.Name, utils.NewBig(o.chainID), filter.Retention, addresses, events, filter.StartBlock)
+}
+
+// LoadFilter returns the named filter, merged over all its event,address pairs like in LoadFilters.
+// It returns sql.ErrNoRows if there is no such filter.
+func (o *ORM) LoadFilter(name string, qopts ...pg.QOpt) (Filter, error) {
+	q := o.q.WithOpts(qopts...)
+	var filter Filter
+	err := q.Get(&filter, `SELECT name,
+			ARRAY_AGG(DISTINCT address)::BYTEA[] AS addresses,
+			ARRAY_AGG(DISTINCT event)::BYTEA[] AS event_sigs,
+			MAX(retention) AS retention,
+			COALESCE(MIN(start_block), 0) AS start_block
+		FROM evm_log_poller_filters WHERE name = $1 AND evm_chain_id = $2
+		GROUP BY name`, name, utils.NewBig(o.chainID))
+	return filter, err
+}
+
+// LoadPendingFilter returns the event,address pairs of the named filter which have a pending start block, merged like
+// in LoadFilter, with the earliest pending start block. It returns sql.ErrNoRows if none of the filter's pairs has one.
+func (o *ORM) LoadPendingFilter(name string, qopts ...pg.QOpt) (Filter, error) {
+	q := o.q.WithOpts(qopts...)
+	var filter Filter
+	err := q.Get(&filter, `SELECT name,
+			ARRAY_AGG(DISTINCT address)::BYTEA[] AS addresses,
+			ARRAY_AGG(DISTINCT event)::BYTEA[] AS event_sigs,
+			MAX(retention) AS retention,
+			MIN(start_block) AS start_block
+		FROM evm_log_poller_filters WHERE name = $1 AND evm_chain_id = $2 AND start_block IS NOT NULL
+		GROUP BY name`, name, utils.NewBig(o.chainID))
+	return filter, err
+}
+
+// UpdateFilterStartBlock requests a backfill of the named filter from startBlock, unless an earlier one is already pending.
+// It returns sql.ErrNoRows if there is no such filter.
+func (o *ORM) UpdateFilterStartBlock(name string, startBlock int64, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	res, err := q.Exec(`UPDATE evm_log_poller_filters SET start_block = LEAST(COALESCE(start_block, $3), $3)
+		WHERE name = $1 AND evm_chain_id = $2`, name, utils.NewBig(o.chainID), startBlock)
+	if err != nil {
+		return err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// AdvanceFilterStartBlock records the progress of a backfill of the given filter from block "from", by moving the pending
+// start block of its event,address pairs up to "to", or clearing it once done if "to" is 0. Pairs added to the filter
+// meanwhile, or whose start block has been moved before "from" by UpdateFilterStartBlock, are left untouched so that
+// their backfill is not lost.
+func (o *ORM) AdvanceFilterStartBlock(filter Filter, from, to int64, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	addresses := make([][]byte, 0)
+	events := make([][]byte, 0)
+
+	for _, addr := range filter.Addresses {
+		addresses = append(addresses, addr.Bytes())
+	}
+	for _, ev := range filter.EventSigs {
+		events = append(events, ev.Bytes())
+	}
+	return q.ExecQ(`UPDATE evm_log_poller_filters SET start_block = NULLIF($4::BIGINT, 0)
+		WHERE name = $1 AND evm_chain_id = $2 AND start_block >= $3 AND ($4 = 0 OR start_block < $4)
+		AND address = ANY($5) AND event = ANY($6)`,
+		filter.Name, utils.NewBig(o.chainID), from, to, pq.ByteaArray(addresses), pq.ByteaArray(events))
 }
 
 // DeleteFilter removes all events,address pairs associated with the Filter
@@ -76,7 +148,8 @@ func (o *ORM) LoadFilters(qopts ...pg.QOpt) (map[string]Filter, error) {
 	err := q.Select(&rows, `SELECT name,
 			ARRAY_AGG(DISTINCT address)::BYTEA[] AS addresses, 
 			ARRAY_AGG(DISTINCT event)::BYTEA[] AS event_sigs,
-			MAX(retention) AS retention
+			MAX(retention) AS retention,
+			COALESCE(MIN(start_block), 0) AS start_block
 		FROM evm_log_poller_filters WHERE evm_chain_id = $1
 		GROUP BY name`, utils.NewBig(o.chainID))
 	filters := make(map[string]Filter)
@@ -518,3 +591,17 @@ func (o *ORM) SelectIndexedLogsWithSigsExcluding(sigA, sigB common.Hash, topicIn
 	return logs, nil
 
 }
+
+// SelectLogsByQuery returns up to query limit + 1 logs matching query, so that callers can tell whether there is a next page.
+func (o *ORM) SelectLogsByQuery(query *LogQuery, qopts ...pg.QOpt) ([]Log, error) {
+	stmt, args, err := query.toSQL(utils.NewBig(o.chainID))
+	if err != nil {
+		return nil, err
+	}
+	var logs []Log
+	q := o.q.WithOpts(qopts...)
+	if err = q.Select(&logs, stmt, args...); err != nil {
+		return nil, err
+	}
+	return logs, nil
+}
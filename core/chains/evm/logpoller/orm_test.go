@@ -441,6 +441,68 @@ func insertLogsTopicValueRange(t *testing.T, chainID *big.Int, o *logpoller.ORM,
 	require.NoError(t, o.InsertLogs(lgs))
 }
 
+func TestORM_FilterStartBlock(t *testing.T) {
+	th := SetupTH(t, 2, 3, 2)
+	o := th.ORM
+	event1 := EmitterABI.Events["Log1"].ID
+	event2 := EmitterABI.Events["Log2"].ID
+	assertStartBlock := func(expected int64) {
+		t.Helper()
+		filter, err := o.LoadFilter("filter")
+		require.NoError(t, err)
+		assert.Equal(t, expected, filter.StartBlock)
+	}
+
+	filter := logpoller.Filter{Name: "filter", EventSigs: []common.Hash{event1}, Addresses: []common.Address{th.EmitterAddress1}, StartBlock: 10}
+	require.NoError(t, o.InsertFilter(filter))
+	assertStartBlock(10)
+
+	// Registering the same pairs again, e.g. on restart, does not backfill them again
+	require.NoError(t, o.AdvanceFilterStartBlock(filter, 10, 0))
+	assertStartBlock(0)
+	filter.StartBlock = 5
+	require.NoError(t, o.InsertFilter(filter))
+	assertStartBlock(0)
+
+	// The earliest requested start block wins
+	require.NoError(t, o.UpdateFilterStartBlock("filter", 10))
+	require.NoError(t, o.UpdateFilterStartBlock("filter", 20))
+	assertStartBlock(10)
+	assert.ErrorIs(t, o.UpdateFilterStartBlock("unknown", 1), sql.ErrNoRows)
+
+	// Progress is not recorded over a start block changed meanwhile
+	require.NoError(t, o.AdvanceFilterStartBlock(filter, 10, 15))
+	assertStartBlock(15)
+	require.NoError(t, o.UpdateFilterStartBlock("filter", 12))
+	require.NoError(t, o.AdvanceFilterStartBlock(filter, 15, 0))
+	assertStartBlock(12)
+
+	// New pairs are not cleared by the backfill of the previous ones
+	extended := filter
+	extended.EventSigs = []common.Hash{event1, event2}
+	extended.StartBlock = 12
+	require.NoError(t, o.InsertFilter(extended))
+	require.NoError(t, o.AdvanceFilterStartBlock(filter, 12, 0))
+	assertStartBlock(12)
+	filters, err := o.LoadFilters()
+	require.NoError(t, err)
+	assert.Equal(t, int64(12), filters["filter"].StartBlock)
+	// and only they are left to backfill
+	pending, err := o.LoadPendingFilter("filter")
+	require.NoError(t, err)
+	assert.Equal(t, types.HashArray{event2}, pending.EventSigs)
+	assert.Equal(t, types.AddressArray{th.EmitterAddress1}, pending.Addresses)
+	assert.Equal(t, int64(12), pending.StartBlock)
+
+	require.NoError(t, o.AdvanceFilterStartBlock(extended, 12, 0))
+	assertStartBlock(0)
+	_, err = o.LoadPendingFilter("filter")
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	_, err = o.LoadFilter("unknown")
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
+
 func TestORM_IndexedLogs(t *testing.T) {
 	th := SetupTH(t, 2, 3, 2)
 	o1 := th.ORM
@@ -1001,3 +1063,112 @@ func TestSelectLogsWithSigsExcluding(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, logs, 0)
 }
+
+func TestLogPoller_QueryLogs(t *testing.T) {
+	t.Parallel()
+	th := SetupTH(t, 2, 3, 2)
+	event1 := EmitterABI.Events["Log1"].ID
+	event2 := EmitterABI.Events["Log2"].ID
+	address1 := common.HexToAddress("0x2ab9a2Dc53736b361b72d900CdF9F78F9406fbbb")
+	address2 := common.HexToAddress("0x6E225058950f237371261C985Db6bDe26df2200E")
+	start := time.Now().UTC().Truncate(time.Second)
+
+	// Blocks 1-5 with a log from each address, topic 1 is the block number and data word 0 is 10 * block number
+	var logs []logpoller.Log
+	for blockNum := int64(1); blockNum <= 5; blockNum++ {
+		for logIndex, addr := range []common.Address{address1, address2} {
+			logs = append(logs, logpoller.Log{
+				EvmChainId:     utils.NewBig(th.ChainID),
+				LogIndex:       int64(logIndex),
+				BlockHash:      common.BigToHash(big.NewInt(blockNum)),
+				BlockNumber:    blockNum,
+				BlockTimestamp: start.Add(time.Duration(blockNum) * time.Minute),
+				EventSig:       event1,
+				Topics:         [][]byte{event1[:], logpoller.EvmWord(uint64(blockNum)).Bytes()},
+				Address:        addr,
+				TxHash:         common.HexToHash("0x1234"),
+				Data:           logpoller.EvmWord(uint64(blockNum * 10)).Bytes(),
+			})
+		}
+	}
+	require.NoError(t, th.ORM.InsertLogs(logs))
+	require.NoError(t, th.ORM.InsertBlock(common.BigToHash(big.NewInt(5)), 5, start.Add(5*time.Minute)))
+
+	assertLogs := func(t *testing.T, query *logpoller.LogQuery, expected int) {
+		page, err := th.LogPoller.QueryLogs(query)
+		require.NoError(t, err)
+		assert.Len(t, page.Logs, expected)
+		assert.Nil(t, page.Next)
+	}
+
+	t.Run("address and event", func(t *testing.T) {
+		assertLogs(t, logpoller.NewLogQuery().WithAddresses(address1), 5)
+		assertLogs(t, logpoller.NewLogQuery().WithAddresses(address1, address2).WithEventSigs(event1), 10)
+		assertLogs(t, logpoller.NewLogQuery().WithEventSigs(event2), 0)
+	})
+
+	t.Run("topics and data words", func(t *testing.T) {
+		assertLogs(t, logpoller.NewLogQuery().WithTopicValues(1, logpoller.EvmWord(2), logpoller.EvmWord(4)), 4)
+		assertLogs(t, logpoller.NewLogQuery().WithAddresses(address2).WithTopicRange(1, logpoller.EvmWord(2), logpoller.EvmWord(3)), 2)
+		assertLogs(t, logpoller.NewLogQuery().WithDataWordRange(0, logpoller.EvmWord(30), common.Hash{}), 6)
+		assertLogs(t, logpoller.NewLogQuery().WithDataWordRange(0, logpoller.EvmWord(20), logpoller.EvmWord(20)), 2)
+	})
+
+	t.Run("confirmations, block and time bounds", func(t *testing.T) {
+		assertLogs(t, logpoller.NewLogQuery().WithConfirmations(2), 6)
+		assertLogs(t, logpoller.NewLogQuery().WithBlockRange(2, 3), 4)
+		assertLogs(t, logpoller.NewLogQuery().WithBlockRange(4, 0), 4)
+		assertLogs(t, logpoller.NewLogQuery().WithTimeRange(start.Add(4*time.Minute), time.Time{}), 4)
+		assertLogs(t, logpoller.NewLogQuery().WithTimeRange(start, start.Add(2*time.Minute)), 2)
+	})
+
+	t.Run("pagination", func(t *testing.T) {
+		query := logpoller.NewLogQuery().WithLimit(3)
+		var all []logpoller.Log
+		var pages int
+		for {
+			page, err := th.LogPoller.QueryLogs(query)
+			require.NoError(t, err)
+			all = append(all, page.Logs...)
+			pages++
+			if page.Next == nil {
+				break
+			}
+			last := page.Logs[len(page.Logs)-1]
+			assert.Equal(t, logpoller.LogCursor{BlockNumber: last.BlockNumber, LogIndex: last.LogIndex}, *page.Next)
+			query = query.After(*page.Next)
+		}
+		assert.Equal(t, 4, pages)
+		require.Len(t, all, 10)
+		for i, l := range all {
+			assert.Equal(t, int64(i/2+1), l.BlockNumber)
+			assert.Equal(t, int64(i%2), l.LogIndex)
+		}
+
+		// exactly one full page
+		page, err := th.LogPoller.QueryLogs(logpoller.NewLogQuery().WithAddresses(address1).WithLimit(5))
+		require.NoError(t, err)
+		assert.Len(t, page.Logs, 5)
+		assert.Nil(t, page.Next)
+	})
+
+	t.Run("invalid queries", func(t *testing.T) {
+		_, err := th.LogPoller.QueryLogs(logpoller.NewLogQuery().WithTopicValues(0, event1))
+		assert.EqualError(t, err, "invalid index for topic: 0")
+		_, err = th.LogPoller.QueryLogs(logpoller.NewLogQuery().WithLimit(logpoller.MaxLogQueryLimit + 1))
+		assert.EqualError(t, err, fmt.Sprintf("invalid limit %d, must be in range [1, %d]", logpoller.MaxLogQueryLimit+1, logpoller.MaxLogQueryLimit))
+		_, err = th.LogPoller.QueryLogs(logpoller.NewLogQuery().WithBlockRange(3, 2))
+		assert.EqualError(t, err, "invalid block range [3, 2]")
+		_, err = th.LogPoller.QueryLogs(nil)
+		assert.EqualError(t, err, "missing log query, use NewLogQuery")
+	})
+
+	t.Run("cursor encoding", func(t *testing.T) {
+		cursor := logpoller.LogCursor{BlockNumber: 123, LogIndex: 4}
+		parsed, err := logpoller.ParseLogCursor(cursor.String())
+		require.NoError(t, err)
+		assert.Equal(t, cursor, parsed)
+		_, err = logpoller.ParseLogCursor("123")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,316 @@
+package logpoller
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+const (
+	// DefaultLogQueryLimit is the page size used when a LogQuery does not set one.
+	DefaultLogQueryLimit = 1000
+	// MaxLogQueryLimit bounds the page size of a LogQuery.
+	MaxLogQueryLimit = 10000
+)
+
+// LogCursor identifies a position in the canonical ordering (block_number, log_index) of saved logs.
+// Queries continuing from a cursor return only the logs strictly after it.
+type LogCursor struct {
+	BlockNumber int64
+	LogIndex    int64
+}
+
+func (c LogCursor) String() string {
+	return fmt.Sprintf("%d-%d", c.BlockNumber, c.LogIndex)
+}
+
+// ParseLogCursor parses a cursor previously formatted with LogCursor.String.
+func ParseLogCursor(s string) (LogCursor, error) {
+	block, index, found := strings.Cut(s, "-")
+	if !found {
+		return LogCursor{}, errors.Errorf("invalid log cursor %q", s)
+	}
+	blockNumber, err := strconv.ParseInt(block, 10, 64)
+	if err != nil {
+		return LogCursor{}, errors.Wrapf(err, "invalid log cursor %q", s)
+	}
+	logIndex, err := strconv.ParseInt(index, 10, 64)
+	if err != nil {
+		return LogCursor{}, errors.Wrapf(err, "invalid log cursor %q", s)
+	}
+	return LogCursor{BlockNumber: blockNumber, LogIndex: logIndex}, nil
+}
+
+// LogPage is a single page of the results of a LogQuery.
+type LogPage struct {
+	Logs []Log
+	// Next is the cursor to pass to LogQuery.After to fetch the following page,
+	// or nil if there are no more results.
+	Next *LogCursor
+}
+
+type comparison string
+
+const (
+	eq  comparison = "="
+	gte comparison = ">="
+	lte comparison = "<="
+)
+
+type topicPredicate struct {
+	index  int
+	op     comparison
+	values []common.Hash
+}
+
+type dataWordPredicate struct {
+	index int
+	op    comparison
+	value common.Hash
+}
+
+// LogQuery is a composable query over the logs saved by the log poller. All predicates are AND-ed together,
+// results are ordered by (block_number, log_index) and paginated with a LogCursor.
+//
+//	q := logpoller.NewLogQuery().
+//		WithAddresses(addr).
+//		WithEventSigs(sig).
+//		WithTopicValues(1, requestID).
+//		WithConfirmations(10).
+//		WithLimit(100)
+//	page, err := lp.QueryLogs(q)
+//	for page.Next != nil {
+//		page, err = lp.QueryLogs(q.After(*page.Next))
+//	}
+type LogQuery struct {
+	addresses  []common.Address
+	eventSigs  []common.Hash
+	topics     []topicPredicate
+	dataWords  []dataWordPredicate
+	confs      int
+	fromBlock  *int64
+	toBlock    *int64
+	fromTime   *time.Time
+	toTime     *time.Time
+	limit      int
+	cursor     *LogCursor
+	validation error
+}
+
+// NewLogQuery returns a query matching every log saved for the chain, DefaultLogQueryLimit at a time.
+func NewLogQuery() *LogQuery {
+	return &LogQuery{limit: DefaultLogQueryLimit}
+}
+
+func (q *LogQuery) clone() *LogQuery {
+	c := *q
+	c.addresses = append([]common.Address(nil), q.addresses...)
+	c.eventSigs = append([]common.Hash(nil), q.eventSigs...)
+	c.topics = append([]topicPredicate(nil), q.topics...)
+	c.dataWords = append([]dataWordPredicate(nil), q.dataWords...)
+	return &c
+}
+
+func (q *LogQuery) invalid(err error) *LogQuery {
+	c := q.clone()
+	if c.validation == nil {
+		c.validation = err
+	}
+	return c
+}
+
+// WithAddresses restricts the query to logs emitted by any of addresses.
+func (q *LogQuery) WithAddresses(addresses ...common.Address) *LogQuery {
+	c := q.clone()
+	c.addresses = append(c.addresses, addresses...)
+	return c
+}
+
+// WithEventSigs restricts the query to logs with any of eventSigs.
+func (q *LogQuery) WithEventSigs(eventSigs ...common.Hash) *LogQuery {
+	c := q.clone()
+	c.eventSigs = append(c.eventSigs, eventSigs...)
+	return c
+}
+
+// WithTopicValues restricts the query to logs with any of values at topicIndex (1-3).
+func (q *LogQuery) WithTopicValues(topicIndex int, values ...common.Hash) *LogQuery {
+	if err := validateTopicIndex(topicIndex); err != nil {
+		return q.invalid(err)
+	}
+	c := q.clone()
+	c.topics = append(c.topics, topicPredicate{index: topicIndex, op: eq, values: values})
+	return c
+}
+
+// WithTopicRange restricts the query to logs with a topic value in [valueMin, valueMax] at topicIndex (1-3).
+// Only meaningful for integer topics. A zero valueMax leaves the range unbounded above.
+func (q *LogQuery) WithTopicRange(topicIndex int, valueMin, valueMax common.Hash) *LogQuery {
+	if err := validateTopicIndex(topicIndex); err != nil {
+		return q.invalid(err)
+	}
+	c := q.clone()
+	c.topics = append(c.topics, topicPredicate{index: topicIndex, op: gte, values: []common.Hash{valueMin}})
+	if valueMax != (common.Hash{}) {
+		c.topics = append(c.topics, topicPredicate{index: topicIndex, op: lte, values: []common.Hash{valueMax}})
+	}
+	return c
+}
+
+// WithDataWordRange restricts the query to logs with a data word in [valueMin, valueMax] at wordIndex (0 based).
+// A zero valueMax leaves the range unbounded above.
+func (q *LogQuery) WithDataWordRange(wordIndex int, valueMin, valueMax common.Hash) *LogQuery {
+	if wordIndex < 0 {
+		return q.invalid(errors.Errorf("invalid index for data word: %d", wordIndex))
+	}
+	c := q.clone()
+	c.dataWords = append(c.dataWords, dataWordPredicate{index: wordIndex, op: gte, value: valueMin})
+	if valueMax != (common.Hash{}) {
+		c.dataWords = append(c.dataWords, dataWordPredicate{index: wordIndex, op: lte, value: valueMax})
+	}
+	return c
+}
+
+// WithConfirmations restricts the query to logs with at least confs blocks on top of them.
+func (q *LogQuery) WithConfirmations(confs int) *LogQuery {
+	if confs < 0 {
+		return q.invalid(errors.Errorf("invalid confirmations: %d", confs))
+	}
+	c := q.clone()
+	c.confs = confs
+	return c
+}
+
+// WithBlockRange restricts the query to logs in blocks [from, to]. A zero to leaves the range unbounded above.
+func (q *LogQuery) WithBlockRange(from, to int64) *LogQuery {
+	if from < 0 || (to != 0 && to < from) {
+		return q.invalid(errors.Errorf("invalid block range [%d, %d]", from, to))
+	}
+	c := q.clone()
+	c.fromBlock = &from
+	if to != 0 {
+		c.toBlock = &to
+	} else {
+		c.toBlock = nil
+	}
+	return c
+}
+
+// WithTimeRange restricts the query to logs with a block timestamp in [from, to). Zero times leave that end unbounded.
+func (q *LogQuery) WithTimeRange(from, to time.Time) *LogQuery {
+	if !from.IsZero() && !to.IsZero() && !to.After(from) {
+		return q.invalid(errors.Errorf("invalid time range [%s, %s)", from, to))
+	}
+	c := q.clone()
+	c.fromTime, c.toTime = nil, nil
+	if !from.IsZero() {
+		c.fromTime = &from
+	}
+	if !to.IsZero() {
+		c.toTime = &to
+	}
+	return c
+}
+
+// WithLimit sets the maximum number of logs returned per page.
+func (q *LogQuery) WithLimit(limit int) *LogQuery {
+	if limit <= 0 || limit > MaxLogQueryLimit {
+		return q.invalid(errors.Errorf("invalid limit %d, must be in range [1, %d]", limit, MaxLogQueryLimit))
+	}
+	c := q.clone()
+	c.limit = limit
+	return c
+}
+
+// After continues the query from cursor, typically LogPage.Next of the previous page.
+func (q *LogQuery) After(cursor LogCursor) *LogQuery {
+	c := q.clone()
+	c.cursor = &cursor
+	return c
+}
+
+// toSQL renders the query for chainID. It fetches one more row than the limit so that the caller can tell
+// whether there is a next page.
+func (q *LogQuery) toSQL(chainID *utils.Big) (string, []interface{}, error) {
+	if q == nil {
+		return "", nil, errors.New("missing log query, use NewLogQuery")
+	}
+	if q.validation != nil {
+		return "", nil, q.validation
+	}
+	args := []interface{}{chainID}
+	arg := func(v interface{}) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	where := []string{"evm_chain_id = $1"}
+	if len(q.addresses) > 0 {
+		addrs := make([][]byte, 0, len(q.addresses))
+		for _, addr := range q.addresses {
+			addrs = append(addrs, addr.Bytes())
+		}
+		where = append(where, "address = ANY("+arg(pq.ByteaArray(addrs))+")")
+	}
+	if len(q.eventSigs) > 0 {
+		sigs := make([][]byte, 0, len(q.eventSigs))
+		for _, sig := range q.eventSigs {
+			sigs = append(sigs, sig.Bytes())
+		}
+		where = append(where, "event_sig = ANY("+arg(pq.ByteaArray(sigs))+")")
+	}
+	for _, t := range q.topics {
+		// Add 1 since postgresql arrays are 1-indexed.
+		topic := fmt.Sprintf("topics[%d]", t.index+1)
+		if t.op == eq {
+			values := make([][]byte, 0, len(t.values))
+			for _, v := range t.values {
+				values = append(values, v.Bytes())
+			}
+			where = append(where, topic+" = ANY("+arg(pq.ByteaArray(values))+")")
+		} else {
+			where = append(where, fmt.Sprintf("%s %s %s", topic, t.op, arg(t.values[0].Bytes())))
+		}
+	}
+	for _, w := range q.dataWords {
+		where = append(where, fmt.Sprintf("substring(data from %d for 32) %s %s", 32*w.index+1, w.op, arg(w.value.Bytes())))
+	}
+	if q.confs > 0 {
+		where = append(where, "(block_number + "+arg(q.confs)+") <= (SELECT COALESCE(block_number, 0) FROM evm_log_poller_blocks WHERE evm_chain_id = $1 ORDER BY block_number DESC LIMIT 1)")
+	}
+	if q.fromBlock != nil {
+		where = append(where, "block_number >= "+arg(*q.fromBlock))
+	}
+	if q.toBlock != nil {
+		where = append(where, "block_number <= "+arg(*q.toBlock))
+	}
+	if q.fromTime != nil {
+		where = append(where, "block_timestamp >= "+arg(*q.fromTime))
+	}
+	if q.toTime != nil {
+		where = append(where, "block_timestamp < "+arg(*q.toTime))
+	}
+	if q.cursor != nil {
+		where = append(where, fmt.Sprintf("(block_number, log_index) > (%s, %s)", arg(q.cursor.BlockNumber), arg(q.cursor.LogIndex)))
+	}
+
+	query := `SELECT * FROM evm_logs WHERE ` + strings.Join(where, " AND ") +
+		` ORDER BY block_number, log_index LIMIT ` + arg(q.limit+1)
+	return query, args, nil
+}
+
+// newLogPage builds a page from the up to limit + 1 logs returned for a query.
+func newLogPage(logs []Log, limit int) LogPage {
+	if len(logs) <= limit {
+		return LogPage{Logs: logs}
+	}
+	last := logs[limit-1]
+	return LogPage{Logs: logs[:limit], Next: &LogCursor{BlockNumber: last.BlockNumber, LogIndex: last.LogIndex}}
+}
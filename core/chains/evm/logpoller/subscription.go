@@ -0,0 +1,114 @@
+package logpoller
+
+import (
+	"sync"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// LogEvent is delivered to a LogSubscription after the log poller saved new logs matching the subscribed filter,
+// or after it removed logs because of a reorg.
+type LogEvent struct {
+	// Logs newly saved by the poller, in (block_number, log_index) order.
+	// Logs may be delivered more than once, e.g. after a replay, and consumers are expected to be idempotent.
+	Logs []Log
+	// RemovedFromBlock is non-zero if a reorg was detected, in which case every log saved at or after
+	// this block was removed. Logs from the new canonical chain follow in subsequent events.
+	RemovedFromBlock int64
+}
+
+// LogSubscription streams LogEvents for a single filter. Events are buffered so the poller never blocks on a slow
+// consumer, the oldest events are dropped once the buffer is full.
+type LogSubscription struct {
+	filterName string
+
+	mailbox   *utils.Mailbox[LogEvent]
+	events    chan LogEvent
+	chStop    chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+	onClose   func(*LogSubscription)
+}
+
+func newLogSubscription(filterName string, onClose func(*LogSubscription)) *LogSubscription {
+	s := &LogSubscription{
+		filterName: filterName,
+		mailbox:    utils.NewHighCapacityMailbox[LogEvent](),
+		events:     make(chan LogEvent),
+		chStop:     make(chan struct{}),
+		onClose:    onClose,
+	}
+	s.wg.Add(1)
+	go s.run()
+	return s
+}
+
+// FilterName returns the name of the subscribed filter.
+func (s *LogSubscription) FilterName() string {
+	return s.filterName
+}
+
+// Events returns the channel on which events are delivered. It is closed when the subscription is closed, either by
+// the consumer, by unregistering the filter or by closing the log poller.
+func (s *LogSubscription) Events() <-chan LogEvent {
+	return s.events
+}
+
+// Close stops the subscription and releases its resources. It is safe to call more than once.
+func (s *LogSubscription) Close() {
+	s.closeOnce.Do(func() {
+		close(s.chStop)
+		s.wg.Wait()
+		if s.onClose != nil {
+			s.onClose(s)
+		}
+	})
+}
+
+func (s *LogSubscription) run() {
+	defer s.wg.Done()
+	defer close(s.events)
+	for {
+		select {
+		case <-s.chStop:
+			return
+		case <-s.mailbox.Notify():
+			for {
+				event, exists := s.mailbox.Retrieve()
+				if !exists {
+					break
+				}
+				select {
+				case s.events <- event:
+				case <-s.chStop:
+					return
+				}
+			}
+		}
+	}
+}
+
+// deliverLogs queues the logs matching filter, if any.
+func (s *LogSubscription) deliverLogs(filter Filter, logs []Log) (wasOverCapacity bool) {
+	var matching []Log
+	for _, l := range logs {
+		if filter.matches(l) {
+			matching = append(matching, l)
+		}
+	}
+	if len(matching) == 0 {
+		return false
+	}
+	return s.mailbox.Deliver(LogEvent{Logs: matching})
+}
+
+func (s *LogSubscription) deliverRemoval(fromBlock int64) (wasOverCapacity bool) {
+	return s.mailbox.Deliver(LogEvent{RemovedFromBlock: fromBlock})
+}
+
+// matches returns true if the log was emitted by one of the filter's addresses with one of its event signatures.
+func (filter *Filter) matches(l Log) bool {
+	return slices.Contains(filter.Addresses, l.Address) && slices.Contains(filter.EventSigs, l.EventSig)
+}
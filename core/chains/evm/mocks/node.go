@@ -18,6 +18,8 @@ import (
 
 	rpc "github.com/ethereum/go-ethereum/rpc"
 
+	time "time"
+
 	types "github.com/ethereum/go-ethereum/core/types"
 
 	utils "github.com/smartcontractkit/chainlink/v2/core/utils"
@@ -476,6 +478,30 @@ func (_m *Node) PendingNonceAt(ctx context.Context, account common.Address) (uin
 	return r0, r1
 }
 
+// RPCStats provides a mock function with given fields:
+func (_m *Node) RPCStats() (time.Duration, float64) {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	var r1 float64
+	if rf, ok := ret.Get(0).(func() (time.Duration, float64)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	if rf, ok := ret.Get(1).(func() float64); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(float64)
+	}
+
+	return r0, r1
+}
+
 // SendTransaction provides a mock function with given fields: ctx, tx
 func (_m *Node) SendTransaction(ctx context.Context, tx *types.Transaction) error {
 	ret := _m.Called(ctx, tx)
@@ -15,11 +15,14 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/assets"
 	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	evmconfig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
 	httypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
@@ -32,6 +35,17 @@ type (
 		services.ServiceCtx
 	}
 
+	// Config is the subset of the chain config used by the BalanceMonitor
+	Config interface {
+		BalanceMonitor() evmconfig.BalanceMonitor
+		GasEstimator() evmconfig.GasEstimator
+	}
+
+	// TxStore is the subset of the txmgr.EvmTxStore used to find pending top-up transactions
+	TxStore interface {
+		HasPendingTransactionTo(account gethCommon.Address, toAddress gethCommon.Address, chainID *big.Int, qopts ...pg.QOpt) (exists bool, err error)
+	}
+
 	balanceMonitor struct {
 		utils.StartStopOnce
 		logger         logger.Logger
@@ -42,24 +56,39 @@ type (
 		ethBalances    map[gethCommon.Address]*assets.Eth
 		ethBalancesMtx *sync.RWMutex
 		sleeperTask    utils.SleeperTask
+
+		// Top-ups are disabled if treasury is nil
+		txm      txmgr.TxManager
+		txStore  TxStore
+		treasury *gethCommon.Address
+		cfg      evmconfig.BalanceMonitor
+		gasLimit uint32
 	}
 
 	NullBalanceMonitor struct{}
 )
 
-// NewBalanceMonitor returns a new balanceMonitor
-func NewBalanceMonitor(ethClient evmclient.Client, ethKeyStore keystore.Eth, logger logger.Logger) BalanceMonitor {
+// NewBalanceMonitor returns a new balanceMonitor. If a treasury address is
+// configured, keys whose balance falls below the configured minimum are
+// topped up from the treasury using txm, and txStore is used to tell whether
+// a top-up is still pending.
+func NewBalanceMonitor(ethClient evmclient.Client, ethKeyStore keystore.Eth, cfg Config, txm txmgr.TxManager, txStore TxStore, logger logger.Logger) BalanceMonitor {
 	chainId := ethClient.ConfiguredChainID()
 	bm := &balanceMonitor{
-		utils.StartStopOnce{},
-		logger,
-		ethClient,
-		chainId,
-		chainId.String(),
-		ethKeyStore,
-		make(map[gethCommon.Address]*assets.Eth),
-		new(sync.RWMutex),
-		nil,
+		logger:         logger,
+		ethClient:      ethClient,
+		chainID:        chainId,
+		chainIDStr:     chainId.String(),
+		ethKeyStore:    ethKeyStore,
+		ethBalances:    make(map[gethCommon.Address]*assets.Eth),
+		ethBalancesMtx: new(sync.RWMutex),
+		txm:            txm,
+		txStore:        txStore,
+	}
+	if treasury := cfg.BalanceMonitor().TreasuryAddress(); treasury != nil {
+		bm.treasury = treasury
+		bm.cfg = cfg.BalanceMonitor()
+		bm.gasLimit = cfg.GasEstimator().LimitTransfer()
 	}
 	bm.sleeperTask = utils.NewSleeperTask(&worker{bm: bm})
 	return bm
@@ -67,6 +96,11 @@ func NewBalanceMonitor(ethClient evmclient.Client, ethKeyStore keystore.Eth, log
 
 func (bm *balanceMonitor) Start(ctx context.Context) error {
 	return bm.StartOnce("BalanceMonitor", func() error {
+		if bm.treasury != nil {
+			if err := bm.ethKeyStore.CheckEnabled(*bm.treasury, bm.chainID); err != nil {
+				bm.logger.Errorw("BalanceMonitor: treasury key is not usable, top-ups will fail", "treasury", bm.treasury.Hex(), "err", err)
+			}
+		}
 		// Always query latest balance on start
 		(&worker{bm}).WorkCtx(ctx)
 		return nil
@@ -131,6 +165,42 @@ func (bm *balanceMonitor) updateBalance(ethBal assets.Eth, address gethCommon.Ad
 	}
 }
 
+// topUp sends funds from the treasury to address if its balance is below the
+// minimum configured for that key. At most one top-up per key is in flight at a time, so repeated
+// heads do not result in duplicate transfers. A new top-up is only sent once no transaction from
+// the treasury to the key is pending in the txm, i.e. the previous one has been confirmed or has failed.
+func (bm *balanceMonitor) topUp(ethBal assets.Eth, address gethCommon.Address) {
+	if bm.treasury == nil || address == *bm.treasury {
+		return
+	}
+	if ethBal.ToInt().Cmp(bm.cfg.KeySpecificMinBalance(address).ToInt()) >= 0 {
+		return
+	}
+
+	pending, err := bm.txStore.HasPendingTransactionTo(*bm.treasury, address, bm.chainID)
+	if err != nil {
+		bm.logger.Errorw("BalanceMonitor: failed to check for pending top-up", "address", address.Hex(), "err", err)
+		return
+	}
+	if pending {
+		bm.logger.Debugw("BalanceMonitor: top-up already pending", "address", address.Hex())
+		return
+	}
+
+	amount := new(big.Int).Sub(bm.cfg.KeySpecificTargetBalance(address).ToInt(), ethBal.ToInt())
+	etx, err := bm.txm.SendNativeToken(bm.chainID, *bm.treasury, address, *amount, bm.gasLimit)
+	if err != nil {
+		bm.logger.Errorw("BalanceMonitor: failed to top up key", "address", address.Hex(), "treasury", bm.treasury.Hex(), "err", err)
+		return
+	}
+	bm.logger.Infow(fmt.Sprintf("BalanceMonitor: topping up key %s with %s", address.Hex(), amount.String()),
+		"address", address.Hex(),
+		"treasury", bm.treasury.Hex(),
+		"weiAmount", amount,
+		"ethTxID", etx.ID,
+	)
+}
+
 func (bm *balanceMonitor) GetEthBalance(address gethCommon.Address) *assets.Eth {
 	bm.ethBalancesMtx.RLock()
 	defer bm.ethBalancesMtx.RUnlock()
@@ -208,6 +278,7 @@ func (w *worker) checkAccountBalance(ctx context.Context, address gethCommon.Add
 	} else {
 		ethBal := assets.Eth(*bal)
 		w.bm.updateBalance(ethBal, address)
+		w.bm.topUp(ethBal, address)
 	}
 }
 
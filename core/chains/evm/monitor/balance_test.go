@@ -15,12 +15,18 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/assets"
 	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	evmcfgv2 "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/v2"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/monitor"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	txmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	configtest "github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest/v2"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/evmtest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
 )
 
 var nilBigInt *big.Int
@@ -43,7 +49,7 @@ func TestBalanceMonitor_Start(t *testing.T) {
 		_, k0Addr := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
 		_, k1Addr := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
 
-		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.TestLogger(t))
+		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, evmtest.NewChainScopedConfig(t, cfg).EVM(), nil, nil, logger.TestLogger(t))
 		defer func() { assert.NoError(t, bm.Close()) }()
 
 		k0bal := big.NewInt(42)
@@ -71,7 +77,7 @@ func TestBalanceMonitor_Start(t *testing.T) {
 
 		_, k0Addr := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
 
-		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.TestLogger(t))
+		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, evmtest.NewChainScopedConfig(t, cfg).EVM(), nil, nil, logger.TestLogger(t))
 		defer func() { assert.NoError(t, bm.Close()) }()
 		k0bal := big.NewInt(42)
 
@@ -91,7 +97,7 @@ func TestBalanceMonitor_Start(t *testing.T) {
 
 		_, k0Addr := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
 
-		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.TestLogger(t))
+		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, evmtest.NewChainScopedConfig(t, cfg).EVM(), nil, nil, logger.TestLogger(t))
 		defer func() { assert.NoError(t, bm.Close()) }()
 		ctxCancelledAwaiter := cltest.NewAwaiter()
 
@@ -121,7 +127,7 @@ func TestBalanceMonitor_Start(t *testing.T) {
 
 		_, k0Addr := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
 
-		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.TestLogger(t))
+		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, evmtest.NewChainScopedConfig(t, cfg).EVM(), nil, nil, logger.TestLogger(t))
 		defer func() { assert.NoError(t, bm.Close()) }()
 
 		ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).
@@ -149,7 +155,7 @@ func TestBalanceMonitor_OnNewLongestChain_UpdatesBalance(t *testing.T) {
 		_, k0Addr := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
 		_, k1Addr := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
 
-		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.TestLogger(t))
+		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, evmtest.NewChainScopedConfig(t, cfg).EVM(), nil, nil, logger.TestLogger(t))
 		k0bal := big.NewInt(42)
 		// Deliberately larger than a 64 bit unsigned integer to test overflow
 		k1bal := big.NewInt(0)
@@ -207,7 +213,7 @@ func TestBalanceMonitor_FewerRPCCallsWhenBehind(t *testing.T) {
 
 	ethClient := newEthClientMock(t)
 
-	bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.TestLogger(t))
+	bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, evmtest.NewChainScopedConfig(t, cfg).EVM(), nil, nil, logger.TestLogger(t))
 	ethClient.On("BalanceAt", mock.Anything, mock.Anything, mock.Anything).
 		Once().
 		Return(big.NewInt(1), nil)
@@ -246,6 +252,93 @@ func TestBalanceMonitor_FewerRPCCallsWhenBehind(t *testing.T) {
 	assert.LessOrEqual(t, callCount.Load(), int32(1))
 }
 
+func TestBalanceMonitor_TopUp(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, configtest.NewGeneralConfig(t, nil).Database()).Eth()
+	_, treasury := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+	_, k0Addr := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+	_, k1Addr := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		treasuryAddr := ethkey.EIP55AddressFromAddress(treasury)
+		c.EVM[0].BalanceMonitor.TreasuryAddress = &treasuryAddr
+		c.EVM[0].BalanceMonitor.MinBalance = assets.NewWeiI(100)
+		c.EVM[0].BalanceMonitor.TargetBalance = assets.NewWeiI(500)
+		// k1 keeps a larger float than the other keys
+		k1 := ethkey.EIP55AddressFromAddress(k1Addr)
+		c.EVM[0].KeySpecific = evmcfgv2.KeySpecificConfig{{
+			Key: &k1,
+			BalanceMonitor: evmcfgv2.KeySpecificBalanceMonitor{
+				MinBalance:    assets.NewWeiI(2000),
+				TargetBalance: assets.NewWeiI(3000),
+			},
+		}}
+	})
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	ethClient := newEthClientMock(t)
+	txm := txmmocks.NewMockEvmTxManager(t)
+	txStore := txmmocks.NewEvmTxStore(t)
+
+	bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, evmcfg.EVM(), txm, txStore, logger.TestLogger(t))
+
+	// k0 is below the chain minimum, k1 is below its own minimum, and the treasury itself is never topped up
+	ethClient.On("BalanceAt", mock.Anything, treasury, nilBigInt).Return(big.NewInt(1), nil)
+	var k0Calls atomic.Int32
+	ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).
+		Run(func(mock.Arguments) { k0Calls.Add(1) }).
+		Twice().
+		Return(big.NewInt(40), nil)
+	ethClient.On("BalanceAt", mock.Anything, k1Addr, nilBigInt).Return(big.NewInt(1000), nil)
+	txStore.On("HasPendingTransactionTo", treasury, k0Addr, big.NewInt(0)).Once().Return(false, nil)
+	txStore.On("HasPendingTransactionTo", treasury, k1Addr, big.NewInt(0)).Once().Return(false, nil)
+	txm.On("SendNativeToken", big.NewInt(0), treasury, k0Addr, *big.NewInt(460), evmcfg.EVM().GasEstimator().LimitTransfer()).
+		Once().
+		Return(txmgr.Tx{ID: 1}, nil)
+	txm.On("SendNativeToken", big.NewInt(0), treasury, k1Addr, *big.NewInt(2000), evmcfg.EVM().GasEstimator().LimitTransfer()).
+		Once().
+		Return(txmgr.Tx{ID: 3}, nil)
+
+	require.NoError(t, bm.Start(testutils.Context(t)))
+	defer func() { assert.NoError(t, bm.Close()) }()
+
+	// A pending top-up is not sent again on the next head
+	txStore.On("HasPendingTransactionTo", treasury, k0Addr, big.NewInt(0)).Once().Return(true, nil)
+	txStore.On("HasPendingTransactionTo", treasury, k1Addr, big.NewInt(0)).Return(true, nil)
+	bm.OnNewLongestChain(testutils.Context(t), cltest.Head(0))
+	gomega.NewWithT(t).Eventually(k0Calls.Load).Should(gomega.Equal(int32(2)))
+
+	// Once the balance is back above the minimum, the key is topped up again when it drops
+	ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(big.NewInt(500), nil)
+	bm.OnNewLongestChain(testutils.Context(t), cltest.Head(1))
+	gomega.NewWithT(t).Eventually(func() *big.Int {
+		return bm.GetEthBalance(k0Addr).ToInt()
+	}).Should(gomega.Equal(big.NewInt(500)))
+
+	txStore.On("HasPendingTransactionTo", treasury, k0Addr, big.NewInt(0)).Once().Return(false, nil)
+	txm.On("SendNativeToken", big.NewInt(0), treasury, k0Addr, *big.NewInt(450), evmcfg.EVM().GasEstimator().LimitTransfer()).
+		Once().
+		Return(txmgr.Tx{ID: 2}, nil)
+	ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(big.NewInt(50), nil)
+	bm.OnNewLongestChain(testutils.Context(t), cltest.Head(2))
+	gomega.NewWithT(t).Eventually(func() *big.Int {
+		return bm.GetEthBalance(k0Addr).ToInt()
+	}).Should(gomega.Equal(big.NewInt(50)))
+
+	// A failed top-up is no longer pending in the txm, and is replaced by a new one
+	txStore.On("HasPendingTransactionTo", treasury, k0Addr, big.NewInt(0)).Once().Return(false, nil)
+	sent := make(chan struct{})
+	txm.On("SendNativeToken", big.NewInt(0), treasury, k0Addr, *big.NewInt(460), evmcfg.EVM().GasEstimator().LimitTransfer()).
+		Once().
+		Run(func(mock.Arguments) { close(sent) }).
+		Return(txmgr.Tx{ID: 4}, nil)
+	ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(big.NewInt(40), nil)
+	bm.OnNewLongestChain(testutils.Context(t), cltest.Head(3))
+	cltest.CallbackOrTimeout(t, "top-up resent after fatal error", func() { <-sent })
+}
+
 func Test_ApproximateFloat64(t *testing.T) {
 	t.Parallel()
 
@@ -125,11 +125,22 @@ func (c *evmTxmClient) BatchGetReceipts(ctx context.Context, attempts []TxAttemp
 	return txReceipt, txErr, nil
 }
 
+func (c *evmTxmClient) LatestFinalizedBlockNumber(ctx context.Context) (int64, error) {
+	head, err := c.client.LatestFinalizedBlock(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if head == nil {
+		return 0, errors.New("received nil finalized block from RPC")
+	}
+	return head.Number, nil
+}
+
 // sendEmptyTransaction sends a transaction with 0 Eth and an empty payload to the burn address
 // May be useful for clearing stuck nonces
 func (c *evmTxmClient) SendEmptyTransaction(
 	ctx context.Context,
-	newTxAttempt func(seq evmtypes.Nonce, feeLimit uint32, fee gas.EvmFee, fromAddress common.Address) (attempt TxAttempt, err error),
+	newTxAttempt func(ctx context.Context, seq evmtypes.Nonce, feeLimit uint32, fee gas.EvmFee, fromAddress common.Address) (attempt TxAttempt, err error),
 	seq evmtypes.Nonce,
 	gasLimit uint32,
 	fee gas.EvmFee,
@@ -137,7 +148,7 @@ func (c *evmTxmClient) SendEmptyTransaction(
 ) (txhash string, err error) {
 	defer utils.WrapIfError(&err, "sendEmptyTransaction failed")
 
-	attempt, err := newTxAttempt(seq, gasLimit, fee, fromAddress)
+	attempt, err := newTxAttempt(ctx, seq, gasLimit, fee, fromAddress)
 	if err != nil {
 		return txhash, err
 	}
@@ -0,0 +1,31 @@
+package txmgr_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+func TestEvmTxmClient_LatestFinalizedBlockNumber(t *testing.T) {
+	t.Parallel()
+
+	ethClient := evmclimocks.NewClient(t)
+	client := txmgr.NewEvmTxmClient(ethClient)
+
+	ethClient.On("LatestFinalizedBlock", mock.Anything).Return(&evmtypes.Head{Number: 42}, nil).Once()
+	n, err := client.LatestFinalizedBlockNumber(testutils.Context(t))
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), n)
+
+	// an RPC returning null for the finalized tag
+	ethClient.On("LatestFinalizedBlock", mock.Anything).Return(nil, nil).Once()
+	_, err = client.LatestFinalizedBlockNumber(testutils.Context(t))
+	require.Error(t, err)
+}
@@ -47,6 +47,9 @@ type EvmTxStore interface {
 	// redeclare TxStore for mockery
 	txmgrtypes.TxStore[common.Address, *big.Int, common.Hash, common.Hash, *evmtypes.Receipt, evmtypes.Nonce, gas.EvmFee]
 	TxStoreWebApi
+
+	// HasPendingTransactionTo returns true if a transaction from account to toAddress is not confirmed yet
+	HasPendingTransactionTo(account common.Address, toAddress common.Address, chainID *big.Int, qopts ...pg.QOpt) (exists bool, err error)
 }
 
 // TxStoreWebApi encapsulates the methods that are not used by the txmgr and only used by the various web controllers and readers
@@ -66,7 +69,6 @@ type TestEvmTxStore interface {
 	InsertReceipt(receipt *evmtypes.Receipt) (int64, error)
 	InsertTx(etx *Tx) error
 	FindTxAttemptsByTxIDs(ids []int64) ([]TxAttempt, error)
-	FindTxWithAttempts(etxID int64) (etx Tx, err error)
 	InsertTxAttempt(attempt *TxAttempt) error
 	LoadTxesAttempts(etxs []*Tx, qopts ...pg.QOpt) error
 }
@@ -1276,6 +1278,63 @@ func (o *evmTxStore) UpdateTxFatalError(etx *Tx, qopts ...pg.QOpt) error {
 	})
 }
 
+// UpdateTxUnstartedToFatalError marks an unstarted transaction as fatally
+// errored so that it is never broadcast, e.g. when it is cancelled
+func (o *evmTxStore) UpdateTxUnstartedToFatalError(etx *Tx, qopts ...pg.QOpt) error {
+	qq := o.q.WithOpts(qopts...)
+
+	if etx.State != txmgr.TxUnstarted {
+		return pkgerrors.Errorf("can only transition to fatal_error from unstarted, transaction is currently %s", etx.State)
+	}
+	if !etx.Error.Valid {
+		return errors.New("expected error field to be set")
+	}
+
+	dbEtx := DbEthTxFromEthTx(etx)
+	err := qq.Get(&dbEtx, `UPDATE eth_txes SET state='fatal_error', error=$1 WHERE id=$2 AND state='unstarted' RETURNING *`, etx.Error, etx.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return pkgerrors.Errorf("eth_tx with id %d is no longer unstarted", etx.ID)
+	} else if err != nil {
+		return pkgerrors.Wrap(err, "UpdateTxUnstartedToFatalError failed to save eth_tx")
+	}
+	DbEthTxToEthTx(dbEtx, etx)
+	return nil
+}
+
+// UpdateTxForCancel rewrites an unconfirmed transaction as the zero value
+// transfer to self in etx and inserts the in_progress attempt replacing it.
+// The Confirmer broadcasts the attempt on the next head.
+func (o *evmTxStore) UpdateTxForCancel(etx *Tx, attempt *TxAttempt, qopts ...pg.QOpt) error {
+	qq := o.q.WithOpts(qopts...)
+
+	if etx.State != txmgr.TxUnconfirmed {
+		return pkgerrors.Errorf("can only cancel an unconfirmed transaction by replacement, transaction is currently %s", etx.State)
+	}
+	if attempt.State != txmgrtypes.TxAttemptInProgress {
+		return errors.New("attempt must be in in_progress state")
+	}
+
+	return qq.Transaction(func(tx pg.Queryer) error {
+		dbEtx := DbEthTxFromEthTx(etx)
+		err := tx.Get(&dbEtx, `UPDATE eth_txes SET to_address=$1, value=$2, encoded_payload=$3 WHERE id=$4 AND state='unconfirmed' RETURNING *`, dbEtx.ToAddress, dbEtx.Value, dbEtx.EncodedPayload, dbEtx.ID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return pkgerrors.Errorf("eth_tx with id %d is no longer unconfirmed", etx.ID)
+		} else if err != nil {
+			return pkgerrors.Wrap(err, "UpdateTxForCancel failed to update eth_txes")
+		}
+		DbEthTxToEthTx(dbEtx, etx)
+
+		dbAttempt := DbEthTxAttemptFromEthTxAttempt(attempt)
+		query, args, err := tx.BindNamed(insertIntoEthTxAttemptsQuery, &dbAttempt)
+		if err != nil {
+			return pkgerrors.Wrap(err, "UpdateTxForCancel failed to BindNamed")
+		}
+		err = tx.Get(&dbAttempt, query, args...)
+		DbEthTxAttemptToEthTxAttempt(dbAttempt, attempt)
+		return pkgerrors.Wrap(err, "UpdateTxForCancel failed to insert eth_tx_attempt")
+	})
+}
+
 // Updates eth attempt from in_progress to broadcast. Also updates the eth tx to unconfirmed.
 // Before it updates both tables though it increments the next nonce from the keystore
 // One of the more complicated signatures. We have to accept variable pg.QOpt and QueryerFunc arguments
@@ -1413,6 +1472,12 @@ func (o *evmTxStore) HasInProgressTransaction(account common.Address, chainID *b
 	return exists, pkgerrors.Wrap(err, "hasInProgressTransaction failed")
 }
 
+func (o *evmTxStore) HasPendingTransactionTo(account common.Address, toAddress common.Address, chainID *big.Int, qopts ...pg.QOpt) (exists bool, err error) {
+	qq := o.q.WithOpts(qopts...)
+	err = qq.Get(&exists, `SELECT EXISTS(SELECT 1 FROM eth_txes WHERE state IN ('unstarted', 'in_progress', 'unconfirmed', 'confirmed_missing_receipt') AND from_address = $1 AND to_address = $2 AND evm_chain_id = $3)`, account, toAddress, chainID.String())
+	return exists, pkgerrors.Wrap(err, "hasPendingTransactionTo failed")
+}
+
 func (o *evmTxStore) UpdateKeyNextSequence(newNextNonce, currentNextNonce evmtypes.Nonce, address common.Address, chainID *big.Int, qopts ...pg.QOpt) error {
 	qq := o.q.WithOpts(qopts...)
 	return qq.Transaction(func(tx pg.Queryer) error {
@@ -1342,6 +1342,50 @@ func TestORM_HasInProgressTransaction(t *testing.T) {
 	})
 }
 
+func TestORM_HasPendingTransactionTo(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := newTestChainScopedConfig(t)
+	txStore := cltest.NewTestTxStore(t, db, cfg.Database())
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg.Database()).Eth()
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+	_, toAddress := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+	_, otherAddress := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+
+	t.Run("no pending eth transaction", func(t *testing.T) {
+		exists, err := txStore.HasPendingTransactionTo(fromAddress, toAddress, ethClient.ConfiguredChainID())
+		require.NoError(t, err)
+		require.False(t, exists)
+	})
+
+	etx := cltest.MustCreateUnstartedTx(t, txStore, fromAddress, toAddress, []byte{}, 21000, *big.NewInt(100), ethClient.ConfiguredChainID())
+
+	t.Run("has pending eth transaction", func(t *testing.T) {
+		exists, err := txStore.HasPendingTransactionTo(fromAddress, toAddress, ethClient.ConfiguredChainID())
+		require.NoError(t, err)
+		require.True(t, exists)
+
+		exists, err = txStore.HasPendingTransactionTo(fromAddress, otherAddress, ethClient.ConfiguredChainID())
+		require.NoError(t, err)
+		require.False(t, exists)
+
+		exists, err = txStore.HasPendingTransactionTo(otherAddress, toAddress, ethClient.ConfiguredChainID())
+		require.NoError(t, err)
+		require.False(t, exists)
+	})
+
+	t.Run("failed eth transaction is not pending", func(t *testing.T) {
+		etx.Error = null.StringFrom("failed")
+		require.NoError(t, txStore.UpdateTxUnstartedToFatalError(&etx))
+
+		exists, err := txStore.HasPendingTransactionTo(fromAddress, toAddress, ethClient.ConfiguredChainID())
+		require.NoError(t, err)
+		require.False(t, exists)
+	})
+}
+
 func TestORM_UpdateEthKeyNextNonce(t *testing.T) {
 	t.Parallel()
 
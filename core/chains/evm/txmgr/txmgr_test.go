@@ -19,6 +19,7 @@ import (
 	"github.com/smartcontractkit/sqlx"
 
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	commontxmmocks "github.com/smartcontractkit/chainlink/v2/common/txmgr/types/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/assets"
 	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
@@ -44,7 +45,7 @@ import (
 func makeTestEvmTxm(
 	t *testing.T, db *sqlx.DB, ethClient evmclient.Client, estimator gas.EvmFeeEstimator, ccfg txmgr.ChainConfig, fcfg txmgr.FeeConfig, txConfig evmconfig.Transactions, dbConfig txmgr.DatabaseConfig, listenerConfig txmgr.ListenerConfig, keyStore keystore.Eth, eventBroadcaster pg.EventBroadcaster) (txmgr.TxManager, error) {
 	lggr := logger.TestLogger(t)
-	lp := logpoller.NewLogPoller(logpoller.NewORM(testutils.FixtureChainID, db, lggr, pgtest.NewQConfig(true)), ethClient, lggr, 100*time.Millisecond, 2, 3, 2, 1000)
+	lp := logpoller.NewLogPoller(logpoller.NewORM(testutils.FixtureChainID, db, lggr, pgtest.NewQConfig(true)), ethClient, lggr, 100*time.Millisecond, false, 2, 3, 2, 1000)
 
 	// logic for building components (from evm/evm_txm.go) -------
 	lggr.Infow("Initializing EVM transaction manager",
@@ -441,6 +442,7 @@ func (c *mockConfig) EVM() evmconfig.EVM {
 func (c *mockConfig) NonceAutoSync() bool                                  { return true }
 func (c *mockConfig) ChainType() config.ChainType                          { return "" }
 func (c *mockConfig) FinalityDepth() uint32                                { return c.finalityDepth }
+func (c *mockConfig) FinalityTagEnabled() bool                             { return false }
 func (c *mockConfig) KeySpecificMaxGasPriceWei(common.Address) *assets.Wei { return assets.NewWeiI(0) }
 func (c *mockConfig) RPCDefaultBatchSize() uint32                          { return c.rpcDefaultBatchSize }
 
@@ -693,3 +695,83 @@ func TestTxm_Reset(t *testing.T) {
 		assert.Equal(t, 0, count)
 	})
 }
+
+func TestTxm_Cancel(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	gcfg := configtest.NewTestGeneralConfig(t)
+	cfg := evmtest.NewChainScopedConfig(t, gcfg)
+	kst := cltest.NewKeyStore(t, db, cfg.Database())
+
+	_, addr := cltest.MustInsertRandomKey(t, kst.Eth(), 2)
+	txStore := cltest.NewTestTxStore(t, db, cfg.Database())
+	confirmedTx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, txStore, 0, 1, addr)
+	unconfirmedTx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 1, addr)
+
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	ethClient.On("PendingNonceAt", mock.Anything, addr).Return(uint64(0), nil)
+	ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(nil, nil)
+	ethClient.On("BatchCallContextAll", mock.Anything, mock.Anything).Return(nil).Maybe()
+	eventBroadcaster := pgmocks.NewEventBroadcaster(t)
+	sub := pgmocks.NewSubscription(t)
+	sub.On("Events").Return(make(<-chan pg.Event))
+	sub.On("Close")
+	eventBroadcaster.On("Subscribe", "insert_on_eth_txes", "").Return(sub, nil)
+
+	estimator := gas.NewEstimator(logger.TestLogger(t), ethClient, cfg.EVM(), cfg.EVM().GasEstimator())
+	txm, err := makeTestEvmTxm(t, db, ethClient, estimator, cfg.EVM(), cfg.EVM().GasEstimator(), cfg.EVM().Transactions(), cfg.Database(), cfg.Database().Listener(), kst.Eth(), eventBroadcaster)
+	require.NoError(t, err)
+
+	t.Run("returns error if not started", func(t *testing.T) {
+		_, err := txm.Cancel(testutils.Context(t), unconfirmedTx.ID)
+		require.EqualError(t, err, "not started")
+	})
+
+	require.NoError(t, txm.Start(testutils.Context(t)))
+	defer func() { assert.NoError(t, txm.Close()) }()
+
+	t.Run("marks unstarted transaction as fatally errored", func(t *testing.T) {
+		unstartedTx := cltest.MustCreateUnstartedTx(t, txStore, addr, testutils.NewAddress(), []byte{1, 2, 3}, 21000, *big.NewInt(1), &cltest.FixtureChainID)
+
+		etx, err := txm.Cancel(testutils.Context(t), unstartedTx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxFatalError, etx.State)
+
+		etx, err = txStore.FindTxWithAttempts(unstartedTx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxFatalError, etx.State)
+		assert.Equal(t, "cancelled", etx.Error.String)
+		assert.Nil(t, etx.Sequence)
+	})
+
+	t.Run("replaces unconfirmed transaction with zero value transfer to self", func(t *testing.T) {
+		etx, err := txm.Cancel(testutils.Context(t), unconfirmedTx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxUnconfirmed, etx.State)
+
+		etx, err = txStore.FindTxWithAttempts(unconfirmedTx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxUnconfirmed, etx.State)
+		assert.Equal(t, addr, etx.ToAddress)
+		assert.Equal(t, int64(0), etx.Value.Int64())
+		assert.Empty(t, etx.EncodedPayload)
+		assert.Equal(t, unconfirmedTx.Sequence, etx.Sequence)
+
+		require.Len(t, etx.TxAttempts, 2)
+		replacement := etx.TxAttempts[0]
+		assert.Equal(t, txmgrtypes.TxAttemptInProgress, replacement.State)
+		assert.Equal(t, 1, replacement.TxFee.Legacy.Cmp(unconfirmedTx.TxAttempts[0].TxFee.Legacy))
+	})
+
+	t.Run("returns error for confirmed transaction", func(t *testing.T) {
+		_, err := txm.Cancel(testutils.Context(t), confirmedTx.ID)
+		require.EqualError(t, err, fmt.Sprintf("transaction %d cannot be cancelled in state confirmed", confirmedTx.ID))
+	})
+
+	t.Run("returns error for unknown transaction", func(t *testing.T) {
+		_, err := txm.Cancel(testutils.Context(t), 1_000_000)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to find transaction 1000000")
+	})
+}
@@ -301,6 +301,11 @@ func NewApp(s *Shell) *cli.App {
 			Usage:       "Commands for managing forwarder addresses.",
 			Subcommands: initFowardersSubCmds(s),
 		},
+		{
+			Name:        "vrf",
+			Usage:       "Commands for inspecting VRF jobs.",
+			Subcommands: initVRFSubCmds(s),
+		},
 	}...)
 	return app
 }
@@ -32,6 +32,10 @@ func initBlocksSubCmds(s *Shell) []cli.Command {
 					Usage:    "Chain ID of the EVM-based blockchain",
 					Required: false,
 				},
+				cli.StringFlag{
+					Name:  "filter",
+					Usage: "Name of the log poller filter to replay, instead of replaying all of them",
+				},
 			},
 		},
 	}
@@ -51,6 +55,10 @@ func (s *Shell) ReplayFromBlock(c *cli.Context) (err error) {
 		v.Add("evmChainID", fmt.Sprintf("%d", c.Int64("evm-chain-id")))
 	}
 
+	if c.IsSet("filter") {
+		v.Add("filter", c.String("filter"))
+	}
+
 	buf := bytes.NewBufferString("{}")
 	resp, err := s.HTTP.Post(
 		fmt.Sprintf(
@@ -41,4 +41,9 @@ func Test_ReplayFromBlock(t *testing.T) {
 	require.NoError(t, set.Set("evm-chain-id", "5"))
 	c = cli.NewContext(nil, set, nil)
 	require.NoError(t, client.ReplayFromBlock(c))
+
+	//Filter replay requires the log poller
+	require.NoError(t, set.Set("filter", "upkeep"))
+	c = cli.NewContext(nil, set, nil)
+	require.ErrorContains(t, client.ReplayFromBlock(c), "log poller disabled")
 }
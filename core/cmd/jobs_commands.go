@@ -45,6 +45,16 @@ func initJobsSubCmds(s *Shell) []cli.Command {
 			Usage:  "Delete a job",
 			Action: s.DeleteJob,
 		},
+		{
+			Name:   "pause",
+			Usage:  "Pause a job, stopping its services without deleting it",
+			Action: s.PauseJob,
+		},
+		{
+			Name:   "resume",
+			Usage:  "Resume a paused job",
+			Action: s.ResumeJob,
+		},
 		{
 			Name:   "run",
 			Usage:  "Trigger a job run",
@@ -286,6 +296,42 @@ func (s *Shell) DeleteJob(c *cli.Context) error {
 	return nil
 }
 
+// PauseJob stops the services of a job without deleting it
+func (s *Shell) PauseJob(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the job id to be paused"))
+	}
+	resp, err := s.HTTP.Post("/v2/jobs/"+c.Args().First()+"/pause", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &JobPresenter{}, fmt.Sprintf("Job %v Paused", c.Args().First()))
+}
+
+// ResumeJob starts the services of a paused job
+func (s *Shell) ResumeJob(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the job id to be resumed"))
+	}
+	resp, err := s.HTTP.Post("/v2/jobs/"+c.Args().First()+"/resume", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &JobPresenter{}, fmt.Sprintf("Job %v Resumed", c.Args().First()))
+}
+
 // TriggerPipelineRun triggers a job run based on a job ID
 func (s *Shell) TriggerPipelineRun(c *cli.Context) error {
 	if !c.Args().Present() {
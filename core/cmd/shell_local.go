@@ -34,10 +34,12 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/build"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
@@ -113,6 +115,28 @@ func initLocalSubCmds(s *Shell, safe bool) []cli.Command {
 				},
 			},
 		},
+		{
+			Name:   "import-logs",
+			Usage:  "Bulk import the logs of registered log poller filters from a JSONL or CSV export of another node's evm_logs table. Logs are verified against the blocks saved by the log poller, so new nodes can bootstrap without replaying history",
+			Action: s.ImportLogs,
+			Before: s.validateDB,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:     "file, f",
+					Usage:    "path of the export to import",
+					Required: true,
+				},
+				cli.StringFlag{
+					Name:  "format",
+					Usage: "format of the export, `jsonl` or `csv`. If left blank, it is inferred from the file extension",
+				},
+				cli.StringFlag{
+					Name:     "evmChainID, evm-chain-id",
+					Usage:    "Chain ID of the logs to import",
+					Required: true,
+				},
+			},
+		},
 		{
 			Name:   "status",
 			Usage:  "Displays the health of various services running inside the node.",
@@ -366,6 +390,13 @@ func (s *Shell) runNode(c *cli.Context) error {
 		}
 
 		for _, ch := range evmChainSet.Chains() {
+			for addr, u := range ch.Config().EVM().KeySpecificExternalSignerURLs() {
+				lggr.Debugf("Registering external signer %s for EVM key %s on chain %s", u.Host, addr, ch.ID())
+				err2 := app.GetKeyStore().Eth().AddExternal(addr, keystore.NewWeb3Signer(u, http.DefaultClient), ch.ID())
+				if err2 != nil {
+					return errors.Wrapf(err2, "failed to register external signer for key %s", addr)
+				}
+			}
 			if ch.Config().EVM().AutoCreateKey() {
 				lggr.Debugf("AutoCreateKey=true, will ensure EVM key for chain %s", ch.ID())
 				err2 := app.GetKeyStore().Eth().EnsureKeys(ch.ID())
@@ -632,6 +663,38 @@ func (s *Shell) RebroadcastTransactions(c *cli.Context) (err error) {
 	return s.errorOut(err)
 }
 
+// ImportLogs bulk imports logs for the registered log poller filters of a chain.
+func (s *Shell) ImportLogs(c *cli.Context) (err error) {
+	chainID, ok := big.NewInt(0).SetString(c.String("evmChainID"), 10)
+	if !ok {
+		return s.errorOut(errors.New("invalid evmChainID"))
+	}
+	filePath := c.String("file")
+	format := logpoller.ImportFormat(c.String("format"))
+	if format == "" {
+		format = logpoller.ImportFormat(strings.TrimPrefix(filepath.Ext(filePath), "."))
+	}
+
+	f, err := os.Open(filePath)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "opening export"))
+	}
+	defer f.Close()
+
+	lggr := logger.Sugared(s.Logger.Named("ImportLogs"))
+	db, err := newConnection(s.Config.Database())
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "opening DB"))
+	}
+	defer lggr.ErrorIfFn(db.Close, "Error closing db")
+
+	orm := logpoller.NewORM(chainID, db, lggr, s.Config.Database())
+	stats, err := logpoller.ImportLogs(context.Background(), orm, f, format, lggr)
+	lggr.Infow("Imported logs", "read", stats.Read, "imported", stats.Imported, "unfiltered", stats.Unfiltered,
+		"aheadOfPoller", stats.AheadOfPoller, "blockHashMismatch", stats.BlockHashMismatch)
+	return s.errorOut(err)
+}
+
 type HealthCheckPresenter struct {
 	webPresenters.Check
 }
@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initVRFSubCmds(s *Shell) []cli.Command {
+	return []cli.Command{
+		{
+			Name:   "pending-requests",
+			Usage:  "List the VRF v2 requests that have not been fulfilled yet, and why their last attempt failed",
+			Action: s.ListVRFPendingRequests,
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  "page",
+					Usage: "page of results to display",
+				},
+			},
+		},
+	}
+}
+
+type VRFPendingRequestPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.VRFPendingRequestResource
+}
+
+var vrfPendingRequestsHeaders = []string{"ID", "Job ID", "Chain ID", "Request ID", "Sub ID", "Confirmed At Block", "Attempts", "Last Try At", "Last Error", "Created At"}
+
+// ToRow presents the VRFPendingRequestResource as a slice of strings.
+func (p *VRFPendingRequestPresenter) ToRow() []string {
+	var lastTryAt, lastError string
+	if p.LastTryAt != nil {
+		lastTryAt = p.LastTryAt.Format(time.RFC3339)
+	}
+	if p.LastError != nil {
+		lastError = *p.LastError
+	}
+	row := []string{
+		p.GetID(),
+		strconv.FormatInt(int64(p.JobID), 10),
+		p.EVMChainID.ToInt().String(),
+		p.RequestID.ToInt().String(),
+		strconv.FormatUint(p.SubID, 10),
+		strconv.FormatUint(p.ConfirmedAtBlock, 10),
+		strconv.Itoa(p.Attempts),
+		lastTryAt,
+		lastError,
+		p.CreatedAt.Format(time.RFC3339),
+	}
+	return row
+}
+
+// RenderTable implements TableRenderer
+func (p *VRFPendingRequestPresenter) RenderTable(rt RendererTable) error {
+	renderList(vrfPendingRequestsHeaders, [][]string{p.ToRow()}, rt.Writer)
+	return nil
+}
+
+// VRFPendingRequestPresenters implements TableRenderer for a slice of VRFPendingRequestPresenter.
+type VRFPendingRequestPresenters []VRFPendingRequestPresenter
+
+// RenderTable implements TableRenderer
+func (ps VRFPendingRequestPresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	renderList(vrfPendingRequestsHeaders, rows, rt.Writer)
+
+	return nil
+}
+
+// ListVRFPendingRequests lists the VRF v2 requests that are waiting to be fulfilled.
+func (s *Shell) ListVRFPendingRequests(c *cli.Context) (err error) {
+	return s.getPage("/v2/vrf/pending_requests", c.Int("page"), &VRFPendingRequestPresenters{})
+}
@@ -0,0 +1,65 @@
+package cmd_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/cmd"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestVRFPendingRequestPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		lastTryAt = time.Now()
+		lastError = "insufficient subscription balance: 200 juels needed, 100 available"
+		createdAt = time.Now().Add(-time.Minute)
+		buffer    = bytes.NewBufferString("")
+		r         = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.VRFPendingRequestPresenter{
+		VRFPendingRequestResource: presenters.VRFPendingRequestResource{
+			JAID:               presenters.NewJAID("1"),
+			JobID:              2,
+			EVMChainID:         *utils.NewBigI(4),
+			RequestID:          *utils.NewBigI(12345),
+			SubID:              6,
+			ConfirmedAtBlock:   110,
+			RequestTxHash:      common.HexToHash("0x1"),
+			RequestBlockNumber: 100,
+			Attempts:           3,
+			LastTryAt:          &lastTryAt,
+			LastError:          &lastError,
+			CreatedAt:          createdAt,
+			UpdatedAt:          lastTryAt,
+		},
+	}
+
+	// Render a single resource
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "12345")
+	assert.Contains(t, output, "110")
+	assert.Contains(t, output, lastTryAt.Format(time.RFC3339))
+	assert.Contains(t, output, lastError)
+	assert.Contains(t, output, createdAt.Format(time.RFC3339))
+
+	// Render many resources, without a failed attempt
+	buffer.Reset()
+	p.LastTryAt, p.LastError = nil, nil
+	ps := cmd.VRFPendingRequestPresenters{p}
+	require.NoError(t, ps.RenderTable(r))
+
+	output = buffer.String()
+	assert.Contains(t, output, "12345")
+	assert.NotContains(t, output, lastError)
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink/cfgtest"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 )
 
 func TestDoc(t *testing.T) {
@@ -50,8 +51,9 @@ func TestDoc(t *testing.T) {
 
 		// clean up KeySpecific as a special case
 		require.Equal(t, 1, len(docDefaults.KeySpecific))
-		ks := evmcfg.KeySpecific{Key: new(ethkey.EIP55Address),
-			GasEstimator: evmcfg.KeySpecificGasEstimator{PriceMax: new(assets.Wei)}}
+		ks := evmcfg.KeySpecific{Key: new(ethkey.EIP55Address), ExternalSignerURL: new(models.URL),
+			BalanceMonitor: evmcfg.KeySpecificBalanceMonitor{MinBalance: new(assets.Wei), TargetBalance: new(assets.Wei)},
+			GasEstimator:   evmcfg.KeySpecificGasEstimator{PriceMax: new(assets.Wei)}}
 		require.Equal(t, ks, docDefaults.KeySpecific[0])
 		docDefaults.KeySpecific = nil
 
@@ -80,6 +82,14 @@ func TestDoc(t *testing.T) {
 		docDefaults.LinkContractAddress = nil
 		docDefaults.OperatorFactoryAddress = nil
 
+		// top-ups are disabled unless a treasury is configured
+		require.Zero(t, *docDefaults.BalanceMonitor.TreasuryAddress)
+		require.Zero(t, *docDefaults.BalanceMonitor.MinBalance)
+		require.Zero(t, *docDefaults.BalanceMonitor.TargetBalance)
+		docDefaults.BalanceMonitor.TreasuryAddress = nil
+		docDefaults.BalanceMonitor.MinBalance = nil
+		docDefaults.BalanceMonitor.TargetBalance = nil
+
 		assertTOML(t, fallbackDefaults, docDefaults)
 	})
 
@@ -36,6 +36,7 @@ var (
 	EnvPyroscopeAuthToken           = EnvSecret("CL_PYROSCOPE_AUTH_TOKEN")
 	EnvPrometheusAuthToken          = EnvSecret("CL_PROMETHEUS_AUTH_TOKEN")
 	EnvThresholdKeyShare            = EnvSecret("CL_THRESHOLD_KEY_SHARE")
+	EnvWebServerOIDCClientSecret    = EnvSecret("CL_WEBSERVER_OIDC_CLIENT_SECRET")
 )
 
 type Env string
@@ -127,6 +127,7 @@ type Secrets struct {
 	Prometheus PrometheusSecrets        `toml:",omitempty"`
 	Mercury    MercurySecrets           `toml:",omitempty"`
 	Threshold  ThresholdKeyShareSecrets `toml:",omitempty"`
+	WebServer  WebServerSecrets         `toml:",omitempty"`
 }
 
 func dbURLPasswordComplexity(err error) string {
@@ -495,6 +496,7 @@ type WebServer struct {
 	ListenIP                *net.IP
 
 	MFA       WebServerMFA       `toml:",omitempty"`
+	OIDC      WebServerOIDC      `toml:",omitempty"`
 	RateLimit WebServerRateLimit `toml:",omitempty"`
 	TLS       WebServerTLS       `toml:",omitempty"`
 }
@@ -535,6 +537,7 @@ func (w *WebServer) setFrom(f *WebServer) {
 	}
 
 	w.MFA.setFrom(&f.MFA)
+	w.OIDC.setFrom(&f.OIDC)
 	w.RateLimit.setFrom(&f.RateLimit)
 	w.TLS.setFrom(&f.TLS)
 }
@@ -553,6 +556,76 @@ func (w *WebServerMFA) setFrom(f *WebServerMFA) {
 	}
 }
 
+type WebServerOIDC struct {
+	Enabled     *bool
+	IssuerURL   *models.URL
+	ClientID    *string
+	GroupsClaim *string
+	AdminGroup  *string
+	EditGroup   *string
+	RunGroup    *string
+	ViewGroup   *string
+
+	AllowLocalLogin *bool
+}
+
+func (w *WebServerOIDC) setFrom(f *WebServerOIDC) {
+	if v := f.Enabled; v != nil {
+		w.Enabled = v
+	}
+	if v := f.IssuerURL; v != nil {
+		w.IssuerURL = v
+	}
+	if v := f.ClientID; v != nil {
+		w.ClientID = v
+	}
+	if v := f.GroupsClaim; v != nil {
+		w.GroupsClaim = v
+	}
+	if v := f.AdminGroup; v != nil {
+		w.AdminGroup = v
+	}
+	if v := f.EditGroup; v != nil {
+		w.EditGroup = v
+	}
+	if v := f.RunGroup; v != nil {
+		w.RunGroup = v
+	}
+	if v := f.ViewGroup; v != nil {
+		w.ViewGroup = v
+	}
+	if v := f.AllowLocalLogin; v != nil {
+		w.AllowLocalLogin = v
+	}
+}
+
+func (w *WebServerOIDC) ValidateConfig() (err error) {
+	if w.Enabled == nil || !*w.Enabled {
+		return
+	}
+	if w.IssuerURL == nil || w.IssuerURL.IsZero() {
+		err = multierr.Append(err, ErrMissing{Name: "IssuerURL", Msg: "required when OIDC is enabled"})
+	} else if w.IssuerURL.Scheme != "https" && w.IssuerURL.Scheme != "http" {
+		err = multierr.Append(err, ErrInvalid{Name: "IssuerURL", Value: w.IssuerURL.Scheme, Msg: "must be http or https"})
+	}
+	if w.ClientID == nil || *w.ClientID == "" {
+		err = multierr.Append(err, ErrMissing{Name: "ClientID", Msg: "required when OIDC is enabled"})
+	}
+	if w.GroupsClaim == nil || *w.GroupsClaim == "" {
+		err = multierr.Append(err, ErrEmpty{Name: "GroupsClaim", Msg: "required when OIDC is enabled"})
+	}
+	var groups int
+	for _, g := range []*string{w.AdminGroup, w.EditGroup, w.RunGroup, w.ViewGroup} {
+		if g != nil && *g != "" {
+			groups++
+		}
+	}
+	if groups == 0 {
+		err = multierr.Append(err, ErrMissing{Name: "AdminGroup", Msg: "at least one of AdminGroup, EditGroup, RunGroup or ViewGroup is required when OIDC is enabled"})
+	}
+	return
+}
+
 type WebServerRateLimit struct {
 	Authenticated         *int64
 	AuthenticatedPeriod   *models.Duration
@@ -612,6 +685,7 @@ type JobPipeline struct {
 	ReaperInterval            *models.Duration
 	ReaperThreshold           *models.Duration
 	ResultWriteQueueDepth     *uint32
+	SignTaskEnabled           *bool
 
 	HTTPRequest JobPipelineHTTPRequest `toml:",omitempty"`
 }
@@ -635,6 +709,9 @@ func (j *JobPipeline) setFrom(f *JobPipeline) {
 	if v := f.ResultWriteQueueDepth; v != nil {
 		j.ResultWriteQueueDepth = v
 	}
+	if v := f.SignTaskEnabled; v != nil {
+		j.SignTaskEnabled = v
+	}
 	j.HTTPRequest.setFrom(&f.HTTPRequest)
 
 }
@@ -1126,3 +1203,11 @@ func (m *MercurySecrets) ValidateConfig() (err error) {
 type ThresholdKeyShareSecrets struct {
 	ThresholdKeyShare *models.Secret
 }
+
+type WebServerSecrets struct {
+	OIDC WebServerOIDCSecrets `toml:",omitempty"`
+}
+
+type WebServerOIDCSecrets struct {
+	ClientSecret *models.Secret
+}
@@ -32,6 +32,19 @@ type MFA interface {
 	RPOrigin() string
 }
 
+type OIDC interface {
+	Enabled() bool
+	IssuerURL() *url.URL
+	ClientID() string
+	ClientSecret() string
+	GroupsClaim() string
+	AdminGroup() string
+	EditGroup() string
+	RunGroup() string
+	ViewGroup() string
+	AllowLocalLogin() bool
+}
+
 type WebServer interface {
 	AllowOrigins() string
 	BridgeCacheTTL() time.Duration
@@ -49,4 +62,5 @@ type WebServer interface {
 	TLS() TLS
 	RateLimit() RateLimit
 	MFA() MFA
+	OIDC() OIDC
 }
@@ -377,6 +377,20 @@ func (_m *Application) ReplayFromBlock(chainID *big.Int, number uint64, forceBro
 	return r0
 }
 
+// ReplayLogPollerFilter provides a mock function with given fields: chainID, filterName, number
+func (_m *Application) ReplayLogPollerFilter(chainID *big.Int, filterName string, number uint64) error {
+	ret := _m.Called(chainID, filterName, number)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*big.Int, string, uint64) error); ok {
+		r0 = rf(chainID, filterName, number)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ResumeJobV2 provides a mock function with given fields: ctx, taskID, result
 func (_m *Application) ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error {
 	ret := _m.Called(ctx, taskID, result)
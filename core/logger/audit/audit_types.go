@@ -4,13 +4,15 @@ type EventID string
 
 // Static audit log event type constants
 const (
-	AuthLoginFailedEmail    EventID = "AUTH_LOGIN_FAILED_EMAIL"
-	AuthLoginFailedPassword EventID = "AUTH_LOGIN_FAILED_PASSWORD"
-	AuthLoginFailed2FA      EventID = "AUTH_LOGIN_FAILED_2FA"
-	AuthLoginSuccessWith2FA EventID = "AUTH_LOGIN_SUCCESS_WITH_2FA"
-	AuthLoginSuccessNo2FA   EventID = "AUTH_LOGIN_SUCCESS_NO_2FA"
-	Auth2FAEnrolled         EventID = "AUTH_2FA_ENROLLED"
-	AuthSessionDeleted      EventID = "SESSION_DELETED"
+	AuthLoginFailedEmail     EventID = "AUTH_LOGIN_FAILED_EMAIL"
+	AuthLoginFailedPassword  EventID = "AUTH_LOGIN_FAILED_PASSWORD"
+	AuthLoginFailed2FA       EventID = "AUTH_LOGIN_FAILED_2FA"
+	AuthLoginSuccessWith2FA  EventID = "AUTH_LOGIN_SUCCESS_WITH_2FA"
+	AuthLoginSuccessNo2FA    EventID = "AUTH_LOGIN_SUCCESS_NO_2FA"
+	AuthLoginFailedExternal  EventID = "AUTH_LOGIN_FAILED_EXTERNAL"
+	AuthLoginSuccessExternal EventID = "AUTH_LOGIN_SUCCESS_EXTERNAL"
+	Auth2FAEnrolled          EventID = "AUTH_2FA_ENROLLED"
+	AuthSessionDeleted       EventID = "SESSION_DELETED"
 
 	PasswordResetAttemptFailedMismatch EventID = "PASSWORD_RESET_ATTEMPT_FAILED_MISMATCH"
 	PasswordResetSuccess               EventID = "PASSWORD_RESET_SUCCESS"
@@ -49,11 +51,14 @@ const (
 	KeyDeleted  EventID = "KEY_DELETED"
 
 	EthTransactionCreated    EventID = "ETH_TRANSACTION_CREATED"
+	EthTransactionCancelled  EventID = "ETH_TRANSACTION_CANCELLED"
 	CosmosTransactionCreated EventID = "COSMOS_TRANSACTION_CREATED"
 	SolanaTransactionCreated EventID = "SOLANA_TRANSACTION_CREATED"
 
 	JobCreated EventID = "JOB_CREATED"
 	JobDeleted EventID = "JOB_DELETED"
+	JobPaused  EventID = "JOB_PAUSED"
+	JobResumed EventID = "JOB_RESUMED"
 
 	ChainAdded       EventID = "CHAIN_ADDED"
 	ChainSpecUpdated EventID = "CHAIN_SPEC_UPDATED"
@@ -18,16 +18,21 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 )
 
+var _ BatchBHS = &BatchBlockhashStore{}
+
 type batchBHSConfig interface {
 	LimitDefault() uint32
 }
 
 type BatchBlockhashStore struct {
-	config   batchBHSConfig
-	txm      txmgr.TxManager
-	abi      *abi.ABI
-	batchbhs batch_blockhash_store.BatchBlockhashStoreInterface
-	lggr     logger.Logger
+	config        batchBHSConfig
+	fromAddresses []ethkey.EIP55Address
+	txm           txmgr.TxManager
+	abi           *abi.ABI
+	batchbhs      batch_blockhash_store.BatchBlockhashStoreInterface
+	chainID       *big.Int
+	gethks        keystore.Eth
+	lggr          logger.Logger
 }
 
 func NewBatchBHS(
@@ -44,11 +49,14 @@ func NewBatchBHS(
 		return nil, errors.Wrap(err, "building ABI")
 	}
 	return &BatchBlockhashStore{
-		config:   config,
-		txm:      txm,
-		abi:      abi,
-		batchbhs: batchbhs,
-		lggr:     lggr,
+		config:        config,
+		fromAddresses: fromAddresses,
+		txm:           txm,
+		abi:           abi,
+		batchbhs:      batchbhs,
+		chainID:       chainID,
+		gethks:        gethks,
+		lggr:          lggr,
 	}, nil
 }
 
@@ -80,3 +88,33 @@ func (b *BatchBlockhashStore) StoreVerifyHeader(ctx context.Context, blockNumber
 
 	return nil
 }
+
+// StoreBatch satisfies the BatchBHS interface.
+func (b *BatchBlockhashStore) StoreBatch(ctx context.Context, blockNums []uint64) error {
+	blockNumbers := make([]*big.Int, len(blockNums))
+	for i, n := range blockNums {
+		blockNumbers[i] = new(big.Int).SetUint64(n)
+	}
+	payload, err := b.abi.Pack("store", blockNumbers)
+	if err != nil {
+		return errors.Wrap(err, "packing args")
+	}
+
+	fromAddress, err := b.gethks.GetRoundRobinAddress(b.chainID, SendingKeys(b.fromAddresses)...)
+	if err != nil {
+		return errors.Wrap(err, "getting next from address")
+	}
+
+	_, err = b.txm.CreateTransaction(txmgr.TxRequest{
+		FromAddress:    fromAddress,
+		ToAddress:      b.batchbhs.Address(),
+		EncodedPayload: payload,
+		FeeLimit:       b.config.LimitDefault(),
+		Strategy:       txmgrcommon.NewSendEveryStrategy(),
+	}, pg.WithParentCtx(ctx))
+	if err != nil {
+		return errors.Wrap(err, "creating transaction")
+	}
+
+	return nil
+}
@@ -105,7 +105,12 @@ func (c *BulletproofBHS) Store(ctx context.Context, blockNum uint64) error {
 
 // IsStored satisfies the BHS interface.
 func (c *BulletproofBHS) IsStored(ctx context.Context, blockNum uint64) (bool, error) {
-	_, err := c.bhs.GetBlockhash(&bind.CallOpts{Context: ctx}, big.NewInt(int64(blockNum)))
+	return isStored(ctx, c.bhs, blockNum)
+}
+
+// isStored checks whether the hash associated with blockNum is stored in the given contract.
+func isStored(ctx context.Context, bhs blockhash_store.BlockhashStoreInterface, blockNum uint64) (bool, error) {
+	_, err := bhs.GetBlockhash(&bind.CallOpts{Context: ctx}, big.NewInt(int64(blockNum)))
 	if err != nil && strings.Contains(err.Error(), "reverted") {
 		// Transaction reverted because the blockhash is not stored
 		return false, nil
@@ -44,6 +44,14 @@ type BHS interface {
 	StoreEarliest(ctx context.Context) error
 }
 
+// BatchBHS defines an interface for storing several blockhashes at once, e.g. through a
+// BatchBlockhashStore contract.
+type BatchBHS interface {
+	// StoreBatch stores the hashes associated with blockNums in a single transaction. The blocks
+	// must be among the 256 most recent ones.
+	StoreBatch(ctx context.Context, blockNums []uint64) error
+}
+
 func GetUnfulfilledBlocksAndRequests(
 	ctx context.Context,
 	lggr logger.Logger,
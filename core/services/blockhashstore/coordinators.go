@@ -4,9 +4,13 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"sort"
+	"strings"
 
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
+	"golang.org/x/exp/maps"
 
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
 	v1 "github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/solidity_vrf_coordinator_interface"
@@ -16,10 +20,81 @@ import (
 
 var (
 	_ Coordinator = MultiCoordinator{}
+	_ Coordinator = VersionedCoordinator{}
 	_ Coordinator = &V1Coordinator{}
 	_ Coordinator = &V2Coordinator{}
 )
 
+// coordinatorConstructor builds the Coordinator of the coordinator contract at the given address.
+type coordinatorConstructor func(
+	address common.Address,
+	backend bind.ContractBackend,
+	lp logpoller.LogPoller,
+) (Coordinator, error)
+
+// coordinatorVersions maps the coordinator versions a job can watch to their constructor.
+// Supporting a new coordinator version takes an implementation of the Coordinator interface and
+// an entry here.
+var coordinatorVersions = map[string]coordinatorConstructor{
+	"v1": func(address common.Address, backend bind.ContractBackend, lp logpoller.LogPoller) (Coordinator, error) {
+		c, err := v1.NewVRFCoordinator(address, backend)
+		if err != nil {
+			return nil, err
+		}
+		coord, err := NewV1Coordinator(c, lp)
+		if err != nil {
+			return nil, err
+		}
+		return coord, nil
+	},
+	"v2": func(address common.Address, backend bind.ContractBackend, lp logpoller.LogPoller) (Coordinator, error) {
+		c, err := v2.NewVRFCoordinatorV2(address, backend)
+		if err != nil {
+			return nil, err
+		}
+		coord, err := NewV2Coordinator(c, lp)
+		if err != nil {
+			return nil, err
+		}
+		return coord, nil
+	},
+}
+
+// supportedCoordinatorVersions returns the coordinator versions a job can watch, in order.
+func supportedCoordinatorVersions() string {
+	versions := maps.Keys(coordinatorVersions)
+	sort.Strings(versions)
+	return strings.Join(versions, ", ")
+}
+
+// VersionedCoordinator is a Coordinator along with the address and version of the coordinator
+// contract it reads from.
+type VersionedCoordinator struct {
+	Coordinator
+	Address common.Address
+	Version string
+}
+
+// NewVersionedCoordinator creates the Coordinator of the given version for the coordinator
+// contract at the given address.
+func NewVersionedCoordinator(
+	version string,
+	address common.Address,
+	backend bind.ContractBackend,
+	lp logpoller.LogPoller,
+) (VersionedCoordinator, error) {
+	newCoordinator, ok := coordinatorVersions[version]
+	if !ok {
+		return VersionedCoordinator{}, errors.Errorf(
+			"unsupported coordinator version %q, supported versions are %s", version, supportedCoordinatorVersions())
+	}
+	c, err := newCoordinator(address, backend, lp)
+	if err != nil {
+		return VersionedCoordinator{}, err
+	}
+	return VersionedCoordinator{Coordinator: c, Address: address, Version: version}, nil
+}
+
 // MultiCoordinator combines the data from multiple coordinators.
 type MultiCoordinator []Coordinator
 
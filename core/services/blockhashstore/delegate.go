@@ -8,9 +8,8 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/batch_blockhash_store"
 	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/blockhash_store"
-	v1 "github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/solidity_vrf_coordinator_interface"
-	v2 "github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/vrf_coordinator_v2"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
@@ -69,16 +68,9 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.ServiceCtx, error) {
 			chain.Config().EVM().FinalityDepth(), jb.BlockhashStoreSpec.WaitBlocks)
 	}
 
-	keys, err := d.ks.EnabledKeysForChain(chain.ID())
+	fromAddresses, err := d.fromAddresses(chain, jb.BlockhashStoreSpec.FromAddresses)
 	if err != nil {
-		return nil, errors.Wrap(err, "getting sending keys")
-	}
-	if len(keys) == 0 {
-		return nil, fmt.Errorf("missing sending keys for chain ID: %v", chain.ID())
-	}
-	fromAddresses := []ethkey.EIP55Address{keys[0].EIP55Address}
-	if jb.BlockhashStoreSpec.FromAddresses != nil {
-		fromAddresses = jb.BlockhashStoreSpec.FromAddresses
+		return nil, err
 	}
 
 	bhs, err := blockhash_store.NewBlockhashStore(
@@ -88,48 +80,60 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.ServiceCtx, error) {
 	}
 
 	lp := chain.LogPoller()
-	var coordinators []Coordinator
-	if jb.BlockhashStoreSpec.CoordinatorV1Address != nil {
-		var c *v1.VRFCoordinator
-		if c, err = v1.NewVRFCoordinator(
-			jb.BlockhashStoreSpec.CoordinatorV1Address.Address(), chain.Client()); err != nil {
-
-			return nil, errors.Wrap(err, "building V1 coordinator")
-		}
-
-		var coord *V1Coordinator
-		coord, err = NewV1Coordinator(c, lp)
+	var coordinators []VersionedCoordinator
+	for _, c := range specCoordinators(jb.BlockhashStoreSpec) {
+		var coord VersionedCoordinator
+		coord, err = NewVersionedCoordinator(c.Version, c.Address.Address(), chain.Client(), lp)
 		if err != nil {
-			return nil, errors.Wrap(err, "building V1 coordinator")
+			return nil, errors.Wrapf(err, "building %s coordinator %s", c.Version, c.Address)
 		}
 		coordinators = append(coordinators, coord)
 	}
-	if jb.BlockhashStoreSpec.CoordinatorV2Address != nil {
-		var c *v2.VRFCoordinatorV2
-		if c, err = v2.NewVRFCoordinatorV2(
-			jb.BlockhashStoreSpec.CoordinatorV2Address.Address(), chain.Client()); err != nil {
 
-			return nil, errors.Wrap(err, "building V2 coordinator")
+	bpBHS, err := NewBulletproofBHS(chain.Config().EVM().GasEstimator(), chain.Config().Database(), fromAddresses, chain.TxManager(), bhs, chain.ID(), d.ks)
+	if err != nil {
+		return nil, errors.Wrap(err, "building bulletproof bhs")
+	}
+
+	var batchBHS BatchBHS
+	if jb.BlockhashStoreSpec.BatchBlockhashStoreAddress != nil {
+		var batchBlockhashStore *batch_blockhash_store.BatchBlockhashStore
+		batchBlockhashStore, err = batch_blockhash_store.NewBatchBlockhashStore(
+			jb.BlockhashStoreSpec.BatchBlockhashStoreAddress.Address(), chain.Client())
+		if err != nil {
+			return nil, errors.Wrap(err, "building batch BHS")
 		}
 
-		var coord *V2Coordinator
-		coord, err = NewV2Coordinator(c, lp)
+		batchBHS, err = NewBatchBHS(
+			chain.Config().EVM().GasEstimator(),
+			fromAddresses,
+			chain.TxManager(),
+			batchBlockhashStore,
+			chain.ID(),
+			d.ks,
+			d.logger,
+		)
 		if err != nil {
-			return nil, errors.Wrap(err, "building V2 coordinator")
+			return nil, errors.Wrap(err, "building batchBHS")
 		}
-		coordinators = append(coordinators, coord)
 	}
 
-	bpBHS, err := NewBulletproofBHS(chain.Config().EVM().GasEstimator(), chain.Config().Database(), fromAddresses, chain.TxManager(), bhs, chain.ID(), d.ks)
-	if err != nil {
-		return nil, errors.Wrap(err, "building bulletproof bhs")
+	stores := []FeederStore{{BHS: bpBHS, BatchBHS: batchBHS}}
+	for _, t := range jb.BlockhashStoreSpec.TrustedBlockhashStores {
+		var trustedBHS *TrustedBlockhashStore
+		trustedBHS, err = d.trustedBHS(chain, t)
+		if err != nil {
+			return nil, errors.Wrapf(err, "building trusted BHS %s on chain %s", t.Address, t.EVMChainID)
+		}
+		stores = append(stores, FeederStore{BHS: trustedBHS, BatchBHS: trustedBHS})
 	}
 
 	log := d.logger.Named("BHS Feeder").With("jobID", jb.ID, "externalJobID", jb.ExternalJobID)
 	feeder := NewFeeder(
 		log,
-		NewMultiCoordinator(coordinators...),
-		bpBHS,
+		coordinators,
+		stores,
+		int(jb.BlockhashStoreSpec.StoreBlockhashesBatchSize),
 		int(jb.BlockhashStoreSpec.WaitBlocks),
 		int(jb.BlockhashStoreSpec.LookbackBlocks),
 		func(ctx context.Context) (uint64, error) {
@@ -138,7 +142,9 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.ServiceCtx, error) {
 				return 0, errors.Wrap(err, "getting chain head")
 			}
 			return uint64(head.Number), nil
-		})
+		},
+		jb.ExternalJobID,
+	)
 
 	return []job.ServiceCtx{&service{
 		feeder:     feeder,
@@ -149,6 +155,52 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.ServiceCtx, error) {
 	}}, nil
 }
 
+// fromAddresses returns the given addresses to send transactions from on the given chain, or the
+// first enabled key of the chain if none are given.
+func (d *Delegate) fromAddresses(chain evm.Chain, addresses []ethkey.EIP55Address) ([]ethkey.EIP55Address, error) {
+	keys, err := d.ks.EnabledKeysForChain(chain.ID())
+	if err != nil {
+		return nil, errors.Wrap(err, "getting sending keys")
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("missing sending keys for chain ID: %v", chain.ID())
+	}
+	if addresses != nil {
+		return addresses, nil
+	}
+	return []ethkey.EIP55Address{keys[0].EIP55Address}, nil
+}
+
+// trustedBHS builds the trusted blockhash store storing the blockhashes of the given chain into
+// the given target.
+func (d *Delegate) trustedBHS(chain evm.Chain, target job.BlockhashStoreTarget) (*TrustedBlockhashStore, error) {
+	targetChain, err := d.chains.Get(target.EVMChainID.ToInt())
+	if err != nil {
+		return nil, fmt.Errorf("getting chain ID %d: %w", target.EVMChainID.ToInt(), err)
+	}
+
+	fromAddresses, err := d.fromAddresses(targetChain, target.FromAddresses)
+	if err != nil {
+		return nil, err
+	}
+
+	bhs, err := blockhash_store.NewBlockhashStore(target.Address.Address(), targetChain.Client())
+	if err != nil {
+		return nil, errors.Wrap(err, "building BHS")
+	}
+
+	return NewTrustedBHS(
+		targetChain.Config().EVM().GasEstimator(),
+		fromAddresses,
+		targetChain.TxManager(),
+		bhs,
+		chain.Client(),
+		targetChain.Client(),
+		targetChain.ID(),
+		d.ks,
+	)
+}
+
 // AfterJobCreated satisfies the job.Delegate interface.
 func (d *Delegate) AfterJobCreated(spec job.Job) {}
 
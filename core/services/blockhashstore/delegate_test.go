@@ -108,6 +108,64 @@ func TestDelegate_ServicesForSpec(t *testing.T) {
 		require.Len(t, services, 1)
 	})
 
+	t.Run("happy with coordinators list and batch BHS", func(t *testing.T) {
+		batchBHSAddress := cltest.NewEIP55Address()
+
+		spec := job.Job{BlockhashStoreSpec: &job.BlockhashStoreSpec{
+			WaitBlocks: defaultWaitBlocks,
+			Coordinators: job.BlockhashStoreCoordinators{
+				{Address: cltest.NewEIP55Address(), Version: "v1"},
+				{Address: cltest.NewEIP55Address(), Version: "v2"},
+			},
+			BatchBlockhashStoreAddress: &batchBHSAddress,
+			StoreBlockhashesBatchSize:  10,
+		}}
+		services, err := delegate.ServicesForSpec(spec)
+
+		require.NoError(t, err)
+		require.Len(t, services, 1)
+	})
+
+	t.Run("happy with trusted blockhash store", func(t *testing.T) {
+		spec := job.Job{BlockhashStoreSpec: &job.BlockhashStoreSpec{
+			WaitBlocks: defaultWaitBlocks,
+			TrustedBlockhashStores: job.BlockhashStoreTargets{
+				{
+					EVMChainID: utils.NewBig(testData.chainSet.Chains()[0].ID()),
+					Address:    cltest.NewEIP55Address(),
+				},
+			},
+			StoreBlockhashesBatchSize: 10,
+		}}
+		services, err := delegate.ServicesForSpec(spec)
+
+		require.NoError(t, err)
+		require.Len(t, services, 1)
+	})
+
+	t.Run("trusted blockhash store on unknown chain", func(t *testing.T) {
+		trustedBHSAddress := cltest.NewEIP55Address()
+		spec := job.Job{BlockhashStoreSpec: &job.BlockhashStoreSpec{
+			WaitBlocks: defaultWaitBlocks,
+			TrustedBlockhashStores: job.BlockhashStoreTargets{
+				{EVMChainID: utils.NewBigI(123), Address: trustedBHSAddress},
+			},
+		}}
+		_, err := delegate.ServicesForSpec(spec)
+		assert.ErrorContains(t, err, "building trusted BHS "+trustedBHSAddress.String()+" on chain 123")
+	})
+
+	t.Run("unsupported coordinator version", func(t *testing.T) {
+		spec := job.Job{BlockhashStoreSpec: &job.BlockhashStoreSpec{
+			WaitBlocks: defaultWaitBlocks,
+			Coordinators: job.BlockhashStoreCoordinators{
+				{Address: cltest.NewEIP55Address(), Version: "v9"},
+			},
+		}}
+		_, err := delegate.ServicesForSpec(spec)
+		assert.ErrorContains(t, err, `unsupported coordinator version "v9"`)
+	})
+
 	t.Run("missing BlockhashStoreSpec", func(t *testing.T) {
 		spec := job.Job{BlockhashStoreSpec: nil}
 		_, err := delegate.ServicesForSpec(spec)
@@ -3,29 +3,60 @@ package blockhashstore
 import (
 	"context"
 
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"go.uber.org/multierr"
+	"golang.org/x/exp/slices"
 
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/utils/mathutil"
 )
 
-// NewFeeder creates a new Feeder instance.
+var metricRequestsNeedingStore = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "blockhash_store_requests_needing_store",
+	Help: "The number of unfulfilled VRF requests within the feeder's search window whose blockhash is not stored yet.",
+}, []string{"external_job_id", "coordinator_address", "coordinator_version"})
+
+// FeederStore is a blockhash store a feeder stores blockhashes into. If BatchBHS is not nil,
+// blockhashes are stored through it in batches, rather than one at a time through BHS.
+type FeederStore struct {
+	BHS      BHS
+	BatchBHS BatchBHS
+}
+
+// feederStore is a store of a feeder, along with the blocks the feeder stored into it.
+type feederStore struct {
+	FeederStore
+	stored map[uint64]struct{}
+}
+
+// NewFeeder creates a new Feeder instance, storing blockhashes into each of the given stores.
+// Batches of blockhashes hold up to batchSize blocks.
 func NewFeeder(
 	logger logger.Logger,
-	coordinator Coordinator,
-	bhs BHS,
+	coordinators []VersionedCoordinator,
+	stores []FeederStore,
+	batchSize int,
 	waitBlocks int,
 	lookbackBlocks int,
 	latestBlock func(ctx context.Context) (uint64, error),
+	externalJobID uuid.UUID,
 ) *Feeder {
+	feederStores := make([]*feederStore, len(stores))
+	for i, s := range stores {
+		feederStores[i] = &feederStore{FeederStore: s, stored: make(map[uint64]struct{})}
+	}
 	return &Feeder{
 		lggr:           logger,
-		coordinator:    coordinator,
-		bhs:            bhs,
+		coordinators:   coordinators,
+		stores:         feederStores,
+		batchSize:      mathutil.Max(batchSize, 1),
 		waitBlocks:     waitBlocks,
 		lookbackBlocks: lookbackBlocks,
 		latestBlock:    latestBlock,
-		stored:         make(map[uint64]struct{}),
+		externalJobID:  externalJobID,
 		lastRunBlock:   0,
 	}
 }
@@ -34,13 +65,14 @@ func NewFeeder(
 // waitBlocks and lookbackBlocks that have unfulfilled requests.
 type Feeder struct {
 	lggr           logger.Logger
-	coordinator    Coordinator
-	bhs            BHS
+	coordinators   []VersionedCoordinator
+	stores         []*feederStore
+	batchSize      int
 	waitBlocks     int
 	lookbackBlocks int
 	latestBlock    func(ctx context.Context) (uint64, error)
+	externalJobID  uuid.UUID
 
-	stored       map[uint64]struct{}
 	lastRunBlock uint64
 }
 
@@ -59,21 +91,66 @@ func (f *Feeder) Run(ctx context.Context) error {
 	}
 
 	lggr := f.lggr.With("latestBlock", latestBlock, "fromBlock", fromBlock, "toBlock", toBlock)
-	blockToRequests, err := GetUnfulfilledBlocksAndRequests(ctx, lggr, f.coordinator, fromBlock, toBlock)
-	if err != nil {
-		return err
+	// blockToRequests holds the unfulfilled requests of all coordinators by block, and
+	// coordinatorBlocks the number of unfulfilled requests of each coordinator by block.
+	blockToRequests := make(map[uint64]map[string]struct{})
+	coordinatorBlocks := make([]map[uint64]int, len(f.coordinators))
+	for i, c := range f.coordinators {
+		unfulfilled, err := GetUnfulfilledBlocksAndRequests(ctx, lggr, c, fromBlock, toBlock)
+		if err != nil {
+			return err
+		}
+		coordinatorBlocks[i] = make(map[uint64]int)
+		for block, reqs := range unfulfilled {
+			if len(reqs) == 0 {
+				continue
+			}
+			coordinatorBlocks[i][block] = len(reqs)
+			if _, ok := blockToRequests[block]; !ok {
+				blockToRequests[block] = make(map[string]struct{})
+			}
+			for id := range reqs {
+				blockToRequests[block][id] = struct{}{}
+			}
+		}
 	}
 
 	var errs error
+	for _, store := range f.stores {
+		errs = multierr.Append(errs, f.storeInto(ctx, store, blockToRequests, latestBlock))
+	}
+	f.reportRequestsNeedingStore(coordinatorBlocks)
+
+	if f.lastRunBlock != 0 {
+		// Prune stored, anything older than fromBlock can be discarded
+		for block := f.lastRunBlock - uint64(f.lookbackBlocks); block < fromBlock; block++ {
+			for _, store := range f.stores {
+				if _, ok := store.stored[block]; ok {
+					delete(store.stored, block)
+					f.lggr.Debugw("Pruned block from stored cache",
+						"block", block, "latestBlock", latestBlock)
+				}
+			}
+		}
+	}
+	f.lastRunBlock = latestBlock
+	return errs
+}
+
+// storeInto stores the blockhashes of the blocks with unfulfilled requests into the given store,
+// unless they are stored already.
+func (f *Feeder) storeInto(ctx context.Context, store *feederStore, blockToRequests map[uint64]map[string]struct{}, latestBlock uint64) error {
+	var errs error
+	var toStore []uint64
 	for block, unfulfilledReqs := range blockToRequests {
 		if len(unfulfilledReqs) == 0 {
 			continue
 		}
-		if _, ok := f.stored[block]; ok {
+		if _, ok := store.stored[block]; ok {
 			// Already stored
 			continue
 		}
-		stored, err := f.bhs.IsStored(ctx, block)
+		stored, err := store.BHS.IsStored(ctx, block)
 		if err != nil {
 			f.lggr.Errorw("Failed to check if block is already stored, attempting to store anyway",
 				"err", err,
@@ -83,12 +160,16 @@ func (f *Feeder) Run(ctx context.Context) error {
 			f.lggr.Infow("Blockhash already stored",
 				"block", block, "latestBlock", latestBlock,
 				"unfulfilledReqIDs", LimitReqIDs(unfulfilledReqs, 50))
-			f.stored[block] = struct{}{}
+			store.stored[block] = struct{}{}
 			continue
 		}
 
 		// Block needs to be stored
-		err = f.bhs.Store(ctx, block)
+		if store.BatchBHS != nil {
+			toStore = append(toStore, block)
+			continue
+		}
+		err = store.BHS.Store(ctx, block)
 		if err != nil {
 			f.lggr.Errorw("Failed to store block", "err", err, "block", block)
 			errs = multierr.Append(errs, errors.Wrap(err, "storing block"))
@@ -98,19 +179,59 @@ func (f *Feeder) Run(ctx context.Context) error {
 		f.lggr.Infow("Stored blockhash",
 			"block", block, "latestBlock", latestBlock,
 			"unfulfilledReqIDs", LimitReqIDs(unfulfilledReqs, 50))
-		f.stored[block] = struct{}{}
+		store.stored[block] = struct{}{}
+	}
+	if len(toStore) > 0 {
+		errs = multierr.Append(errs, f.storeBatches(ctx, store, toStore, latestBlock))
 	}
+	return errs
+}
 
-	if f.lastRunBlock != 0 {
-		// Prune stored, anything older than fromBlock can be discarded
-		for block := f.lastRunBlock - uint64(f.lookbackBlocks); block < fromBlock; block++ {
-			if _, ok := f.stored[block]; ok {
-				delete(f.stored, block)
-				f.lggr.Debugw("Pruned block from stored cache",
-					"block", block, "latestBlock", latestBlock)
-			}
+// storeBatches stores the given blocks through the batch BHS of the store, batchSize blocks at a
+// time.
+func (f *Feeder) storeBatches(ctx context.Context, store *feederStore, blocks []uint64, latestBlock uint64) error {
+	slices.Sort(blocks)
+	var errs error
+	for len(blocks) > 0 {
+		batch := blocks[:mathutil.Min(len(blocks), f.batchSize)]
+		blocks = blocks[len(batch):]
+
+		if err := store.BatchBHS.StoreBatch(ctx, batch); err != nil {
+			f.lggr.Errorw("Failed to store blocks", "err", err, "blocks", batch)
+			errs = multierr.Append(errs, errors.Wrap(err, "storing blocks"))
+			continue
+		}
+
+		f.lggr.Infow("Stored blockhashes", "blocks", batch, "latestBlock", latestBlock)
+		for _, block := range batch {
+			store.stored[block] = struct{}{}
 		}
 	}
-	f.lastRunBlock = latestBlock
 	return errs
 }
+
+// reportRequestsNeedingStore reports, for each coordinator, the number of unfulfilled requests
+// whose blockhash is neither stored nor being stored in all the stores.
+func (f *Feeder) reportRequestsNeedingStore(coordinatorBlocks []map[uint64]int) {
+	for i, c := range f.coordinators {
+		var needingStore int
+		for block, reqs := range coordinatorBlocks[i] {
+			if !f.storedInAll(block) {
+				needingStore += reqs
+			}
+		}
+		metricRequestsNeedingStore.
+			WithLabelValues(f.externalJobID.String(), c.Address.Hex(), c.Version).
+			Set(float64(needingStore))
+	}
+}
+
+// storedInAll returns whether the given block is stored, or being stored, in all the stores.
+func (f *Feeder) storedInAll(block uint64) bool {
+	for _, store := range f.stores {
+		if _, ok := store.stored[block]; !ok {
+			return false
+		}
+	}
+	return true
+}
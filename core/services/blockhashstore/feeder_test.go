@@ -8,6 +8,8 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/google/uuid"
+	promtestutil "github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
@@ -227,13 +229,15 @@ func TestFeeder(t *testing.T) {
 
 			feeder := NewFeeder(
 				logger.TestLogger(t),
-				coordinator,
-				&test.bhs,
+				[]VersionedCoordinator{{Coordinator: coordinator}},
+				[]FeederStore{{BHS: &test.bhs}},
+				0,
 				test.wait,
 				test.lookback,
 				func(ctx context.Context) (uint64, error) {
 					return test.latest, nil
-				})
+				},
+				uuid.New())
 
 			err := feeder.Run(testutils.Context(t))
 			if test.expectedErrMsg == "" {
@@ -316,13 +320,15 @@ func TestFeederWithLogPollerVRFv1(t *testing.T) {
 			// Instantiate feeder.
 			feeder := NewFeeder(
 				logger.TestLogger(t),
-				coordinator,
-				&test.bhs,
+				[]VersionedCoordinator{{Coordinator: coordinator}},
+				[]FeederStore{{BHS: &test.bhs}},
+				0,
 				test.wait,
 				test.lookback,
 				func(ctx context.Context) (uint64, error) {
 					return test.latest, nil
-				})
+				},
+				uuid.New())
 
 			// Run feeder and assert correct results.
 			err = feeder.Run(testutils.Context(t))
@@ -409,13 +415,15 @@ func TestFeederWithLogPollerVRFv2(t *testing.T) {
 			// Instantiate feeder.
 			feeder := NewFeeder(
 				logger.TestLogger(t),
-				coordinator,
-				&test.bhs,
+				[]VersionedCoordinator{{Coordinator: coordinator}},
+				[]FeederStore{{BHS: &test.bhs}},
+				0,
 				test.wait,
 				test.lookback,
 				func(ctx context.Context) (uint64, error) {
 					return test.latest, nil
-				})
+				},
+				uuid.New())
 
 			// Run feeder and assert correct results.
 			err = feeder.Run(testutils.Context(t))
@@ -438,13 +446,15 @@ func TestFeeder_CachesStoredBlocks(t *testing.T) {
 
 	feeder := NewFeeder(
 		logger.TestLogger(t),
-		coordinator,
-		bhs,
+		[]VersionedCoordinator{{Coordinator: coordinator}},
+		[]FeederStore{{BHS: bhs}},
+		0,
 		100,
 		200,
 		func(ctx context.Context) (uint64, error) {
 			return 250, nil
-		})
+		},
+		uuid.New())
 
 	// Should store block 100
 	require.NoError(t, feeder.Run(testutils.Context(t)))
@@ -461,7 +471,140 @@ func TestFeeder_CachesStoredBlocks(t *testing.T) {
 		return 500, nil
 	}
 	require.NoError(t, feeder.Run(testutils.Context(t)))
-	require.Empty(t, feeder.stored)
+	require.Empty(t, feeder.stores[0].stored)
+}
+
+func TestFeeder_BatchBHS(t *testing.T) {
+	coordinator := &TestCoordinator{
+		RequestEvents: []Event{
+			{Block: 150, ID: "1000"},
+			{Block: 151, ID: "1001"},
+			{Block: 152, ID: "1002"},
+			{Block: 153, ID: "1003"},
+			{Block: 154, ID: "1004"},
+		},
+	}
+	bhs := &TestBHS{Stored: []uint64{151}}
+	batchBHS := &TestBatchBHS{ErrorsStoreBatch: []uint64{154}}
+
+	feeder := NewFeeder(
+		logger.TestLogger(t),
+		[]VersionedCoordinator{{Coordinator: coordinator}},
+		[]FeederStore{{BHS: bhs, BatchBHS: batchBHS}},
+		2,
+		25,
+		100,
+		func(ctx context.Context) (uint64, error) {
+			return 200, nil
+		},
+		uuid.New())
+
+	require.EqualError(t, feeder.Run(testutils.Context(t)), "storing blocks: error storing batch")
+	// Blocks are stored in order, in batches of at most 2, skipping those already stored.
+	require.Equal(t, [][]uint64{{150, 152}, {153, 154}}, batchBHS.StoreBatches)
+	require.ElementsMatch(t, []uint64{150, 152}, batchBHS.Stored)
+	require.Equal(t, []uint64{151}, bhs.Stored)
+
+	// The failed batch is retried on the next run.
+	batchBHS.StoreBatches, batchBHS.ErrorsStoreBatch = nil, nil
+	require.NoError(t, feeder.Run(testutils.Context(t)))
+	require.Equal(t, [][]uint64{{153, 154}}, batchBHS.StoreBatches)
+}
+
+func TestFeeder_MultipleStores(t *testing.T) {
+	coordinator := VersionedCoordinator{
+		Coordinator: &TestCoordinator{
+			RequestEvents: []Event{{Block: 150, ID: "1000"}, {Block: 151, ID: "1001"}, {Block: 152, ID: "1002"}},
+		},
+		Address: testutils.NewAddress(),
+		Version: "v2",
+	}
+	bhs := &TestBHS{Stored: []uint64{151}}
+	trustedBHS := &TestBHS{}
+	trustedBatchBHS := &TestBatchBHS{ErrorsStoreBatch: []uint64{152}}
+	externalJobID := uuid.New()
+
+	feeder := NewFeeder(
+		logger.TestLogger(t),
+		[]VersionedCoordinator{coordinator},
+		[]FeederStore{{BHS: bhs}, {BHS: trustedBHS, BatchBHS: trustedBatchBHS}},
+		2,
+		25,
+		100,
+		func(ctx context.Context) (uint64, error) {
+			return 200, nil
+		},
+		externalJobID)
+
+	// A failure to store into one store doesn't prevent storing into the others.
+	require.EqualError(t, feeder.Run(testutils.Context(t)), "storing blocks: error storing batch")
+	require.ElementsMatch(t, []uint64{150, 151, 152}, bhs.Stored)
+	require.Equal(t, [][]uint64{{150, 151}, {152}}, trustedBatchBHS.StoreBatches)
+	require.ElementsMatch(t, []uint64{150, 151}, trustedBatchBHS.Stored)
+	require.Len(t, feeder.stores[0].stored, 3)
+	require.Len(t, feeder.stores[1].stored, 2)
+
+	// The requests of block 152 still need their blockhash stored in the trusted store.
+	requestsNeedingStore := func() float64 {
+		return promtestutil.ToFloat64(metricRequestsNeedingStore.WithLabelValues(
+			externalJobID.String(), coordinator.Address.Hex(), coordinator.Version))
+	}
+	require.Equal(t, float64(1), requestsNeedingStore())
+
+	// Only the trusted store is retried on the next run.
+	bhs.Stored, trustedBatchBHS.StoreBatches, trustedBatchBHS.ErrorsStoreBatch = nil, nil, nil
+	require.NoError(t, feeder.Run(testutils.Context(t)))
+	require.Empty(t, bhs.Stored)
+	require.Equal(t, [][]uint64{{152}}, trustedBatchBHS.StoreBatches)
+	require.Equal(t, float64(0), requestsNeedingStore())
+}
+
+func TestFeeder_RequestsNeedingStoreMetric(t *testing.T) {
+	coordinatorV1 := VersionedCoordinator{
+		Coordinator: &TestCoordinator{
+			RequestEvents: []Event{{Block: 150, ID: "1000"}, {Block: 150, ID: "1001"}, {Block: 151, ID: "1002"}},
+		},
+		Address: testutils.NewAddress(),
+		Version: "v1",
+	}
+	coordinatorV2 := VersionedCoordinator{
+		Coordinator: &TestCoordinator{
+			RequestEvents:     []Event{{Block: 152, ID: "2000"}, {Block: 153, ID: "2001"}},
+			FulfillmentEvents: []Event{{Block: 155, ID: "2001"}},
+		},
+		Address: testutils.NewAddress(),
+		Version: "v2",
+	}
+	bhs := &TestBHS{ErrorsStore: []uint64{150, 152}}
+	externalJobID := uuid.New()
+
+	feeder := NewFeeder(
+		logger.TestLogger(t),
+		[]VersionedCoordinator{coordinatorV1, coordinatorV2},
+		[]FeederStore{{BHS: bhs}},
+		0,
+		25,
+		100,
+		func(ctx context.Context) (uint64, error) {
+			return 200, nil
+		},
+		externalJobID)
+
+	require.Error(t, feeder.Run(testutils.Context(t)))
+	require.ElementsMatch(t, []uint64{151}, bhs.Stored)
+
+	// Only the requests of blocks 150 and 152 still need their blockhash stored.
+	requestsNeedingStore := func(c VersionedCoordinator) float64 {
+		return promtestutil.ToFloat64(metricRequestsNeedingStore.WithLabelValues(
+			externalJobID.String(), c.Address.Hex(), c.Version))
+	}
+	require.Equal(t, float64(2), requestsNeedingStore(coordinatorV1))
+	require.Equal(t, float64(1), requestsNeedingStore(coordinatorV2))
+
+	bhs.ErrorsStore = nil
+	require.NoError(t, feeder.Run(testutils.Context(t)))
+	require.Equal(t, float64(0), requestsNeedingStore(coordinatorV1))
+	require.Equal(t, float64(0), requestsNeedingStore(coordinatorV2))
 }
 
 func newRandomnessRequestedLogV1(
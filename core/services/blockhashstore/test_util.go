@@ -87,6 +87,12 @@ type TestBatchBHS struct {
 	StoreVerifyHeaderCallCounter uint16
 	GetBlockhashesError          error
 	StoreVerifyHeadersError      error
+
+	// StoreBatches records the blocks of each StoreBatch call.
+	StoreBatches [][]uint64
+
+	// ErrorsStoreBatch defines which block numbers fail the StoreBatch calls they are part of.
+	ErrorsStoreBatch []uint64
 }
 
 func (t *TestBatchBHS) GetBlockhashes(_ context.Context, blockNumbers []*big.Int) ([][32]byte, error) {
@@ -124,6 +130,19 @@ func (t *TestBatchBHS) StoreVerifyHeader(ctx context.Context, blockNumbers []*bi
 	return nil
 }
 
+func (t *TestBatchBHS) StoreBatch(_ context.Context, blockNums []uint64) error {
+	t.StoreBatches = append(t.StoreBatches, blockNums)
+	for _, e := range t.ErrorsStoreBatch {
+		for _, blockNum := range blockNums {
+			if e == blockNum {
+				return errors.New("error storing batch")
+			}
+		}
+	}
+	t.Stored = append(t.Stored, blockNums...)
+	return nil
+}
+
 type TestBlockHeaderProvider struct {
 }
 
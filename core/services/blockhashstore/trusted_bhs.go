@@ -0,0 +1,138 @@
+package blockhashstore
+
+import (
+	"context"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/blockhash_store"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+)
+
+var (
+	_ BHS      = &TrustedBlockhashStore{}
+	_ BatchBHS = &TrustedBlockhashStore{}
+)
+
+// trustedBHSABI is the part of the ABI of the TrustedBlockhashStore contract used to store
+// blockhashes. The contract extends BlockhashStore, whose ABI is used to read them.
+const trustedBHSABI = `[{"inputs":[{"internalType":"uint256[]","name":"blockNums","type":"uint256[]"},{"internalType":"bytes32[]","name":"blockhashes","type":"bytes32[]"},{"internalType":"uint256","name":"recentBlockNumber","type":"uint256"},{"internalType":"bytes32","name":"recentBlockhash","type":"bytes32"}],"name":"storeTrusted","outputs":[],"stateMutability":"nonpayable","type":"function"}]`
+
+// TrustedBlockhashStore is an implementation of BHS and BatchBHS that stores the blockhashes of a
+// source chain into a TrustedBlockhashStore contract, on the same or another chain. As the
+// contract can't read the blockhashes of another chain, they are read from the source chain and
+// passed to storeTrusted, along with a recent block of the contract's chain, which the contract
+// checks to guard against reorgs.
+type TrustedBlockhashStore struct {
+	config        batchBHSConfig
+	fromAddresses []ethkey.EIP55Address
+	txm           txmgr.TxManager
+	abi           *abi.ABI
+	bhs           blockhash_store.BlockhashStoreInterface
+	source        evmclient.Client
+	target        evmclient.Client
+	chainID       *big.Int
+	gethks        keystore.Eth
+}
+
+// NewTrustedBHS creates a new instance storing the blockhashes of the source chain into the given
+// trusted blockhash store, through the transaction manager of the target chain it is deployed on.
+func NewTrustedBHS(
+	config batchBHSConfig,
+	fromAddresses []ethkey.EIP55Address,
+	txm txmgr.TxManager,
+	bhs blockhash_store.BlockhashStoreInterface,
+	source evmclient.Client,
+	target evmclient.Client,
+	chainID *big.Int,
+	gethks keystore.Eth,
+) (*TrustedBlockhashStore, error) {
+	trustedABI, err := abi.JSON(strings.NewReader(trustedBHSABI))
+	if err != nil {
+		return nil, errors.Wrap(err, "building ABI")
+	}
+	return &TrustedBlockhashStore{
+		config:        config,
+		fromAddresses: fromAddresses,
+		txm:           txm,
+		abi:           &trustedABI,
+		bhs:           bhs,
+		source:        source,
+		target:        target,
+		chainID:       chainID,
+		gethks:        gethks,
+	}, nil
+}
+
+// Store satisfies the BHS interface.
+func (t *TrustedBlockhashStore) Store(ctx context.Context, blockNum uint64) error {
+	return t.StoreBatch(ctx, []uint64{blockNum})
+}
+
+// IsStored satisfies the BHS interface.
+func (t *TrustedBlockhashStore) IsStored(ctx context.Context, blockNum uint64) (bool, error) {
+	return isStored(ctx, t.bhs, blockNum)
+}
+
+// StoreEarliest satisfies the BHS interface. The contract can't read the blockhashes of the
+// source chain, so the earliest one can't be stored.
+func (t *TrustedBlockhashStore) StoreEarliest(context.Context) error {
+	return errors.New("storing the earliest blockhash is not supported by trusted blockhash stores")
+}
+
+// StoreBatch satisfies the BatchBHS interface.
+func (t *TrustedBlockhashStore) StoreBatch(ctx context.Context, blockNums []uint64) error {
+	blockNumbers := make([]*big.Int, len(blockNums))
+	blockhashes := make([]common.Hash, len(blockNums))
+	for i, n := range blockNums {
+		blockNumbers[i] = new(big.Int).SetUint64(n)
+		head, err := t.source.HeadByNumber(ctx, blockNumbers[i])
+		if err != nil {
+			return errors.Wrapf(err, "getting block %d", n)
+		}
+		if head == nil {
+			return errors.Errorf("block %d not found", n)
+		}
+		blockhashes[i] = head.Hash
+	}
+
+	recent, err := t.target.HeadByNumber(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "getting recent block")
+	}
+	if recent == nil {
+		return errors.New("recent block not found")
+	}
+
+	payload, err := t.abi.Pack("storeTrusted", blockNumbers, blockhashes, big.NewInt(recent.Number), recent.Hash)
+	if err != nil {
+		return errors.Wrap(err, "packing args")
+	}
+
+	fromAddress, err := t.gethks.GetRoundRobinAddress(t.chainID, SendingKeys(t.fromAddresses)...)
+	if err != nil {
+		return errors.Wrap(err, "getting next from address")
+	}
+
+	_, err = t.txm.CreateTransaction(txmgr.TxRequest{
+		FromAddress:    fromAddress,
+		ToAddress:      t.bhs.Address(),
+		EncodedPayload: payload,
+		FeeLimit:       t.config.LimitDefault(),
+		Strategy:       txmgrcommon.NewSendEveryStrategy(),
+	}, pg.WithParentCtx(ctx))
+	if err != nil {
+		return errors.Wrap(err, "creating transaction")
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package blockhashstore_test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	txmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/blockhash_store"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	configtest "github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest/v2"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/blockhashstore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+	ksmocks "github.com/smartcontractkit/chainlink/v2/core/services/keystore/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+const storeTrustedABI = `[{"inputs":[{"internalType":"uint256[]","name":"blockNums","type":"uint256[]"},{"internalType":"bytes32[]","name":"blockhashes","type":"bytes32[]"},{"internalType":"uint256","name":"recentBlockNumber","type":"uint256"},{"internalType":"bytes32","name":"recentBlockhash","type":"bytes32"}],"name":"storeTrusted","outputs":[],"stateMutability":"nonpayable","type":"function"}]`
+
+func TestTrustedBHS_StoreBatch(t *testing.T) {
+	cfg := evmtest.NewChainScopedConfig(t, configtest.NewTestGeneralConfig(t))
+	source := evmclimocks.NewClient(t)
+	target := evmclimocks.NewClient(t)
+	txm := txmmocks.NewMockEvmTxManager(t)
+	ks := ksmocks.NewEth(t)
+
+	chainID := big.NewInt(137)
+	fromAddress := testutils.NewAddress()
+	trustedBHSAddress := testutils.NewAddress()
+	store, err := blockhash_store.NewBlockhashStore(trustedBHSAddress, target)
+	require.NoError(t, err)
+
+	bhs, err := blockhashstore.NewTrustedBHS(
+		cfg.EVM().GasEstimator(),
+		[]ethkey.EIP55Address{ethkey.EIP55AddressFromAddress(fromAddress)},
+		txm,
+		store,
+		source,
+		target,
+		chainID,
+		ks,
+	)
+	require.NoError(t, err)
+
+	sourceHeads := map[int64]*evmtypes.Head{}
+	for _, n := range []int64{100, 102} {
+		head := evmtypes.NewHead(big.NewInt(n), utils.NewHash(), utils.NewHash(), 0, nil)
+		sourceHeads[n] = &head
+		source.On("HeadByNumber", mock.Anything, big.NewInt(n)).Return(&head, nil).Once()
+	}
+	recent := evmtypes.NewHead(big.NewInt(5000), utils.NewHash(), utils.NewHash(), 0, nil)
+	target.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(&recent, nil).Once()
+	ks.On("GetRoundRobinAddress", chainID, fromAddress).Return(fromAddress, nil).Once()
+
+	var payload []byte
+	txm.On("CreateTransaction", mock.MatchedBy(func(tx txmgr.TxRequest) bool {
+		payload = tx.EncodedPayload
+		return tx.FromAddress == fromAddress &&
+			tx.ToAddress == trustedBHSAddress &&
+			tx.FeeLimit == cfg.EVM().GasEstimator().LimitDefault()
+	}), mock.Anything).Return(txmgr.Tx{}, nil).Once()
+
+	require.NoError(t, bhs.StoreBatch(testutils.Context(t), []uint64{100, 102}))
+
+	// The blockhashes of the source chain are stored along with the most recent block of the
+	// target chain.
+	trustedABI, err := abi.JSON(strings.NewReader(storeTrustedABI))
+	require.NoError(t, err)
+	args, err := trustedABI.Methods["storeTrusted"].Inputs.Unpack(payload[4:])
+	require.NoError(t, err)
+	require.Equal(t, []*big.Int{big.NewInt(100), big.NewInt(102)}, args[0])
+	require.Equal(t, [][32]byte{sourceHeads[100].Hash, sourceHeads[102].Hash}, args[1])
+	require.Equal(t, big.NewInt(5000), args[2])
+	require.Equal(t, [32]byte(recent.Hash), args[3])
+}
+
+func TestTrustedBHS_StoreBatch_MissingSourceBlock(t *testing.T) {
+	cfg := evmtest.NewChainScopedConfig(t, configtest.NewTestGeneralConfig(t))
+	source := evmclimocks.NewClient(t)
+	target := evmclimocks.NewClient(t)
+	store, err := blockhash_store.NewBlockhashStore(testutils.NewAddress(), target)
+	require.NoError(t, err)
+
+	bhs, err := blockhashstore.NewTrustedBHS(
+		cfg.EVM().GasEstimator(),
+		nil,
+		txmmocks.NewMockEvmTxManager(t),
+		store,
+		source,
+		target,
+		big.NewInt(137),
+		ksmocks.NewEth(t),
+	)
+	require.NoError(t, err)
+
+	source.On("HeadByNumber", mock.Anything, big.NewInt(100)).Return(nil, nil).Once()
+
+	require.EqualError(t, bhs.StoreBatch(testutils.Context(t), []uint64{100}), "block 100 not found")
+	require.EqualError(t, bhs.StoreEarliest(testutils.Context(t)),
+		"storing the earliest blockhash is not supported by trusted blockhash stores")
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
 )
 
 // ValidatedSpec validates and converts the given toml string to a job.Job.
@@ -38,9 +39,9 @@ func ValidatedSpec(tomlString string) (job.Job, error) {
 	}
 
 	// Required fields
-	if spec.CoordinatorV1Address == nil && spec.CoordinatorV2Address == nil {
+	if spec.CoordinatorV1Address == nil && spec.CoordinatorV2Address == nil && len(spec.Coordinators) == 0 {
 		return jb, errors.New(
-			`at least one of "coordinatorV1Address" and "coordinatorV2Address" must be set`)
+			`at least one of "coordinatorV1Address", "coordinatorV2Address" and "coordinators" must be set`)
 	}
 	if spec.BlockhashStoreAddress == "" {
 		return jb, notSet("blockhashStoreAddress")
@@ -62,6 +63,14 @@ func ValidatedSpec(tomlString string) (job.Job, error) {
 	if spec.RunTimeout == 0 {
 		spec.RunTimeout = 30 * time.Second
 	}
+	if (spec.BatchBlockhashStoreAddress != nil || len(spec.TrustedBlockhashStores) > 0) && spec.StoreBlockhashesBatchSize == 0 {
+		spec.StoreBlockhashesBatchSize = 10
+	}
+	for i := range spec.TrustedBlockhashStores {
+		if spec.TrustedBlockhashStores[i].EVMChainID == nil {
+			spec.TrustedBlockhashStores[i].EVMChainID = spec.EVMChainID
+		}
+	}
 
 	// Validation
 	if spec.WaitBlocks >= spec.LookbackBlocks {
@@ -74,6 +83,33 @@ func ValidatedSpec(tomlString string) (job.Job, error) {
 		return jb, errors.New(`"lookbackBlocks" must be less than 256`)
 	}
 
+	seen := make(map[ethkey.EIP55Address]struct{})
+	for _, c := range specCoordinators(&spec) {
+		if c.Address == "" {
+			return jb, errors.New(`"address" must be set for all "coordinators"`)
+		}
+		if _, ok := coordinatorVersions[c.Version]; !ok {
+			return jb, errors.Errorf("unsupported coordinator version %q, supported versions are %s",
+				c.Version, supportedCoordinatorVersions())
+		}
+		if _, ok := seen[c.Address]; ok {
+			return jb, errors.Errorf("coordinator %s is set more than once", c.Address)
+		}
+		seen[c.Address] = struct{}{}
+	}
+
+	trustedStores := make(map[string]struct{})
+	for _, t := range spec.TrustedBlockhashStores {
+		if t.Address == "" {
+			return jb, errors.New(`"address" must be set for all "trustedBlockhashStores"`)
+		}
+		key := t.EVMChainID.String() + "/" + t.Address.String()
+		if _, ok := trustedStores[key]; ok {
+			return jb, errors.Errorf("trusted blockhash store %s on chain %s is set more than once", t.Address, t.EVMChainID)
+		}
+		trustedStores[key] = struct{}{}
+	}
+
 	jb.BlockhashStoreSpec = &spec
 
 	return jb, nil
@@ -82,3 +118,18 @@ func ValidatedSpec(tomlString string) (job.Job, error) {
 func notSet(field string) error {
 	return errors.Errorf("%q must be set", field)
 }
+
+// specCoordinators returns the coordinators watched by the given job: those of
+// CoordinatorV1Address and CoordinatorV2Address, followed by those of Coordinators.
+func specCoordinators(spec *job.BlockhashStoreSpec) job.BlockhashStoreCoordinators {
+	var coordinators job.BlockhashStoreCoordinators
+	if spec.CoordinatorV1Address != nil {
+		coordinators = append(coordinators, job.BlockhashStoreCoordinator{
+			Address: *spec.CoordinatorV1Address, Version: "v1"})
+	}
+	if spec.CoordinatorV2Address != nil {
+		coordinators = append(coordinators, job.BlockhashStoreCoordinator{
+			Address: *spec.CoordinatorV2Address, Version: "v2"})
+	}
+	return append(coordinators, spec.Coordinators...)
+}
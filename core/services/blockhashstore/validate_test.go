@@ -112,7 +112,132 @@ blockhashStoreAddress = "0x3e20Cef636EdA7ba135bCbA4fe6177Bd3cE0aB17"
 evmChainID = "4"
 fromAddresses = ["0x469aA2CD13e037DC5236320783dCfd0e641c0559"]`,
 			assertion: func(t *testing.T, os job.Job, err error) {
-				require.EqualError(t, err, `at least one of "coordinatorV1Address" and "coordinatorV2Address" must be set`)
+				require.EqualError(t, err, `at least one of "coordinatorV1Address", "coordinatorV2Address" and "coordinators" must be set`)
+			},
+		},
+		{
+			name: "coordinators list and batch BHS",
+			toml: `
+type = "blockhashstore"
+name = "coordinators-test"
+blockhashStoreAddress = "0x3e20Cef636EdA7ba135bCbA4fe6177Bd3cE0aB17"
+batchBlockhashStoreAddress = "0xD04E5b2ea4e55AEbe6f7522bc2A69Ec6639bfc63"
+evmChainID = "4"
+coordinators = [
+	{ address = "0x1F72B4A5DCf7CC6d2E38423bF2f4BFA7db97d139", version = "v1" },
+	{ address = "0x2be990eE17832b59E0086534c5ea2459Aa75E38F", version = "v2" },
+]`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.NoError(t, err)
+				require.Nil(t, os.BlockhashStoreSpec.CoordinatorV1Address)
+				require.Nil(t, os.BlockhashStoreSpec.CoordinatorV2Address)
+				require.Equal(t, job.BlockhashStoreCoordinators{
+					{Address: v1Coordinator, Version: "v1"},
+					{Address: v2Coordinator, Version: "v2"},
+				}, os.BlockhashStoreSpec.Coordinators)
+				batchBHS := ethkey.EIP55Address("0xD04E5b2ea4e55AEbe6f7522bc2A69Ec6639bfc63")
+				require.Equal(t, &batchBHS, os.BlockhashStoreSpec.BatchBlockhashStoreAddress)
+				require.Equal(t, uint16(10), os.BlockhashStoreSpec.StoreBlockhashesBatchSize)
+			},
+		},
+		{
+			name: "trusted blockhash stores",
+			toml: `
+type = "blockhashstore"
+name = "trusted-test"
+coordinatorV2Address = "0x2be990eE17832b59E0086534c5ea2459Aa75E38F"
+blockhashStoreAddress = "0x3e20Cef636EdA7ba135bCbA4fe6177Bd3cE0aB17"
+evmChainID = "4"
+trustedBlockhashStores = [
+	{ address = "0xD04E5b2ea4e55AEbe6f7522bc2A69Ec6639bfc63" },
+	{ evmChainID = "137", address = "0xD04E5b2ea4e55AEbe6f7522bc2A69Ec6639bfc63", fromAddresses = ["0x469aA2CD13e037DC5236320783dCfd0e641c0559"] },
+]`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.NoError(t, err)
+				trustedBHS := ethkey.EIP55Address("0xD04E5b2ea4e55AEbe6f7522bc2A69Ec6639bfc63")
+				require.Equal(t, job.BlockhashStoreTargets{
+					{EVMChainID: utils.NewBigI(4), Address: trustedBHS},
+					{
+						EVMChainID:    utils.NewBigI(137),
+						Address:       trustedBHS,
+						FromAddresses: fromAddresses,
+					},
+				}, os.BlockhashStoreSpec.TrustedBlockhashStores)
+				require.Equal(t, uint16(10), os.BlockhashStoreSpec.StoreBlockhashesBatchSize)
+			},
+		},
+		{
+			name: "invalid trusted blockhash store without address",
+			toml: `
+type = "blockhashstore"
+name = "trusted-test"
+coordinatorV2Address = "0x2be990eE17832b59E0086534c5ea2459Aa75E38F"
+blockhashStoreAddress = "0x3e20Cef636EdA7ba135bCbA4fe6177Bd3cE0aB17"
+evmChainID = "4"
+trustedBlockhashStores = [
+	{ evmChainID = "137" },
+]`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.EqualError(t, err, `"address" must be set for all "trustedBlockhashStores"`)
+			},
+		},
+		{
+			name: "invalid duplicate trusted blockhash store",
+			toml: `
+type = "blockhashstore"
+name = "trusted-test"
+coordinatorV2Address = "0x2be990eE17832b59E0086534c5ea2459Aa75E38F"
+blockhashStoreAddress = "0x3e20Cef636EdA7ba135bCbA4fe6177Bd3cE0aB17"
+evmChainID = "4"
+trustedBlockhashStores = [
+	{ address = "0xD04E5b2ea4e55AEbe6f7522bc2A69Ec6639bfc63" },
+	{ evmChainID = "4", address = "0xD04E5b2ea4e55AEbe6f7522bc2A69Ec6639bfc63" },
+]`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.EqualError(t, err, `trusted blockhash store 0xD04E5b2ea4e55AEbe6f7522bc2A69Ec6639bfc63 on chain 4 is set more than once`)
+			},
+		},
+		{
+			name: "invalid coordinator version",
+			toml: `
+type = "blockhashstore"
+name = "coordinators-test"
+blockhashStoreAddress = "0x3e20Cef636EdA7ba135bCbA4fe6177Bd3cE0aB17"
+evmChainID = "4"
+coordinators = [
+	{ address = "0x2be990eE17832b59E0086534c5ea2459Aa75E38F", version = "v9" },
+]`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.EqualError(t, err, `unsupported coordinator version "v9", supported versions are v1, v2`)
+			},
+		},
+		{
+			name: "invalid coordinator without address",
+			toml: `
+type = "blockhashstore"
+name = "coordinators-test"
+blockhashStoreAddress = "0x3e20Cef636EdA7ba135bCbA4fe6177Bd3cE0aB17"
+evmChainID = "4"
+coordinators = [
+	{ version = "v2" },
+]`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.EqualError(t, err, `"address" must be set for all "coordinators"`)
+			},
+		},
+		{
+			name: "invalid duplicate coordinator",
+			toml: `
+type = "blockhashstore"
+name = "coordinators-test"
+coordinatorV2Address = "0x2be990eE17832b59E0086534c5ea2459Aa75E38F"
+blockhashStoreAddress = "0x3e20Cef636EdA7ba135bCbA4fe6177Bd3cE0aB17"
+evmChainID = "4"
+coordinators = [
+	{ address = "0x2be990eE17832b59E0086534c5ea2459Aa75E38F", version = "v2" },
+]`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.EqualError(t, err, `coordinator 0x2be990eE17832b59E0086534c5ea2459Aa75E38F is set more than once`)
 			},
 		},
 		{
@@ -102,6 +102,9 @@ type Application interface {
 	// ReplayFromBlock replays logs from on or after the given block number. If forceBroadcast is
 	// set to true, consumers will reprocess data even if it has already been processed.
 	ReplayFromBlock(chainID *big.Int, number uint64, forceBroadcast bool) error
+	// ReplayLogPollerFilter backfills the logs of a single log poller filter from on or after the given block number,
+	// without replaying the other filters.
+	ReplayLogPollerFilter(chainID *big.Int, filterName string, number uint64) error
 
 	// ID is unique to this particular application instance
 	ID() uuid.UUID
@@ -296,10 +299,16 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	promReporter := promreporter.NewPromReporter(db.DB, globalLogger)
 	srvcs = append(srvcs, promReporter)
 
+	var sessionAuthenticator sessions.Authenticator
+	if oidc := cfg.WebServer().OIDC(); oidc.Enabled() {
+		globalLogger.Infow("OIDC: logins will be validated against the OIDC issuer", "issuer", oidc.IssuerURL())
+		sessionAuthenticator = sessions.NewOIDCAuthenticator(oidc, unrestrictedHTTPClient, globalLogger)
+	}
+
 	var (
 		pipelineORM    = pipeline.NewORM(db, globalLogger, cfg.Database(), cfg.JobPipeline().MaxSuccessfulRuns())
 		bridgeORM      = bridges.NewORM(db, globalLogger, cfg.Database())
-		sessionORM     = sessions.NewORM(db, cfg.WebServer().SessionTimeout().Duration(), globalLogger, cfg.Database(), auditLogger)
+		sessionORM     = sessions.NewORMWithAuthenticator(db, cfg.WebServer().SessionTimeout().Duration(), globalLogger, cfg.Database(), auditLogger, sessionAuthenticator, cfg.WebServer().OIDC().AllowLocalLogin())
 		pipelineRunner = pipeline.NewRunner(pipelineORM, bridgeORM, cfg.JobPipeline(), cfg.WebServer(), chains.EVM, keyStore.Eth(), keyStore.VRF(), globalLogger, restrictedHTTPClient, unrestrictedHTTPClient)
 		jobORM         = job.NewORM(db, chains.EVM, pipelineORM, bridgeORM, keyStore, globalLogger, cfg.Database())
 		txmORM         = txmgr.NewTxStore(db, globalLogger, cfg.Database())
@@ -342,6 +351,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 				globalLogger),
 			job.Cron: cron.NewDelegate(
 				pipelineRunner,
+				cron.NewORM(db, globalLogger, cfg.Database()),
 				globalLogger),
 			job.BlockhashStore: blockhashstore.NewDelegate(
 				globalLogger,
@@ -406,7 +416,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		relayers := make(map[relay.Network]loop.Relayer)
 		if cfg.EVMEnabled() {
 			lggr := globalLogger.Named("EVM")
-			evmRelayer := evmrelay.NewRelayer(db, chains.EVM, lggr, cfg, keyStore, eventBroadcaster)
+			evmRelayer := evmrelay.NewRelayer(db, chains.EVM, lggr, cfg.Database(), keyStore, eventBroadcaster)
 			relayers[relay.EVM] = relay.NewRelayerAdapter(evmRelayer, chains.EVM)
 		}
 		if cfg.CosmosEnabled() {
@@ -831,6 +841,15 @@ func (app *ChainlinkApplication) ReplayFromBlock(chainID *big.Int, number uint64
 	return nil
 }
 
+// ReplayLogPollerFilter implements the Application interface.
+func (app *ChainlinkApplication) ReplayLogPollerFilter(chainID *big.Int, filterName string, number uint64) error {
+	chain, err := app.Chains.EVM.Get(chainID)
+	if err != nil {
+		return err
+	}
+	return chain.LogPoller().ReplayFilterAsync(filterName, int64(number))
+}
+
 // GetChains returns Chains.
 func (app *ChainlinkApplication) GetChains() Chains {
 	return app.Chains
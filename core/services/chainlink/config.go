@@ -200,5 +200,8 @@ func (s *Secrets) setEnv() error {
 	if thresholdKeyShare := config.EnvThresholdKeyShare.Get(); thresholdKeyShare != "" {
 		s.Threshold.ThresholdKeyShare = &thresholdKeyShare
 	}
+	if oidcClientSecret := config.EnvWebServerOIDCClientSecret.Get(); oidcClientSecret != "" {
+		s.WebServer.OIDC.ClientSecret = &oidcClientSecret
+	}
 	return nil
 }
@@ -305,6 +305,18 @@ func TestConfig_Marshal(t *testing.T) {
 			RPID:     ptr("test-rpid"),
 			RPOrigin: ptr("test-rp-origin"),
 		},
+		OIDC: config.WebServerOIDC{
+			Enabled:     ptr(true),
+			IssuerURL:   mustURL("https://idp.example.com/realms/chainlink"),
+			ClientID:    ptr("test-client-id"),
+			GroupsClaim: ptr("memberOf"),
+			AdminGroup:  ptr("test-admins"),
+			EditGroup:   ptr("test-editors"),
+			RunGroup:    ptr("test-runners"),
+			ViewGroup:   ptr("test-viewers"),
+
+			AllowLocalLogin: ptr(false),
+		},
 		RateLimit: config.WebServerRateLimit{
 			Authenticated:         ptr[int64](42),
 			AuthenticatedPeriod:   models.MustNewDuration(time.Second),
@@ -327,6 +339,7 @@ func TestConfig_Marshal(t *testing.T) {
 		ReaperInterval:            models.MustNewDuration(4 * time.Hour),
 		ReaperThreshold:           models.MustNewDuration(7 * 24 * time.Hour),
 		ResultWriteQueueDepth:     ptr[uint32](10),
+		SignTaskEnabled:           ptr(true),
 		HTTPRequest: config.JobPipelineHTTPRequest{
 			MaxSize:        ptr[utils.FileSize](100 * utils.MB),
 			DefaultTimeout: models.MustNewDuration(time.Minute),
@@ -439,12 +452,16 @@ func TestConfig_Marshal(t *testing.T) {
 			Chain: evmcfg.Chain{
 				AutoCreateKey: ptr(false),
 				BalanceMonitor: evmcfg.BalanceMonitor{
-					Enabled: ptr(true),
+					Enabled:         ptr(true),
+					TreasuryAddress: mustAddress("0xa0788FC17B1dEe36f057c42B6F373A34B014687e"),
+					MinBalance:      assets.NewWeiI(100_000_000_000_000_000),
+					TargetBalance:   assets.NewWeiI(500_000_000_000_000_000),
 				},
 				BlockBackfillDepth:   ptr[uint32](100),
 				BlockBackfillSkip:    ptr(true),
 				ChainType:            ptr("Optimism"),
 				FinalityDepth:        ptr[uint32](42),
+				FinalityTagEnabled:   ptr[bool](true),
 				FlagsContractAddress: mustAddress("0xae4E781a6218A8031764928E88d457937A954fC3"),
 
 				GasEstimator: evmcfg.GasEstimator{
@@ -486,7 +503,12 @@ func TestConfig_Marshal(t *testing.T) {
 
 				KeySpecific: []evmcfg.KeySpecific{
 					{
-						Key: mustAddress("0x2a3e23c6f242F5345320814aC8a1b4E58707D292"),
+						Key:               mustAddress("0x2a3e23c6f242F5345320814aC8a1b4E58707D292"),
+						ExternalSignerURL: mustURL("http://localhost:9000"),
+						BalanceMonitor: evmcfg.KeySpecificBalanceMonitor{
+							MinBalance:    assets.NewWeiI(1_000_000_000_000_000_000),
+							TargetBalance: assets.NewWeiI(2_000_000_000_000_000_000),
+						},
 						GasEstimator: evmcfg.KeySpecificGasEstimator{
 							PriceMax: assets.NewWei(utils.HexToBig("FFFFFFFFFFFFFFFFFFFFFFFF")),
 						},
@@ -716,6 +738,17 @@ ListenIP = '192.158.1.37'
 RPID = 'test-rpid'
 RPOrigin = 'test-rp-origin'
 
+[WebServer.OIDC]
+Enabled = true
+IssuerURL = 'https://idp.example.com/realms/chainlink'
+ClientID = 'test-client-id'
+GroupsClaim = 'memberOf'
+AdminGroup = 'test-admins'
+EditGroup = 'test-editors'
+RunGroup = 'test-runners'
+ViewGroup = 'test-viewers'
+AllowLocalLogin = false
+
 [WebServer.RateLimit]
 Authenticated = 42
 AuthenticatedPeriod = '1s'
@@ -741,6 +774,7 @@ MaxSuccessfulRuns = 123456
 ReaperInterval = '4h0m0s'
 ReaperThreshold = '168h0m0s'
 ResultWriteQueueDepth = 10
+SignTaskEnabled = true
 
 [JobPipeline.HTTPRequest]
 DefaultTimeout = '1m0s'
@@ -847,6 +881,7 @@ BlockBackfillDepth = 100
 BlockBackfillSkip = true
 ChainType = 'Optimism'
 FinalityDepth = 42
+FinalityTagEnabled = true
 FlagsContractAddress = '0xae4E781a6218A8031764928E88d457937A954fC3'
 LinkContractAddress = '0x538aAaB4ea120b2bC2fe5D296852D948F07D849e'
 LogBackfillBatchSize = 17
@@ -870,6 +905,9 @@ ResendAfterThreshold = '1h0m0s'
 
 [EVM.BalanceMonitor]
 Enabled = true
+TreasuryAddress = '0xa0788FC17B1dEe36f057c42B6F373A34B014687e'
+MinBalance = '100 milli'
+TargetBalance = '500 milli'
 
 [EVM.GasEstimator]
 Mode = 'L2Suggested'
@@ -912,6 +950,11 @@ SamplingInterval = '1h0m0s'
 
 [[EVM.KeySpecific]]
 Key = '0x2a3e23c6f242F5345320814aC8a1b4E58707D292'
+ExternalSignerURL = 'http://localhost:9000'
+
+[EVM.KeySpecific.BalanceMonitor]
+MinBalance = '1 ether'
+TargetBalance = '2 ether'
 
 [EVM.KeySpecific.GasEstimator]
 PriceMax = '79.228162514264337593543950335 gether'
@@ -1085,11 +1128,12 @@ func TestConfig_Validate(t *testing.T) {
 					- WSURL: missing: required for primary nodes
 					- HTTPURL: missing: required for all nodes
 				- 1.HTTPURL: missing: required for all nodes
-		- 1: 6 errors:
+		- 1: 7 errors:
 			- ChainType: invalid value (Foo): must not be set with this chain id
 			- Nodes: missing: must have at least one node
 			- ChainType: invalid value (Foo): must be one of arbitrum, metis, xdai, optimismBedrock, celo or omitted
 			- HeadTracker.HistoryDepth: invalid value (30): must be equal to or greater than FinalityDepth
+			- KeySpecific.BalanceMonitor.TargetBalance: invalid value (1 ether): must be greater than the MinBalance for key 0xde709f2102306220921060314715629080e2fb77
 			- GasEstimator: 2 errors:
 				- FeeCapDefault: invalid value (101 wei): must be equal to PriceMax (99 wei) since you are using FixedPrice estimation with gas bumping disabled in EIP1559 mode - PriceMax will be used as the FeeCap for transactions instead of FeeCapDefault
 				- PriceMax: invalid value (1 gwei): must be greater than or equal to PriceDefault
@@ -96,8 +96,60 @@ func (m *mfaConfig) RPOrigin() string {
 	return *m.c.RPOrigin
 }
 
+type oidcConfig struct {
+	c v2.WebServerOIDC
+	s v2.WebServerOIDCSecrets
+}
+
+func (o *oidcConfig) Enabled() bool {
+	return *o.c.Enabled
+}
+
+func (o *oidcConfig) IssuerURL() *url.URL {
+	if o.c.IssuerURL.IsZero() {
+		return nil
+	}
+	return o.c.IssuerURL.URL()
+}
+
+func (o *oidcConfig) ClientID() string {
+	return *o.c.ClientID
+}
+
+func (o *oidcConfig) ClientSecret() string {
+	if o.s.ClientSecret == nil {
+		return ""
+	}
+	return string(*o.s.ClientSecret)
+}
+
+func (o *oidcConfig) GroupsClaim() string {
+	return *o.c.GroupsClaim
+}
+
+func (o *oidcConfig) AdminGroup() string {
+	return *o.c.AdminGroup
+}
+
+func (o *oidcConfig) EditGroup() string {
+	return *o.c.EditGroup
+}
+
+func (o *oidcConfig) RunGroup() string {
+	return *o.c.RunGroup
+}
+
+func (o *oidcConfig) ViewGroup() string {
+	return *o.c.ViewGroup
+}
+
+func (o *oidcConfig) AllowLocalLogin() bool {
+	return *o.c.AllowLocalLogin
+}
+
 type webServerConfig struct {
 	c       v2.WebServer
+	s       v2.WebServerSecrets
 	rootDir func() string
 }
 
@@ -113,6 +165,10 @@ func (w *webServerConfig) MFA() config.MFA {
 	return &mfaConfig{c: w.c.MFA}
 }
 
+func (w *webServerConfig) OIDC() config.OIDC {
+	return &oidcConfig{c: w.c.OIDC, s: w.s.OIDC}
+}
+
 func (w *webServerConfig) AllowOrigins() string {
 	return *w.c.AllowOrigins
 }
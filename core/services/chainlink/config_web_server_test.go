@@ -46,4 +46,16 @@ func TestWebServerConfig(t *testing.T) {
 	assert.Equal(t, "test-rpid", mf.RPID())
 	assert.Equal(t, "test-rp-origin", mf.RPOrigin())
 
+	oidc := ws.OIDC()
+	assert.True(t, oidc.Enabled())
+	assert.Equal(t, "https://idp.example.com/realms/chainlink", oidc.IssuerURL().String())
+	assert.Equal(t, "test-client-id", oidc.ClientID())
+	assert.Equal(t, "", oidc.ClientSecret())
+	assert.Equal(t, "memberOf", oidc.GroupsClaim())
+	assert.Equal(t, "test-admins", oidc.AdminGroup())
+	assert.Equal(t, "test-editors", oidc.EditGroup())
+	assert.Equal(t, "test-runners", oidc.RunGroup())
+	assert.Equal(t, "test-viewers", oidc.ViewGroup())
+	assert.False(t, oidc.AllowLocalLogin())
+
 }
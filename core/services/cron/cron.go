@@ -3,41 +3,81 @@ package cron
 import (
 	"context"
 	"fmt"
+	"sync"
+	"time"
 
+	"github.com/pkg/errors"
 	"github.com/robfig/cron/v3"
 
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
+var parser = cron.NewParser(cron.Second | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
 // Cron runs a cron jobSpec from a CronSpec
 type Cron struct {
 	cronRunner     *cron.Cron
+	schedule       cron.Schedule
+	entryID        cron.EntryID
 	logger         logger.Logger
 	jobSpec        job.Job
 	pipelineRunner pipeline.Runner
+	orm            ORM
 	chStop         utils.StopChan
+	wg             sync.WaitGroup
+
+	// running is held while a run is in progress, and queued while a run
+	// waits for it, depending on the overlap policy.
+	running chan struct{}
+	queued  chan struct{}
+
+	// preinsert is set when the pipeline has async tasks, so that its runs
+	// are saved when they are created rather than when they finish.
+	preinsert bool
 }
 
 // NewCronFromJobSpec instantiates a job that executes on a predefined schedule.
 func NewCronFromJobSpec(
 	jobSpec job.Job,
 	pipelineRunner pipeline.Runner,
+	orm ORM,
 	logger logger.Logger,
 ) (*Cron, error) {
 	cronLogger := logger.Named("Cron").With(
 		"jobID", jobSpec.ID,
 		"schedule", jobSpec.CronSpec.CronSchedule,
+		"timezone", jobSpec.CronSpec.Timezone,
 	)
 
+	schedule, err := parser.Parse(qualifiedSchedule(*jobSpec.CronSpec))
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid cron schedule '%v' for job %d", jobSpec.CronSpec.CronSchedule, jobSpec.ID)
+	}
+
+	var preinsert bool
+	if jobSpec.CronSpec.CatchUpWindow > 0 {
+		p, err := pipeline.Parse(jobSpec.PipelineSpec.DotDagSource)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid pipeline for job %d", jobSpec.ID)
+		}
+		preinsert = p.RequiresPreInsert()
+	}
+
 	return &Cron{
 		cronRunner:     cronRunner(),
+		schedule:       schedule,
 		logger:         cronLogger,
 		jobSpec:        jobSpec,
 		pipelineRunner: pipelineRunner,
+		orm:            orm,
+		preinsert:      preinsert,
 		chStop:         make(chan struct{}),
+		running:        make(chan struct{}, 1),
+		queued:         make(chan struct{}, 1),
 	}, nil
 }
 
@@ -45,12 +85,18 @@ func NewCronFromJobSpec(
 func (cr *Cron) Start(context.Context) error {
 	cr.logger.Debug("Starting")
 
-	_, err := cr.cronRunner.AddFunc(cr.jobSpec.CronSpec.CronSchedule, cr.runPipeline)
-	if err != nil {
-		cr.logger.Errorw(fmt.Sprintf("Error running cron job %d", cr.jobSpec.ID), "err", err, "schedule", cr.jobSpec.CronSpec.CronSchedule, "jobID", cr.jobSpec.ID)
-		return err
+	cr.entryID = cr.cronRunner.Schedule(cr.schedule, cron.FuncJob(cr.tick))
+	if cr.jobSpec.CronSpec.CatchUpWindow <= 0 {
+		cr.cronRunner.Start()
+		return nil
 	}
-	cr.cronRunner.Start()
+
+	cr.wg.Add(1)
+	go func() {
+		defer cr.wg.Done()
+		cr.catchUp()
+		cr.cronRunner.Start()
+	}()
 	return nil
 }
 
@@ -58,11 +104,102 @@ func (cr *Cron) Start(context.Context) error {
 // running and cleans up resources.
 func (cr *Cron) Close() error {
 	cr.logger.Debug("Closing")
+	close(cr.chStop)
+	cr.wg.Wait()
 	cr.cronRunner.Stop()
 	return nil
 }
 
-func (cr *Cron) runPipeline() {
+// catchUp executes, in order, the runs that were scheduled within the catch up
+// window but missed, e.g. while the node was down. Runs scheduled up to the
+// last fired run of the job, or before the job was created, were not missed.
+func (cr *Cron) catchUp() {
+	ctx, cancel := cr.chStop.NewCtx()
+	defer cancel()
+
+	from := time.Now().Add(-cr.jobSpec.CronSpec.CatchUpWindow)
+	if cr.jobSpec.CreatedAt.After(from) {
+		from = cr.jobSpec.CreatedAt
+	}
+	lastScheduled, err := cr.orm.LastScheduledAt(ctx, cr.jobSpec.CronSpec.ID)
+	if err != nil {
+		cr.logger.Errorw("Failed to load the last scheduled run, not catching up on missed runs", "err", err)
+		return
+	}
+	if lastScheduled.After(from) {
+		from = lastScheduled
+	}
+
+	// time.Now() is re-evaluated on every iteration, so that runs which become
+	// due while catching up are not missed either.
+	for scheduled := cr.schedule.Next(from); !scheduled.After(time.Now()); scheduled = cr.schedule.Next(scheduled) {
+		select {
+		case <-cr.chStop:
+			return
+		default:
+		}
+		cr.logger.Infow("Catching up on missed run", "scheduledTime", scheduled)
+		cr.runPipeline(scheduled)
+	}
+}
+
+// tick is called by the cron runner whenever a run is due, and applies the
+// overlap policy of the job.
+func (cr *Cron) tick() {
+	scheduled := cr.cronRunner.Entry(cr.entryID).Prev
+
+	switch cr.jobSpec.CronSpec.OverlapPolicy {
+	case job.CronOverlapSkip:
+		select {
+		case cr.running <- struct{}{}:
+		default:
+			cr.logger.Warnw("Skipping run, the previous run is still in progress", "scheduledTime", scheduled)
+			cr.skipped(scheduled)
+			return
+		}
+	case job.CronOverlapQueueOne:
+		select {
+		case cr.queued <- struct{}{}:
+		default:
+			cr.logger.Warnw("Skipping run, the previous run is still in progress and another run is already queued", "scheduledTime", scheduled)
+			cr.skipped(scheduled)
+			return
+		}
+		select {
+		case cr.running <- struct{}{}:
+			<-cr.queued
+		case <-cr.chStop:
+			<-cr.queued
+			return
+		}
+	default:
+		cr.runPipeline(scheduled)
+		return
+	}
+	defer func() { <-cr.running }()
+
+	cr.runPipeline(scheduled)
+}
+
+// skipped records the run scheduled at the given time as handled when the
+// overlap policy skips it, so that it is not caught up on after a restart.
+// Only jobs catching up on missed runs need this.
+func (cr *Cron) skipped(scheduled time.Time) {
+	if cr.jobSpec.CronSpec.CatchUpWindow <= 0 {
+		return
+	}
+	ctx, cancel := cr.chStop.NewCtx()
+	defer cancel()
+
+	if err := cr.orm.SetLastScheduledAt(cr.jobSpec.CronSpec.ID, scheduled, nil, pg.WithParentCtx(ctx)); err != nil {
+		cr.logger.Errorw("Failed to save the skipped scheduled run", "err", err, "scheduledTime", scheduled)
+	}
+}
+
+// runPipeline executes the run scheduled at the given time. For jobs catching
+// up on missed runs, the scheduled time is saved in the same transaction as
+// the run, when the run is created or, without async tasks, when it finishes.
+func (cr *Cron) runPipeline(scheduled time.Time) {
 	ctx, cancel := cr.chStop.NewCtx()
 	defer cancel()
 
@@ -73,15 +210,41 @@ func (cr *Cron) runPipeline() {
 			"name":          cr.jobSpec.Name.ValueOrZero(),
 		},
 		"jobRun": map[string]interface{}{
-			"meta": map[string]interface{}{},
+			"meta":          map[string]interface{}{},
+			"scheduledTime": scheduled.Unix(),
 		},
 	})
 
+	if cr.jobSpec.CronSpec.CatchUpWindow > 0 && !cr.preinsert {
+		run, _, err := cr.pipelineRunner.ExecuteRun(ctx, *cr.jobSpec.PipelineSpec, vars, cr.logger)
+		if err != nil {
+			cr.logger.Errorw(fmt.Sprintf("Error executing new run for jobSpec ID %v", cr.jobSpec.ID), "err", err, "scheduledTime", scheduled)
+			return
+		}
+		err = cr.orm.SetLastScheduledAt(cr.jobSpec.CronSpec.ID, scheduled, func(tx pg.Queryer) error {
+			// don't insert if we exited early
+			if run.FailSilently {
+				return nil
+			}
+			return cr.pipelineRunner.InsertFinishedRun(&run, false, pg.WithQueryer(tx))
+		}, pg.WithParentCtx(ctx))
+		if err != nil {
+			cr.logger.Errorw(fmt.Sprintf("Error saving new run for jobSpec ID %v", cr.jobSpec.ID), "err", err, "scheduledTime", scheduled)
+		}
+		return
+	}
+
+	var fn func(tx pg.Queryer) error
+	if cr.jobSpec.CronSpec.CatchUpWindow > 0 {
+		fn = func(tx pg.Queryer) error {
+			return cr.orm.SetLastScheduledAt(cr.jobSpec.CronSpec.ID, scheduled, nil, pg.WithQueryer(tx))
+		}
+	}
 	run := pipeline.NewRun(*cr.jobSpec.PipelineSpec, vars)
 
-	_, err := cr.pipelineRunner.Run(ctx, &run, cr.logger, false, nil)
+	_, err := cr.pipelineRunner.Run(ctx, &run, cr.logger, false, fn)
 	if err != nil {
-		cr.logger.Errorf("Error executing new run for jobSpec ID %v", cr.jobSpec.ID)
+		cr.logger.Errorw(fmt.Sprintf("Error executing new run for jobSpec ID %v", cr.jobSpec.ID), "err", err, "scheduledTime", scheduled)
 	}
 }
 
@@ -1,7 +1,10 @@
 package cron_test
 
 import (
+	"context"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -16,7 +19,9 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/cron"
+	cronmocks "github.com/smartcontractkit/chainlink/v2/core/services/cron/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	pipelinemocks "github.com/smartcontractkit/chainlink/v2/core/services/pipeline/mocks"
 )
@@ -40,7 +45,7 @@ func TestCronV2Pipeline(t *testing.T) {
 		PipelineSpec:  &pipeline.Spec{},
 		ExternalJobID: uuid.New(),
 	}
-	delegate := cron.NewDelegate(runner, lggr)
+	delegate := cron.NewDelegate(runner, cron.NewORM(db, lggr, cfg.Database()), lggr)
 
 	err := jobORM.CreateJob(jb)
 	require.NoError(t, err)
@@ -54,6 +59,32 @@ func TestCronV2Pipeline(t *testing.T) {
 	defer func() { assert.NoError(t, service.Close()) }()
 }
 
+func TestCronV2Pipeline_TimezoneAndOverlapPolicy(t *testing.T) {
+	cfg := configtest.NewTestGeneralConfig(t)
+	db := pgtest.NewSqlxDB(t)
+
+	keyStore := cltest.NewKeyStore(t, db, cfg.Database())
+	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg, Client: evmtest.NewEthClientMockWithDefaultChain(t), KeyStore: keyStore.Eth()})
+	lggr := logger.TestLogger(t)
+	orm := pipeline.NewORM(db, lggr, cfg.Database(), cfg.JobPipeline().MaxSuccessfulRuns())
+	btORM := bridges.NewORM(db, lggr, cfg.Database())
+	jobORM := job.NewORM(db, cc, orm, btORM, keyStore, lggr, cfg.Database())
+
+	jb := &job.Job{
+		Type:          job.Cron,
+		SchemaVersion: 1,
+		CronSpec:      &job.CronSpec{CronSchedule: "@every 1s", Timezone: "UTC", OverlapPolicy: job.CronOverlapSkip},
+		PipelineSpec:  &pipeline.Spec{},
+		ExternalJobID: uuid.New(),
+	}
+	require.NoError(t, jobORM.CreateJob(jb))
+
+	created, err := jobORM.FindJob(testutils.Context(t), jb.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "UTC", created.CronSpec.Timezone)
+	assert.Equal(t, job.CronOverlapSkip, created.CronSpec.OverlapPolicy)
+}
+
 func TestCronV2Schedule(t *testing.T) {
 	t.Parallel()
 
@@ -70,7 +101,7 @@ func TestCronV2Schedule(t *testing.T) {
 		Return(false, nil).
 		Once()
 
-	service, err := cron.NewCronFromJobSpec(spec, runner, logger.TestLogger(t))
+	service, err := cron.NewCronFromJobSpec(spec, runner, cronmocks.NewORM(t), logger.TestLogger(t))
 	require.NoError(t, err)
 	err = service.Start(testutils.Context(t))
 	require.NoError(t, err)
@@ -78,3 +109,207 @@ func TestCronV2Schedule(t *testing.T) {
 
 	awaiter.AwaitOrFail(t)
 }
+
+func TestCronV2Schedule_OverlapPolicy(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		policy       job.CronOverlapPolicy
+		expectedRuns int32
+	}{
+		{job.CronOverlapSkip, 1},
+		{job.CronOverlapQueueOne, 2},
+	} {
+		test := test
+		t.Run(string(test.policy), func(t *testing.T) {
+			t.Parallel()
+
+			spec := job.Job{
+				Type:          job.Cron,
+				SchemaVersion: 1,
+				CronSpec:      &job.CronSpec{CronSchedule: "@every 1s", OverlapPolicy: test.policy},
+				PipelineSpec:  &pipeline.Spec{},
+			}
+			var runs atomic.Int32
+			started := make(chan time.Time, 10)
+			release := make(chan struct{})
+			runner := pipelinemocks.NewRunner(t)
+			runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
+				Run(func(args mock.Arguments) {
+					runs.Add(1)
+					select {
+					case started <- time.Now():
+					default:
+					}
+					select {
+					case <-release:
+					case <-args.Get(0).(context.Context).Done():
+					}
+				}).
+				Return(false, nil).
+				Maybe()
+
+			service, err := cron.NewCronFromJobSpec(spec, runner, cronmocks.NewORM(t), logger.TestLogger(t))
+			require.NoError(t, err)
+			require.NoError(t, service.Start(testutils.Context(t)))
+			defer func() { assert.NoError(t, service.Close()) }()
+
+			var first time.Time
+			select {
+			case first = <-started:
+			case <-time.After(testutils.WaitTimeout(t)):
+				t.Fatal("timed out waiting for the first run")
+			}
+			// further runs become due while the first one is in progress, release it
+			// half way between two ticks so that no new run is due when checking
+			time.Sleep(time.Until(first.Add(2500 * time.Millisecond)))
+			assert.Equal(t, int32(1), runs.Load())
+
+			release <- struct{}{}
+			time.Sleep(200 * time.Millisecond)
+			assert.Equal(t, test.expectedRuns, runs.Load())
+		})
+	}
+}
+
+func TestCronV2Schedule_OverlapSkipMarksSkippedRuns(t *testing.T) {
+	t.Parallel()
+
+	spec := job.Job{
+		Type:          job.Cron,
+		SchemaVersion: 1,
+		CronSpec:      &job.CronSpec{ID: 7, CronSchedule: "@every 1s", OverlapPolicy: job.CronOverlapSkip, CatchUpWindow: time.Hour},
+		PipelineSpec:  &pipeline.Spec{DotDagSource: `ds [type=bridge name=test]`},
+		CreatedAt:     time.Now(),
+	}
+
+	started := make(chan struct{}, 10)
+	release := make(chan struct{})
+	runner := pipelinemocks.NewRunner(t)
+	runner.On("ExecuteRun", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			select {
+			case started <- struct{}{}:
+			default:
+			}
+			select {
+			case <-release:
+			case <-args.Get(0).(context.Context).Done():
+			}
+		}).
+		Return(pipeline.Run{}, pipeline.TaskRunResults{}, nil).
+		Maybe()
+	runner.On("InsertFinishedRun", mock.Anything, false, mock.Anything).Return(nil).Maybe()
+
+	skipped := make(chan time.Time, 10)
+	orm := cronmocks.NewORM(t)
+	orm.On("LastScheduledAt", mock.Anything, int32(7)).Return(time.Time{}, nil).Once()
+	orm.On("SetLastScheduledAt", int32(7), mock.AnythingOfType("time.Time"), mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			if fn := args.Get(2).(func(pg.Queryer) error); fn != nil {
+				require.NoError(t, fn(nil))
+				return
+			}
+			skipped <- args.Get(1).(time.Time)
+		}).
+		Return(nil).
+		Maybe()
+
+	service, err := cron.NewCronFromJobSpec(spec, runner, orm, logger.TestLogger(t))
+	require.NoError(t, err)
+	require.NoError(t, service.Start(testutils.Context(t)))
+	defer func() { assert.NoError(t, service.Close()) }()
+
+	testutils.WaitWithTimeout(t, started, "timed out waiting for the first run")
+	select {
+	case scheduled := <-skipped:
+		assert.False(t, scheduled.IsZero())
+	case <-time.After(testutils.WaitTimeout(t)):
+		t.Fatal("timed out waiting for the skipped run to be recorded")
+	}
+	close(release)
+}
+
+func TestCronV2Schedule_CatchUp(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name     string
+		pipeline string
+	}{
+		{"finished runs", `ds [type=bridge name=test]`},
+		{"runs with async tasks", `submit [type=ethtx to="0x0000000000000000000000000000000000000000" data="0x"]`},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			hour := time.Now().Truncate(time.Hour)
+			spec := job.Job{
+				Type:          job.Cron,
+				SchemaVersion: 1,
+				CronSpec:      &job.CronSpec{ID: 7, CronSchedule: "0 0 * * * *", Timezone: "UTC", CatchUpWindow: 24 * time.Hour},
+				PipelineSpec:  &pipeline.Spec{ID: 42, DotDagSource: test.pipeline},
+				CreatedAt:     hour.Add(-48 * time.Hour),
+			}
+
+			var scheduled []int64
+			awaiter := cltest.NewAwaiter()
+			ran := func(scheduledTime interface{}) {
+				scheduled = append(scheduled, scheduledTime.(int64))
+				if len(scheduled) == 2 {
+					awaiter.ItHappened()
+				}
+			}
+
+			// the run scheduled two hours ago was fired, the ones after were missed
+			orm := cronmocks.NewORM(t)
+			orm.On("LastScheduledAt", mock.Anything, int32(7)).Return(hour.Add(-2*time.Hour), nil).Once()
+			var fired []time.Time
+			orm.On("SetLastScheduledAt", int32(7), mock.AnythingOfType("time.Time"), mock.Anything, mock.Anything).
+				Run(func(args mock.Arguments) {
+					fired = append(fired, args.Get(1).(time.Time))
+					if fn := args.Get(2).(func(pg.Queryer) error); fn != nil {
+						require.NoError(t, fn(nil))
+					}
+				}).
+				Return(nil).
+				Twice()
+
+			runner := pipelinemocks.NewRunner(t)
+			if test.name == "finished runs" {
+				// the scheduled time is saved with the finished run
+				runner.On("ExecuteRun", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Run(func(args mock.Arguments) {
+						scheduledTime, err := args.Get(2).(pipeline.Vars).Get("jobRun.scheduledTime")
+						require.NoError(t, err)
+						ran(scheduledTime)
+					}).
+					Return(pipeline.Run{}, pipeline.TaskRunResults{}, nil).
+					Twice()
+				runner.On("InsertFinishedRun", mock.AnythingOfType("*pipeline.Run"), false, mock.Anything).Return(nil).Twice()
+			} else {
+				// the scheduled time is saved with the created run
+				runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, false, mock.Anything).
+					Run(func(args mock.Arguments) {
+						require.NoError(t, args.Get(4).(func(pg.Queryer) error)(nil))
+						jobRun := args.Get(1).(*pipeline.Run).Inputs.Val.(map[string]interface{})["jobRun"].(map[string]interface{})
+						ran(jobRun["scheduledTime"])
+					}).
+					Return(false, nil).
+					Twice()
+			}
+
+			service, err := cron.NewCronFromJobSpec(spec, runner, orm, logger.TestLogger(t))
+			require.NoError(t, err)
+			require.NoError(t, service.Start(testutils.Context(t)))
+			defer func() { assert.NoError(t, service.Close()) }()
+
+			awaiter.AwaitOrFail(t)
+			assert.Equal(t, []int64{hour.Add(-time.Hour).Unix(), hour.Unix()}, scheduled)
+			require.Len(t, fired, 2)
+			assert.True(t, hour.Add(-time.Hour).Equal(fired[0]))
+			assert.True(t, hour.Equal(fired[1]))
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package cron
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/sqlx"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+)
+
+//go:generate mockery --quiet --name ORM --output ./mocks/ --case=underscore
+
+// ORM keeps track of the runs scheduled by cron jobs, so that missed runs can
+// be caught up on exactly once.
+type ORM interface {
+	// LastScheduledAt returns the scheduled time of the latest run fired for the
+	// given cron spec, or the zero time if none was fired yet.
+	LastScheduledAt(ctx context.Context, cronSpecID int32) (time.Time, error)
+	// SetLastScheduledAt records that the run scheduled at the given time was
+	// handled for the given cron spec, whether it was executed or skipped.
+	// Earlier times are ignored. If fn is not nil, it is called in the same
+	// transaction, so that the run and its scheduled time are saved together.
+	SetLastScheduledAt(cronSpecID int32, scheduled time.Time, fn func(tx pg.Queryer) error, qopts ...pg.QOpt) error
+}
+
+type orm struct {
+	q pg.Q
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.QConfig) ORM {
+	return &orm{pg.NewQ(db, lggr.Named("CronORM"), cfg)}
+}
+
+func (o *orm) LastScheduledAt(ctx context.Context, cronSpecID int32) (time.Time, error) {
+	var scheduled time.Time
+	q := o.q.WithOpts(pg.WithParentCtx(ctx))
+	err := q.Get(&scheduled, `SELECT scheduled_at FROM cron_last_scheduled_runs WHERE cron_spec_id = $1`, cronSpecID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return time.Time{}, nil
+	}
+	return scheduled, errors.Wrap(err, "failed to load last scheduled run")
+}
+
+func (o *orm) SetLastScheduledAt(cronSpecID int32, scheduled time.Time, fn func(tx pg.Queryer) error, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	return q.Transaction(func(tx pg.Queryer) error {
+		if fn != nil {
+			if err := fn(tx); err != nil {
+				return err
+			}
+		}
+		_, err := tx.Exec(`INSERT INTO cron_last_scheduled_runs (cron_spec_id, scheduled_at) VALUES ($1, $2)
+	ON CONFLICT (cron_spec_id) DO UPDATE SET scheduled_at = EXCLUDED.scheduled_at
+	WHERE cron_last_scheduled_runs.scheduled_at < EXCLUDED.scheduled_at`, cronSpecID, scheduled)
+		return errors.Wrap(err, "failed to save last scheduled run")
+	})
+}
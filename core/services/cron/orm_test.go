@@ -0,0 +1,70 @@
+package cron_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	configtest "github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest/v2"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/cron"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestORM_LastScheduledAt(t *testing.T) {
+	cfg := configtest.NewTestGeneralConfig(t)
+	db := pgtest.NewSqlxDB(t)
+	lggr := logger.TestLogger(t)
+
+	keyStore := cltest.NewKeyStore(t, db, cfg.Database())
+	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg, Client: evmtest.NewEthClientMockWithDefaultChain(t), KeyStore: keyStore.Eth()})
+	pipelineORM := pipeline.NewORM(db, lggr, cfg.Database(), cfg.JobPipeline().MaxSuccessfulRuns())
+	jobORM := job.NewORM(db, cc, pipelineORM, bridges.NewORM(db, lggr, cfg.Database()), keyStore, lggr, cfg.Database())
+	jb := &job.Job{
+		Type:          job.Cron,
+		SchemaVersion: 1,
+		CronSpec:      &job.CronSpec{CronSchedule: "0 0 * * * *", CatchUpWindow: time.Hour},
+		PipelineSpec:  &pipeline.Spec{},
+		ExternalJobID: uuid.New(),
+	}
+	require.NoError(t, jobORM.CreateJob(jb))
+	specID := *jb.CronSpecID
+
+	orm := cron.NewORM(db, lggr, cfg.Database())
+	ctx := testutils.Context(t)
+
+	scheduled, err := orm.LastScheduledAt(ctx, specID)
+	require.NoError(t, err)
+	assert.True(t, scheduled.IsZero())
+
+	latest := time.Now().Truncate(time.Hour)
+	require.NoError(t, orm.SetLastScheduledAt(specID, latest, nil, pg.WithParentCtx(ctx)))
+	// an earlier run fired late does not move the last scheduled run back
+	require.NoError(t, orm.SetLastScheduledAt(specID, latest.Add(-time.Hour), nil, pg.WithParentCtx(ctx)))
+	// the last scheduled run is not saved if saving its run fails
+	err = orm.SetLastScheduledAt(specID, latest.Add(time.Hour), func(pg.Queryer) error {
+		return errors.New("failed to save run")
+	}, pg.WithParentCtx(ctx))
+	require.EqualError(t, err, "failed to save run")
+
+	scheduled, err = orm.LastScheduledAt(ctx, specID)
+	require.NoError(t, err)
+	assert.True(t, latest.Equal(scheduled), "expected %v, got %v", latest, scheduled)
+
+	// the last scheduled run is deleted with the job
+	require.NoError(t, jobORM.DeleteJob(jb.ID))
+	scheduled, err = orm.LastScheduledAt(ctx, specID)
+	require.NoError(t, err)
+	assert.True(t, scheduled.IsZero())
+}
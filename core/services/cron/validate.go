@@ -1,6 +1,10 @@
 package cron
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
@@ -34,9 +38,39 @@ func ValidatedCronSpec(tomlString string) (job.Job, error) {
 	if jb.Type != job.Cron {
 		return jb, errors.Errorf("unsupported type %s", jb.Type)
 	}
-	if err := utils.ValidateCronSchedule(spec.CronSchedule); err != nil {
+	if spec.Timezone != "" {
+		if strings.HasPrefix(spec.CronSchedule, "CRON_TZ=") || strings.HasPrefix(spec.CronSchedule, "TZ=") {
+			return jb, errors.New("cron schedule cannot specify a time zone when timezone is set")
+		}
+		if _, err := time.LoadLocation(spec.Timezone); err != nil {
+			return jb, errors.Wrapf(err, "invalid timezone '%v'", spec.Timezone)
+		}
+	}
+	if err := utils.ValidateCronSchedule(qualifiedSchedule(spec)); err != nil {
 		return jb, errors.Wrapf(err, "while validating cron schedule '%v'", spec.CronSchedule)
 	}
 
+	switch spec.OverlapPolicy {
+	case "":
+		spec.OverlapPolicy = job.CronOverlapAllow
+	case job.CronOverlapAllow, job.CronOverlapSkip, job.CronOverlapQueueOne:
+	default:
+		return jb, errors.Errorf("invalid overlapPolicy '%v', must be one of %s, %s or %s", spec.OverlapPolicy, job.CronOverlapAllow, job.CronOverlapSkip, job.CronOverlapQueueOne)
+	}
+
+	if spec.CatchUpWindow < 0 {
+		return jb, errors.Errorf("catchUpWindow must not be negative, got %v", spec.CatchUpWindow)
+	} else if spec.CatchUpWindow > 0 && strings.HasPrefix(spec.CronSchedule, "@every ") {
+		return jb, errors.New("catchUpWindow cannot be used with @every schedules")
+	}
+
 	return jb, nil
 }
+
+// qualifiedSchedule returns the schedule of spec, prefixed with its timezone if one is set.
+func qualifiedSchedule(spec job.CronSpec) string {
+	if spec.Timezone == "" {
+		return spec.CronSchedule
+	}
+	return fmt.Sprintf("CRON_TZ=%s %s", spec.Timezone, spec.CronSchedule)
+}
@@ -1,9 +1,14 @@
 package fluxmonitorv2
 
 import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
 
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 )
 
 // DeviationThresholds carries parameters used by the threshold-trigger logic
@@ -12,11 +17,132 @@ type DeviationThresholds struct {
 	Abs float64 // Absolute change required, i.e. |new-old| >= Abs
 }
 
+// DeviationTier carries deviation thresholds which apply within a window of time recurring on
+// given days of the week.
+type DeviationTier struct {
+	DeviationThresholds
+
+	days     map[time.Weekday]struct{} // nil for every day
+	from     time.Duration             // since midnight
+	to       time.Duration             // since midnight
+	location *time.Location
+}
+
+// NewDeviationTier parses the deviation tier of a job spec.
+func NewDeviationTier(spec job.FluxMonitorDeviationTier) (DeviationTier, error) {
+	tier := DeviationTier{
+		DeviationThresholds: DeviationThresholds{
+			Rel: float64(spec.Threshold),
+			Abs: float64(spec.AbsoluteThreshold),
+		},
+		to:       24 * time.Hour,
+		location: time.UTC,
+	}
+
+	var err error
+	if spec.From != "" {
+		if tier.from, err = parseTimeOfDay(spec.From); err != nil {
+			return tier, errors.Wrap(err, "invalid from")
+		}
+	}
+	if spec.To != "" {
+		if tier.to, err = parseTimeOfDay(spec.To); err != nil {
+			return tier, errors.Wrap(err, "invalid to")
+		}
+	}
+	if tier.from == tier.to {
+		return tier, errors.New("from and to must differ")
+	}
+	if spec.Timezone != "" {
+		if tier.location, err = time.LoadLocation(spec.Timezone); err != nil {
+			return tier, errors.Wrap(err, "invalid timezone")
+		}
+	}
+	for _, d := range spec.Days {
+		day, ok := parseWeekday(d)
+		if !ok {
+			return tier, errors.Errorf("invalid day %q, expected e.g. \"Mon\" or \"Monday\"", d)
+		}
+		if tier.days == nil {
+			tier.days = make(map[time.Weekday]struct{})
+		}
+		tier.days[day] = struct{}{}
+	}
+	return tier, nil
+}
+
+// NewDeviationTiers parses the deviation tiers of a job spec.
+func NewDeviationTiers(specs job.FluxMonitorDeviationTiers) ([]DeviationTier, error) {
+	var tiers []DeviationTier
+	for i, spec := range specs {
+		tier, err := NewDeviationTier(spec)
+		if err != nil {
+			return nil, errors.Wrapf(err, "deviation tier %d", i)
+		}
+		tiers = append(tiers, tier)
+	}
+	return tiers, nil
+}
+
+// Contains returns whether the given time is within the window of the tier.
+func (t DeviationTier) Contains(at time.Time) bool {
+	at = at.In(t.location)
+	// By the wall clock, so that windows keep their times of day across daylight saving changes.
+	sinceMidnight := time.Duration(at.Hour())*time.Hour + time.Duration(at.Minute())*time.Minute +
+		time.Duration(at.Second())*time.Second
+
+	if t.from < t.to {
+		return t.startsOn(at.Weekday()) && sinceMidnight >= t.from && sinceMidnight < t.to
+	}
+	// The window spans midnight, so it started either today or yesterday.
+	if sinceMidnight >= t.from {
+		return t.startsOn(at.Weekday())
+	}
+	if sinceMidnight < t.to {
+		return t.startsOn((at.Weekday() + 6) % 7)
+	}
+	return false
+}
+
+func (t DeviationTier) startsOn(day time.Weekday) bool {
+	if t.days == nil {
+		return true
+	}
+	_, ok := t.days[day]
+	return ok
+}
+
+// parseTimeOfDay parses a time of day such as "09:30" into the duration since midnight. "24:00"
+// is the end of the day.
+func parseTimeOfDay(s string) (time.Duration, error) {
+	if s == "24:00" {
+		return 24 * time.Hour, nil
+	}
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, errors.Errorf("%q is not a time of day such as \"09:30\"", s)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// parseWeekday parses the full or the three-letter name of a day of the week, in any case.
+func parseWeekday(s string) (time.Weekday, bool) {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if strings.EqualFold(s, day.String()) || strings.EqualFold(s, day.String()[:3]) {
+			return day, true
+		}
+	}
+	return 0, false
+}
+
 // DeviationChecker checks the deviation of the next answer against the current
 // answer.
 type DeviationChecker struct {
 	Thresholds DeviationThresholds
-	lggr       logger.Logger
+	// Tiers replace Thresholds within their windows of time. The first tier whose window
+	// contains the time of a check applies.
+	Tiers []DeviationTier
+	lggr  logger.Logger
 }
 
 // NewDeviationChecker constructs a new deviation checker with thresholds.
@@ -30,6 +156,24 @@ func NewDeviationChecker(rel, abs float64, lggr logger.Logger) *DeviationChecker
 	}
 }
 
+// NewTieredDeviationChecker constructs a new deviation checker with thresholds, replaced by those
+// of the given tiers within their windows of time.
+func NewTieredDeviationChecker(rel, abs float64, tiers []DeviationTier, lggr logger.Logger) *DeviationChecker {
+	c := NewDeviationChecker(rel, abs, lggr)
+	c.Tiers = tiers
+	return c
+}
+
+// ThresholdsAt returns the thresholds which apply at the given time.
+func (c *DeviationChecker) ThresholdsAt(at time.Time) DeviationThresholds {
+	for _, tier := range c.Tiers {
+		if tier.Contains(at) {
+			return tier.DeviationThresholds
+		}
+	}
+	return c.Thresholds
+}
+
 // NewZeroDeviationChecker constructs a new deviation checker with 0 as thresholds.
 func NewZeroDeviationChecker(lggr logger.Logger) *DeviationChecker {
 	return NewDeviationChecker(0, 0, lggr)
@@ -43,7 +187,14 @@ func (c *DeviationChecker) OutsideDeviation(curAnswer, nextAnswer decimal.Decima
 		"nextAnswer", nextAnswer,
 	}
 
-	if c.Thresholds.Rel == 0 && c.Thresholds.Abs == 0 {
+	thresholds := c.ThresholdsAt(time.Now())
+	if len(c.Tiers) > 0 {
+		loggerFields = append(loggerFields,
+			"tierThreshold", thresholds.Rel,
+			"tierAbsoluteThreshold", thresholds.Abs)
+	}
+
+	if thresholds.Rel == 0 && thresholds.Abs == 0 {
 		c.lggr.Debugw(
 			"Deviation thresholds both zero; short-circuiting deviation checker to "+
 				"true, regardless of feed values", loggerFields...)
@@ -52,7 +203,7 @@ func (c *DeviationChecker) OutsideDeviation(curAnswer, nextAnswer decimal.Decima
 	diff := curAnswer.Sub(nextAnswer).Abs()
 	loggerFields = append(loggerFields, "absoluteDeviation", diff)
 
-	if !diff.GreaterThan(decimal.NewFromFloat(c.Thresholds.Abs)) {
+	if !diff.GreaterThan(decimal.NewFromFloat(thresholds.Abs)) {
 		c.lggr.Debugw("Absolute deviation threshold not met", loggerFields...)
 		return false
 	}
@@ -71,7 +222,7 @@ func (c *DeviationChecker) OutsideDeviation(curAnswer, nextAnswer decimal.Decima
 
 	loggerFields = append(loggerFields, "percentage", percentage)
 
-	if percentage.LessThan(decimal.NewFromFloat(c.Thresholds.Rel)) {
+	if percentage.LessThan(decimal.NewFromFloat(thresholds.Rel)) {
 		c.lggr.Debugw("Relative deviation threshold not met", loggerFields...)
 		return false
 	}
@@ -3,12 +3,15 @@ package fluxmonitorv2_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/fluxmonitorv2"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 )
 
 type outsideDeviationRow struct {
@@ -81,3 +84,83 @@ func TestDeviationChecker_OutsideDeviation(t *testing.T) {
 		t.Run(tc.name+" max absolute threshold", func(t *testing.T) { c(test3) })
 	}
 }
+
+func TestDeviationTier_Contains(t *testing.T) {
+	t.Parallel()
+
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	// 2023-06-05 is a Monday.
+	at := func(day, hour, min int) time.Time { return time.Date(2023, 6, day, hour, min, 0, 0, time.UTC) }
+
+	testCases := []struct {
+		name     string
+		spec     job.FluxMonitorDeviationTier
+		at       time.Time
+		contains bool
+	}{
+		{"every day", job.FluxMonitorDeviationTier{}, at(4, 12, 0), true},
+		{"start of window", job.FluxMonitorDeviationTier{From: "09:30", To: "16:00"}, at(5, 9, 30), true},
+		{"before window", job.FluxMonitorDeviationTier{From: "09:30", To: "16:00"}, at(5, 9, 29), false},
+		{"end of window", job.FluxMonitorDeviationTier{From: "09:30", To: "16:00"}, at(5, 16, 0), false},
+		{"until end of day", job.FluxMonitorDeviationTier{From: "22:00"}, at(5, 23, 59), true},
+		{"on day", job.FluxMonitorDeviationTier{Days: []string{"monday"}}, at(5, 12, 0), true},
+		{"not on day", job.FluxMonitorDeviationTier{Days: []string{"Mon", "Wed"}}, at(6, 12, 0), false},
+		{"timezone", job.FluxMonitorDeviationTier{Days: []string{"Mon"}, From: "09:30", To: "16:00", Timezone: "America/New_York"}, time.Date(2023, 6, 5, 9, 30, 0, 0, newYork), true},
+		{"timezone in UTC", job.FluxMonitorDeviationTier{From: "09:30", To: "16:00", Timezone: "America/New_York"}, at(5, 9, 30), false},
+		{"across midnight, before midnight", job.FluxMonitorDeviationTier{Days: []string{"Fri"}, From: "22:00", To: "02:00"}, at(9, 23, 0), true},
+		{"across midnight, after midnight", job.FluxMonitorDeviationTier{Days: []string{"Fri"}, From: "22:00", To: "02:00"}, at(10, 1, 0), true},
+		{"across midnight, started on other day", job.FluxMonitorDeviationTier{Days: []string{"Fri"}, From: "22:00", To: "02:00"}, at(9, 1, 0), false},
+		{"across midnight, outside window", job.FluxMonitorDeviationTier{From: "22:00", To: "02:00"}, at(9, 2, 0), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			tier, err := fluxmonitorv2.NewDeviationTier(tc.spec)
+			require.NoError(t, err)
+			assert.Equal(t, tc.contains, tier.Contains(tc.at))
+		})
+	}
+}
+
+func TestNewDeviationTier_Invalid(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		spec job.FluxMonitorDeviationTier
+		err  string
+	}{
+		{"day", job.FluxMonitorDeviationTier{Days: []string{"Someday"}}, `invalid day "Someday", expected e.g. "Mon" or "Monday"`},
+		{"from", job.FluxMonitorDeviationTier{From: "9am"}, `invalid from: "9am" is not a time of day such as "09:30"`},
+		{"to", job.FluxMonitorDeviationTier{To: "24:01"}, `invalid to: "24:01" is not a time of day such as "09:30"`},
+		{"empty window", job.FluxMonitorDeviationTier{From: "10:00", To: "10:00"}, "from and to must differ"},
+		{"timezone", job.FluxMonitorDeviationTier{Timezone: "Mars/Base"}, "invalid timezone: unknown time zone Mars/Base"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := fluxmonitorv2.NewDeviationTier(tc.spec)
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func TestDeviationChecker_ThresholdsAt(t *testing.T) {
+	t.Parallel()
+
+	tiers, err := fluxmonitorv2.NewDeviationTiers(job.FluxMonitorDeviationTiers{
+		{Threshold: 0.5, From: "09:30", To: "16:00"},
+		{Threshold: 1, AbsoluteThreshold: 0.01, From: "12:00", To: "18:00"},
+	})
+	require.NoError(t, err)
+	checker := fluxmonitorv2.NewTieredDeviationChecker(2, 0, tiers, logger.TestLogger(t))
+
+	at := func(hour int) time.Time { return time.Date(2023, 6, 5, hour, 0, 0, 0, time.UTC) }
+	assert.Equal(t, fluxmonitorv2.DeviationThresholds{Rel: 2}, checker.ThresholdsAt(at(8)))
+	// The first matching tier applies.
+	assert.Equal(t, fluxmonitorv2.DeviationThresholds{Rel: 0.5}, checker.ThresholdsAt(at(13)))
+	assert.Equal(t, fluxmonitorv2.DeviationThresholds{Rel: 1, Abs: float64(float32(0.01))}, checker.ThresholdsAt(at(17)))
+}
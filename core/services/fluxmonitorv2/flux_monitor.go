@@ -48,6 +48,7 @@ const (
 	PollRequestTypeRetry
 	PollRequestTypeAwaken
 	PollRequestTypeDrumbeat
+	PollRequestTypeHeartbeat
 )
 
 // DefaultHibernationPollPeriod defines the hibernation polling period
@@ -223,6 +224,11 @@ func NewFromJobSpec(
 		return nil, err
 	}
 
+	deviationTiers, err := NewDeviationTiers(fmSpec.DeviationTiers)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid deviation tiers")
+	}
+
 	fmLogger := lggr.With(
 		"jobID", jobSpec.ID,
 		"contract", fmSpec.ContractAddress.Hex(),
@@ -237,6 +243,7 @@ func NewFromJobSpec(
 			DrumbeatSchedule:        fmSpec.DrumbeatSchedule,
 			DrumbeatEnabled:         fmSpec.DrumbeatEnabled,
 			DrumbeatRandomDelay:     fmSpec.DrumbeatRandomDelay,
+			HeartbeatSchedule:       fmSpec.HeartbeatSchedule,
 			HibernationPollPeriod:   DefaultHibernationPollPeriod, // Not currently configurable
 			MinRetryBackoffDuration: 1 * time.Minute,
 			MaxRetryBackoffDuration: 1 * time.Hour,
@@ -260,9 +267,10 @@ func NewFromJobSpec(
 		paymentChecker,
 		fmSpec.ContractAddress.Address(),
 		contractSubmitter,
-		NewDeviationChecker(
+		NewTieredDeviationChecker(
 			float64(fmSpec.Threshold),
 			float64(fmSpec.AbsoluteThreshold),
+			deviationTiers,
 			fmLogger,
 		),
 		NewSubmissionChecker(min, max),
@@ -443,6 +451,12 @@ func (fm *FluxMonitor) consume() {
 				fm.pollIfEligible(PollRequestTypeDrumbeat, NewZeroDeviationChecker(fm.logger), nil)
 			})
 
+		case at := <-fm.pollManager.HeartbeatTicks():
+			tickLogger.Debugf("Heartbeat ticker fired on %v", formatTime(at))
+			recovery.WrapRecover(fm.logger, func() {
+				fm.pollIfEligible(PollRequestTypeHeartbeat, NewZeroDeviationChecker(fm.logger), nil)
+			})
+
 		case request := <-fm.pollManager.Poll():
 			switch request.Type {
 			case PollRequestTypeUnknown:
@@ -811,9 +825,10 @@ func (fm *FluxMonitor) checkEligibilityAndAggregatorFunding(roundState flux_aggr
 func (fm *FluxMonitor) pollIfEligible(pollReq PollRequestType, deviationChecker *DeviationChecker, broadcast log.Broadcast) {
 	started := time.Now()
 
+	thresholds := deviationChecker.ThresholdsAt(started)
 	l := fm.logger.With(
-		"threshold", deviationChecker.Thresholds.Rel,
-		"absoluteThreshold", deviationChecker.Thresholds.Abs,
+		"threshold", thresholds.Rel,
+		"absoluteThreshold", thresholds.Abs,
 	)
 	var markConsumed = true
 	defer func() {
@@ -137,6 +137,7 @@ type setupOptions struct {
 	drumbeatEnabled       bool
 	drumbeatSchedule      string
 	drumbeatRandomDelay   time.Duration
+	heartbeatSchedule     string
 	hibernationPollPeriod time.Duration
 	flags                 *fmmocks.Flags
 	orm                   fluxmonitorv2.ORM
@@ -173,6 +174,7 @@ func setup(t *testing.T, db *sqlx.DB, optionFns ...func(*setupOptions)) (*fluxmo
 			DrumbeatEnabled:         options.drumbeatEnabled,
 			DrumbeatSchedule:        options.drumbeatSchedule,
 			DrumbeatRandomDelay:     options.drumbeatRandomDelay,
+			HeartbeatSchedule:       options.heartbeatSchedule,
 			HibernationPollPeriod:   options.hibernationPollPeriod,
 			MinRetryBackoffDuration: 1 * time.Minute,
 			MaxRetryBackoffDuration: 1 * time.Hour,
@@ -230,6 +232,13 @@ func enableDrumbeatTicker(schedule string, randomDelay time.Duration) func(*setu
 	}
 }
 
+// enableHeartbeatTicker is an option to enable the heartbeat ticker during setup
+func enableHeartbeatTicker(schedule string) func(*setupOptions) {
+	return func(opts *setupOptions) {
+		opts.heartbeatSchedule = schedule
+	}
+}
+
 // setIdleTimerPeriod is an option to set the idle timer period during setup
 func setIdleTimerPeriod(period time.Duration) func(*setupOptions) {
 	return func(opts *setupOptions) {
@@ -1934,3 +1943,98 @@ func TestFluxMonitor_DrumbeatTicker(t *testing.T) {
 	cltest.EventuallyExpectationsMet(t, tm.pipelineORM, waitTime, interval)
 	cltest.EventuallyExpectationsMet(t, tm.contractSubmitter, waitTime, interval)
 }
+
+func TestFluxMonitor_HeartbeatTicker(t *testing.T) {
+	t.Parallel()
+
+	db, nodeAddr := setupStoreWithKey(t)
+	oracles := []common.Address{nodeAddr, testutils.NewAddress()}
+
+	fm, tm := setup(t, db, disablePollTicker(true), disableIdleTimer(true), enableHeartbeatTicker("CRON_TZ=UTC */3 * * * * *"))
+
+	tm.keyStore.On("EnabledKeysForChain", testutils.FixtureChainID).Return([]ethkey.KeyV2{{Address: nodeAddr}}, nil)
+
+	// The fetched answer is the latest submission, so no round would be submitted on deviation.
+	const fetchedAnswer = 100
+	answerBigInt := big.NewInt(fetchedAnswer)
+
+	tm.fluxAggregator.On("Address").Return(common.Address{})
+	tm.fluxAggregator.On("GetOracles", nilOpts).Return(oracles, nil)
+	tm.logBroadcaster.On("Register", mock.Anything, mock.Anything).Return(func() {})
+	tm.logBroadcaster.On("IsConnected").Return(true).Maybe()
+
+	tm.fluxAggregator.On("LatestRoundData", nilOpts).Return(freshContractRoundDataResponse()).Once()
+
+	expectSubmission := func(roundID uint32, runID int64) {
+		tm.fluxAggregator.On("OracleRoundState", nilOpts, nodeAddr, uint32(0)).
+			Return(flux_aggregator_wrapper.OracleRoundState{
+				RoundId:          roundID,
+				EligibleToSubmit: true,
+				LatestSubmission: answerBigInt,
+				AvailableFunds:   big.NewInt(1).Mul(big.NewInt(10000), defaultMinimumContractPayment.ToInt()),
+				PaymentAmount:    defaultMinimumContractPayment.ToInt(),
+				StartedAt:        now(),
+			}, nil).
+			Once()
+
+		tm.orm.On("FindOrCreateFluxMonitorRoundStats", contractAddress, roundID, mock.Anything).
+			Return(fluxmonitorv2.FluxMonitorRoundStatsV2{Aggregator: contractAddress, RoundID: roundID}, nil).
+			Once()
+
+		tm.fluxAggregator.On("LatestRoundData", nilOpts).
+			Return(flux_aggregator_wrapper.LatestRoundData{
+				Answer:    answerBigInt,
+				UpdatedAt: big.NewInt(100),
+			}, nil).
+			Once()
+
+		tm.pipelineRunner.
+			On("ExecuteRun", mock.Anything, pipelineSpec, mock.Anything, mock.Anything).
+			Return(pipeline.Run{}, pipeline.TaskRunResults{
+				{
+					Result: pipeline.Result{
+						Value: decimal.NewFromInt(fetchedAnswer),
+						Error: nil,
+					},
+					Task: &pipeline.HTTPTask{},
+				},
+			}, nil).
+			Once()
+
+		tm.pipelineRunner.On("InsertFinishedRun", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil).
+			Run(func(args mock.Arguments) {
+				args.Get(0).(*pipeline.Run).ID = runID
+			}).
+			Once()
+		tm.contractSubmitter.
+			On("Submit", big.NewInt(int64(roundID)), answerBigInt, mock.Anything).
+			Return(nil).
+			Once()
+
+		tm.orm.
+			On("UpdateFluxMonitorRoundStats", contractAddress, roundID, runID, mock.Anything, mock.Anything).
+			Return(nil).
+			Once()
+	}
+
+	expectSubmission(2, 1)
+	expectSubmission(3, 2)
+
+	// catch remaining heartbeats
+	tm.fluxAggregator.
+		On("OracleRoundState", nilOpts, nodeAddr, uint32(0)).
+		Return(flux_aggregator_wrapper.OracleRoundState{RoundId: 3, EligibleToSubmit: false, LatestSubmission: answerBigInt, StartedAt: now()}, nil).
+		Maybe()
+
+	require.NoError(t, fm.Start(testutils.Context(t)))
+	defer func() { assert.NoError(t, fm.Close()) }()
+
+	waitTime := 15 * time.Second
+	interval := 50 * time.Millisecond
+	cltest.EventuallyExpectationsMet(t, tm.logBroadcaster, waitTime, interval)
+	cltest.EventuallyExpectationsMet(t, tm.fluxAggregator, waitTime, interval)
+	cltest.EventuallyExpectationsMet(t, tm.orm, waitTime, interval)
+	cltest.EventuallyExpectationsMet(t, tm.pipelineORM, waitTime, interval)
+	cltest.EventuallyExpectationsMet(t, tm.contractSubmitter, waitTime, interval)
+}
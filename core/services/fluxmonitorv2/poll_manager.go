@@ -19,6 +19,7 @@ type PollManagerConfig struct {
 	DrumbeatSchedule        string
 	DrumbeatEnabled         bool
 	DrumbeatRandomDelay     time.Duration
+	HeartbeatSchedule       string
 	HibernationPollPeriod   time.Duration
 	MinRetryBackoffDuration time.Duration
 	MaxRetryBackoffDuration time.Duration
@@ -42,6 +43,10 @@ type PollManagerConfig struct {
 // since the last round was start and the IdleTimerPeriod has elapsed. This can
 // also be known as a heartbeat.
 //
+// HeartbeatTicker - The heartbeat ticker requests a poll on a cron schedule,
+// e.g. at the top of every hour, so that a round is submitted on wall clock
+// boundaries regardless of deviation. It runs alongside the idle timer.
+//
 // RoundTimer - The round timer requests a poll when the round state provided by
 // the contract has timed out.
 //
@@ -58,6 +63,7 @@ type PollManager struct {
 	roundTimer       utils.ResettableTimer
 	retryTicker      utils.BackoffTicker
 	drumbeat         utils.CronTicker
+	heartbeat        utils.CronTicker
 	chPoll           chan PollRequest
 
 	logger logger.Logger
@@ -98,6 +104,12 @@ func NewPollManager(cfg PollManagerConfig, logger logger.Logger) (*PollManager,
 			return nil, err
 		}
 	}
+	if cfg.HeartbeatSchedule != "" {
+		p.heartbeat, err = utils.NewCronTicker(cfg.HeartbeatSchedule)
+		if err != nil {
+			return nil, err
+		}
+	}
 	p.isHibernating.Store(cfg.IsHibernating)
 	return p, nil
 }
@@ -132,6 +144,11 @@ func (pm *PollManager) DrumbeatTicks() <-chan time.Time {
 	return pm.drumbeat.Ticks()
 }
 
+// HeartbeatTicks ticks on a cron schedule when a heartbeat schedule is set
+func (pm *PollManager) HeartbeatTicks() <-chan time.Time {
+	return pm.heartbeat.Ticks()
+}
+
 // Poll returns a channel which the manager will use to send polling requests
 //
 // Note: In the future, we should change the tickers above to send their request
@@ -183,6 +200,7 @@ func (pm *PollManager) Reset(roundState flux_aggregator_wrapper.OracleRoundState
 		pm.startIdleTimer(roundState.StartedAt)
 		pm.startRoundTimer(roundStateTimesOutAt(roundState))
 		pm.startDrumbeat()
+		pm.startHeartbeat()
 	}
 }
 
@@ -212,6 +230,7 @@ func (pm *PollManager) Stop() {
 	pm.idleTimer.Stop()
 	pm.roundTimer.Stop()
 	pm.drumbeat.Stop()
+	pm.heartbeat.Stop()
 }
 
 // Hibernate sets hibernation to true, starts the hibernation timer and stops
@@ -228,6 +247,7 @@ func (pm *PollManager) Hibernate() {
 	pm.idleTimer.Stop()
 	pm.roundTimer.Stop()
 	pm.drumbeat.Stop()
+	pm.heartbeat.Stop()
 	pm.StopRetryTicker()
 }
 
@@ -245,6 +265,7 @@ func (pm *PollManager) Awaken(roundState flux_aggregator_wrapper.OracleRoundStat
 	pm.startIdleTimer(roundState.StartedAt)
 	pm.startRoundTimer(roundStateTimesOutAt(roundState))
 	pm.startDrumbeat()
+	pm.startHeartbeat()
 }
 
 // startPollTicker starts the poll ticker if it is enabled
@@ -342,6 +363,17 @@ func (pm *PollManager) startDrumbeat() {
 	}
 }
 
+// startHeartbeat starts the heartbeat ticker if a schedule is set
+func (pm *PollManager) startHeartbeat() {
+	if pm.cfg.HeartbeatSchedule == "" {
+		return
+	}
+
+	if pm.heartbeat.Start() {
+		pm.logger.Debugw("started heartbeat ticker", "schedule", pm.cfg.HeartbeatSchedule)
+	}
+}
+
 func roundStateTimesOutAt(rs flux_aggregator_wrapper.OracleRoundState) uint64 {
 	return rs.StartedAt + rs.Timeout
 }
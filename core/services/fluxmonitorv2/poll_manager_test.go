@@ -36,6 +36,7 @@ type tickChecker struct {
 	roundTicked       bool
 	hibernationTicked bool
 	retryTicked       bool
+	heartbeatTicked   bool
 	initialPoll       bool
 }
 
@@ -47,6 +48,7 @@ func watchTicks(t *testing.T, pm *fluxmonitorv2.PollManager, waitDuration time.D
 		roundTicked:       false,
 		hibernationTicked: false,
 		retryTicked:       false,
+		heartbeatTicked:   false,
 		initialPoll:       false,
 	}
 
@@ -63,6 +65,8 @@ func watchTicks(t *testing.T, pm *fluxmonitorv2.PollManager, waitDuration time.D
 			ticks.hibernationTicked = true
 		case <-pm.RetryTickerTicks():
 			ticks.retryTicked = true
+		case <-pm.HeartbeatTicks():
+			ticks.heartbeatTicked = true
 		case request := <-pm.Poll():
 			switch request.Type {
 			case fluxmonitorv2.PollRequestTypeInitial:
@@ -125,6 +129,29 @@ func TestPollManager_IdleTimer(t *testing.T) {
 	assert.False(t, ticks.roundTicked)
 }
 
+func TestPollManager_HeartbeatTicker(t *testing.T) {
+	pm, err := fluxmonitorv2.NewPollManager(fluxmonitorv2.PollManagerConfig{
+		PollTickerInterval:    pollTickerDefaultDuration,
+		PollTickerDisabled:    true,
+		IdleTimerPeriod:       time.Hour,
+		IdleTimerDisabled:     false,
+		HeartbeatSchedule:     "CRON_TZ=UTC * * * * * *",
+		HibernationPollPeriod: 24 * time.Hour,
+	}, logger.TestLogger(t))
+	require.NoError(t, err)
+
+	pm.Start(false, flux_aggregator_wrapper.OracleRoundState{
+		StartedAt: uint64(time.Now().Unix()),
+	})
+	t.Cleanup(pm.Stop)
+
+	ticks := watchTicks(t, pm, 2*time.Second)
+
+	assert.False(t, ticks.pollTicked)
+	assert.False(t, ticks.idleTicked)
+	assert.True(t, ticks.heartbeatTicked)
+}
+
 func TestPollManager_RoundTimer(t *testing.T) {
 	pm, err := fluxmonitorv2.NewPollManager(fluxmonitorv2.PollManagerConfig{
 		PollTickerInterval:    pollTickerDefaultDuration,
@@ -1,6 +1,7 @@
 package fluxmonitorv2
 
 import (
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -71,6 +72,19 @@ func ValidatedFluxMonitorSpec(config ValidationConfig, ts string) (job.Job, erro
 		}
 	}
 
+	if jb.FluxMonitorSpec.HeartbeatSchedule != "" {
+		if strings.HasPrefix(jb.FluxMonitorSpec.HeartbeatSchedule, "@every ") {
+			return jb, errors.New("heartbeat schedule must be aligned to the wall clock using CRON_TZ, e.g. 'CRON_TZ=UTC 0 0 * * * *'")
+		}
+		if err := utils.ValidateCronSchedule(jb.FluxMonitorSpec.HeartbeatSchedule); err != nil {
+			return jb, errors.Wrap(err, "while validating heartbeat schedule")
+		}
+	}
+
+	if _, err := NewDeviationTiers(jb.FluxMonitorSpec.DeviationTiers); err != nil {
+		return jb, errors.Wrap(err, "while validating deviation tiers")
+	}
+
 	if !validatePollTimer(jb.FluxMonitorSpec.PollTimerDisabled, minTimeout, jb.FluxMonitorSpec.PollTimerPeriod) {
 		return jb, errors.Errorf("PollTimerPeriod (%v) must be equal or greater than the smallest value of MaxTaskDuration param, JobPipeline.HTTPRequest.DefaultTimeout config var, or MinTimeout of all tasks (%v)", jb.FluxMonitorSpec.PollTimerPeriod, minTimeout)
 	}
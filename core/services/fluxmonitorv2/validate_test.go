@@ -208,6 +208,146 @@ externalJobID = "cfa3fa6b-2850-446b-b973-8f4c3b29d519"
 				require.NoError(t, err)
 			},
 		},
+		{
+			name: "deviation tiers",
+			toml: `
+type              = "fluxmonitor"
+schemaVersion       = 1
+name                = "example flux monitor spec"
+contractAddress   = "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"
+maxTaskDuration = "1s"
+threshold = 2.0
+absoluteThreshold = 0.0
+deviationTiers = [
+	{ threshold = 0.5, days = ["Mon", "Tue", "Wed", "Thu", "Fri"], from = "09:30", to = "16:00", timezone = "America/New_York" },
+	{ threshold = 1.0, absoluteThreshold = 0.01, from = "22:00", to = "02:00" },
+]
+
+idleTimerDisabled = true
+pollTimerPeriod = "1m"
+
+observationSource = """
+ds1 [type=http method=GET url="https://pricesource1.com" requestData="{\\"coin\\": \\"ETH\\", \\"market\\": \\"USD\\"}" timeout="500ms"];
+ds1_parse [type=jsonparse path="latest"];
+ds1 -> ds1_parse;
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.NoError(t, err)
+				require.Len(t, s.FluxMonitorSpec.DeviationTiers, 2)
+				assert.Equal(t, job.FluxMonitorDeviationTier{
+					Threshold: 0.5,
+					Days:      []string{"Mon", "Tue", "Wed", "Thu", "Fri"},
+					From:      "09:30",
+					To:        "16:00",
+					Timezone:  "America/New_York",
+				}, s.FluxMonitorSpec.DeviationTiers[0])
+				assert.Equal(t, job.FluxMonitorDeviationTier{
+					Threshold:         1,
+					AbsoluteThreshold: 0.01,
+					From:              "22:00",
+					To:                "02:00",
+				}, s.FluxMonitorSpec.DeviationTiers[1])
+			},
+		},
+		{
+			name: "invalid deviation tier",
+			toml: `
+type              = "fluxmonitor"
+schemaVersion       = 1
+name                = "example flux monitor spec"
+contractAddress   = "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"
+maxTaskDuration = "1s"
+threshold = 2.0
+deviationTiers = [
+	{ threshold = 0.5, days = ["Someday"] },
+]
+
+idleTimerDisabled = true
+pollTimerPeriod = "1m"
+
+observationSource = """
+ds1 [type=http method=GET url="https://pricesource1.com"];
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				assert.EqualError(t, err, `while validating deviation tiers: deviation tier 0: invalid day "Someday", expected e.g. "Mon" or "Monday"`)
+			},
+		},
+		{
+			name: "heartbeat and idle both active",
+			toml: `
+type              = "fluxmonitor"
+schemaVersion       = 1
+name                = "example flux monitor spec"
+contractAddress   = "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"
+maxTaskDuration = "1s"
+threshold = 0.5
+
+idleTimerDisabled = false
+idleTimerPeriod = "1h"
+
+heartbeatSchedule = "CRON_TZ=America/New_York 0 0 * * * *"
+
+pollTimerPeriod = "1m"
+
+observationSource = """
+ds1 [type=http method=GET url="https://pricesource1.com" requestData="{\\"coin\\": \\"ETH\\", \\"market\\": \\"USD\\"}" timeout="500ms"];
+ds1_parse [type=jsonparse path="latest"];
+ds1 -> ds1_parse;
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "CRON_TZ=America/New_York 0 0 * * * *", s.FluxMonitorSpec.HeartbeatSchedule)
+				assert.False(t, s.FluxMonitorSpec.IdleTimerDisabled)
+			},
+		},
+		{
+			name: "heartbeat not aligned to the wall clock",
+			toml: `
+type              = "fluxmonitor"
+schemaVersion       = 1
+name                = "example flux monitor spec"
+contractAddress   = "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"
+maxTaskDuration = "1s"
+threshold = 0.5
+
+idleTimerDisabled = true
+heartbeatSchedule = "@every 1h"
+pollTimerPeriod = "1m"
+
+observationSource = """
+ds1 [type=http method=GET url="https://pricesource1.com"];
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				assert.EqualError(t, err, "heartbeat schedule must be aligned to the wall clock using CRON_TZ, e.g. 'CRON_TZ=UTC 0 0 * * * *'")
+			},
+		},
+		{
+			name: "invalid heartbeat schedule",
+			toml: `
+type              = "fluxmonitor"
+schemaVersion       = 1
+name                = "example flux monitor spec"
+contractAddress   = "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"
+maxTaskDuration = "1s"
+threshold = 0.5
+
+idleTimerDisabled = true
+heartbeatSchedule = "CRON_TZ=UTC 0 61 * * *"
+pollTimerPeriod = "1m"
+
+observationSource = """
+ds1 [type=http method=GET url="https://pricesource1.com"];
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "while validating heartbeat schedule")
+			},
+		},
 	}
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
@@ -175,6 +175,7 @@ func (h *functionsConnectorHandler) sendResponse(ctx context.Context, gatewayId
 			DonId:     requestBody.DonId,
 			Method:    requestBody.Method,
 			Sender:    h.nodeAddress,
+			Receiver:  requestBody.Sender,
 			Payload:   payloadJson,
 		},
 	}
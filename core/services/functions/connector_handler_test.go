@@ -74,6 +74,7 @@ func TestFunctionsConnectorHandler(t *testing.T) {
 				msg, ok := args[2].(*api.Message)
 				require.True(t, ok)
 				require.Equal(t, `{"success":true,"rows":[{"slot_id":1,"version":1,"expiration":1},{"slot_id":2,"version":2,"expiration":2}]}`, string(msg.Body.Payload))
+				require.Equal(t, addr.Hex(), msg.Body.Receiver)
 
 			}).Return(nil).Once()
 
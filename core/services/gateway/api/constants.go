@@ -10,6 +10,7 @@ const (
 	RequestTimeoutError
 	NodeReponseEncodingError
 	FatalError
+	LimitExceededError
 )
 
 // See https://www.jsonrpc.org/specification#error_object
@@ -22,6 +23,7 @@ func ToJsonRPCErrorCode(errorCode ErrorCode) int {
 		RequestTimeoutError:      -32000, // Server Error
 		NodeReponseEncodingError: -32603, // Internal Error
 		FatalError:               -32000, // Server Error
+		LimitExceededError:       -32005, // Limit Exceeded (EIP-1474)
 	}
 
 	code, ok := gatewayErrorToJsonRPCError[errorCode]
@@ -41,6 +43,7 @@ func ToHttpErrorCode(errorCode ErrorCode) int {
 		RequestTimeoutError:      504, // Gateway Timeout
 		NodeReponseEncodingError: 500, // Internal Server Error
 		FatalError:               500, // Internal Server Error
+		LimitExceededError:       429, // Too Many Requests
 	}
 
 	code, ok := gatewayErrorToHttpError[errorCode]
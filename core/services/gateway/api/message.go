@@ -37,6 +37,8 @@ type MessageBody struct {
 	Method    string `json:"method"`
 	DonId     string `json:"don_id"`
 	Sender    string `json:"sender"`
+	// Receiver is set by nodes on their responses, to the sender of the request they answer.
+	Receiver string `json:"receiver,omitempty"`
 
 	// Service-specific payload, decoded inside the Handler.
 	Payload json.RawMessage `json:"payload,omitempty"`
@@ -58,6 +60,9 @@ func (m *Message) Validate() error {
 	if len(m.Body.DonId) == 0 || len(m.Body.DonId) > MessageDonIdMaxLen {
 		return errors.New("invalid DON ID length")
 	}
+	if len(m.Body.Receiver) != 0 && len(m.Body.Receiver) != MessageSenderHexEncodedLen {
+		return errors.New("invalid receiver length")
+	}
 	signerBytes, err := m.ValidateSignature()
 	if err != nil {
 		return err
@@ -75,6 +80,7 @@ func (m *Message) Validate() error {
 //  2. Method aligned to 64 bytes
 //  3. DonId aligned to 64 bytes
 //  4. Payload (before parsing)
+//  5. Receiver aligned to 42 bytes, only when set
 func (m *Message) Sign(privateKey *ecdsa.PrivateKey) error {
 	rawData, err := getRawMessageBody(&m.Body)
 	if err != nil {
@@ -110,5 +116,12 @@ func getRawMessageBody(msgBody *MessageBody) ([][]byte, error) {
 	copy(alignedMethod, msgBody.Method)
 	alignedDonId := make([]byte, MessageDonIdMaxLen)
 	copy(alignedDonId, msgBody.DonId)
-	return [][]byte{alignedMessageId, alignedMethod, alignedDonId, msgBody.Payload}, nil
+	rawData := [][]byte{alignedMessageId, alignedMethod, alignedDonId, msgBody.Payload}
+	// Leaving out an empty receiver keeps the signatures of user messages unchanged.
+	if len(msgBody.Receiver) > 0 {
+		alignedReceiver := make([]byte, MessageSenderHexEncodedLen)
+		copy(alignedReceiver, msgBody.Receiver)
+		rawData = append(rawData, alignedReceiver)
+	}
+	return rawData, nil
 }
@@ -42,6 +42,18 @@ func TestMessage_Validate(t *testing.T) {
 	require.Error(t, msg.Validate())
 	msg.Body.Method = "request"
 
+	// receiver is covered by the signature
+	msg.Body.Receiver = "0x0000000000000000000000000000000000000001"
+	require.Error(t, msg.Validate())
+	require.NoError(t, msg.Sign(privateKey))
+	require.NoError(t, msg.Validate())
+
+	// invalid receiver
+	msg.Body.Receiver = "0x01"
+	require.Error(t, msg.Validate())
+	msg.Body.Receiver = ""
+	require.NoError(t, msg.Sign(privateKey))
+
 	// invalid signature
 	msg.Signature = "0x00"
 	require.Error(t, msg.Validate())
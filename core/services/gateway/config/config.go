@@ -10,7 +10,9 @@ type GatewayConfig struct {
 	UserServerConfig        gw_net.HTTPServerConfig
 	NodeServerConfig        gw_net.WebSocketServerConfig
 	ConnectionManagerConfig ConnectionManagerConfig
-	Dons                    []DONConfig
+	// UserRateLimiterConfig applies to user requests across all DONs
+	UserRateLimiterConfig RateLimiterConfig
+	Dons                  []DONConfig
 }
 
 type ConnectionManagerConfig struct {
@@ -18,11 +20,24 @@ type ConnectionManagerConfig struct {
 	AuthChallengeLen          uint32
 }
 
+// RateLimiterConfig configures token-bucket limits for user requests.
+// Zero RPS values disable the corresponding limit.
+type RateLimiterConfig struct {
+	GlobalRPS      float64
+	GlobalBurst    int
+	PerSenderRPS   float64
+	PerSenderBurst int
+}
+
 type DONConfig struct {
 	DonId         string
 	HandlerName   string
 	HandlerConfig json.RawMessage
 	Members       []NodeConfig
+	// F is the maximum number of faulty nodes in the DON.
+	F int
+	// UserRateLimiterConfig applies to user requests sent to this DON only
+	UserRateLimiterConfig RateLimiterConfig
 }
 
 type NodeConfig struct {
@@ -30,11 +30,12 @@ type HandlerFactory interface {
 type gateway struct {
 	utils.StartStopOnce
 
-	codec      api.Codec
-	httpServer gw_net.HttpServer
-	handlers   map[string]handlers.Handler
-	connMgr    ConnectionManager
-	lggr       logger.Logger
+	codec       api.Codec
+	httpServer  gw_net.HttpServer
+	handlers    map[string]handlers.Handler
+	connMgr     ConnectionManager
+	rateLimiter *UserRateLimiter
+	lggr        logger.Logger
 }
 
 func NewGatewayFromConfig(config *config.GatewayConfig, handlerFactory HandlerFactory, lggr logger.Logger) (Gateway, error) {
@@ -44,6 +45,10 @@ func NewGatewayFromConfig(config *config.GatewayConfig, handlerFactory HandlerFa
 	if err != nil {
 		return nil, err
 	}
+	rateLimiter, err := NewUserRateLimiter(config)
+	if err != nil {
+		return nil, err
+	}
 
 	handlerMap := make(map[string]handlers.Handler)
 	for _, donConfig := range config.Dons {
@@ -63,16 +68,18 @@ func NewGatewayFromConfig(config *config.GatewayConfig, handlerFactory HandlerFa
 		handlerMap[donConfig.DonId] = handler
 		donConnMgr.SetHandler(handler)
 	}
-	return NewGateway(codec, httpServer, handlerMap, connMgr, lggr), nil
+	return NewGateway(codec, httpServer, handlerMap, connMgr, rateLimiter, lggr), nil
 }
 
-func NewGateway(codec api.Codec, httpServer gw_net.HttpServer, handlers map[string]handlers.Handler, connMgr ConnectionManager, lggr logger.Logger) Gateway {
+// rateLimiter can be nil to disable rate limiting of user requests.
+func NewGateway(codec api.Codec, httpServer gw_net.HttpServer, handlers map[string]handlers.Handler, connMgr ConnectionManager, rateLimiter *UserRateLimiter, lggr logger.Logger) Gateway {
 	gw := &gateway{
-		codec:      codec,
-		httpServer: httpServer,
-		handlers:   handlers,
-		connMgr:    connMgr,
-		lggr:       lggr.Named("gateway"),
+		codec:       codec,
+		httpServer:  httpServer,
+		handlers:    handlers,
+		connMgr:     connMgr,
+		rateLimiter: rateLimiter,
+		lggr:        lggr.Named("gateway"),
 	}
 	httpServer.SetHTTPRequestHandler(gw)
 	return gw
@@ -117,6 +124,18 @@ func (g *gateway) ProcessRequest(ctx context.Context, rawRequest []byte) (rawRes
 	if !ok {
 		return newError(g.codec, msg.Body.MessageId, api.UnsupportedDONIdError, "unsupported DON ID")
 	}
+	// apply rate limits
+	if g.rateLimiter != nil {
+		if g.rateLimiter.RequiresSender() {
+			// per-sender limits can only be applied to authenticated senders
+			if err = msg.Validate(); err != nil {
+				return newError(g.codec, msg.Body.MessageId, api.UserMessageParseError, err.Error())
+			}
+		}
+		if !g.rateLimiter.Allow(msg.Body.DonId, msg.Body.Sender) {
+			return newError(g.codec, msg.Body.MessageId, api.LimitExceededError, "rate limit exceeded")
+		}
+	}
 	// send to the handler
 	responseCh := make(chan handlers.UserCallbackPayload, 1)
 	err = handler.HandleUserMessage(ctx, msg, responseCh)
@@ -116,7 +116,7 @@ func newGatewayWithMockHandler(t *testing.T) (gateway.Gateway, *handler_mocks.Ha
 	handlers := map[string]handlers.Handler{
 		"testDON": handler,
 	}
-	gw := gateway.NewGateway(&api.JsonRPCCodec{}, httpServer, handlers, nil, logger.TestLogger(t))
+	gw := gateway.NewGateway(&api.JsonRPCCodec{}, httpServer, handlers, nil, nil, logger.TestLogger(t))
 	return gw, handler
 }
 
@@ -184,3 +184,68 @@ func TestGateway_ProcessRequest_HandlerError(t *testing.T) {
 	requireJsonRPCError(t, response, "abcd", -32000, "failure")
 	require.Equal(t, 500, statusCode)
 }
+
+func TestGateway_ProcessRequest_RateLimited(t *testing.T) {
+	t.Parallel()
+
+	httpServer := net_mocks.NewHttpServer(t)
+	httpServer.On("SetHTTPRequestHandler", mock.Anything).Return(nil)
+	handler := handler_mocks.NewHandler(t)
+	handler.On("HandleUserMessage", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		msg := args.Get(1).(*api.Message)
+		callbackCh := args.Get(2).(chan<- handlers.UserCallbackPayload)
+		callbackCh <- handlers.UserCallbackPayload{Msg: msg, ErrCode: api.NoError, ErrMsg: ""}
+	}).Once()
+	rateLimiter, err := gateway.NewUserRateLimiter(&config.GatewayConfig{
+		Dons: []config.DONConfig{
+			{DonId: "testDON", UserRateLimiterConfig: config.RateLimiterConfig{GlobalRPS: 0.001, GlobalBurst: 1}},
+		},
+	})
+	require.NoError(t, err)
+	require.False(t, rateLimiter.RequiresSender())
+	gw := gateway.NewGateway(&api.JsonRPCCodec{}, httpServer, map[string]handlers.Handler{"testDON": handler}, nil, rateLimiter, logger.TestLogger(t))
+
+	request := []byte(`{"jsonrpc":"2.0", "method": "request", "id": "abcd", "params": {"body":{"don_id": "testDON"}}}`)
+	_, statusCode := gw.ProcessRequest(testutils.Context(t), request)
+	require.Equal(t, 200, statusCode)
+
+	response, statusCode := gw.ProcessRequest(testutils.Context(t), request)
+	requireJsonRPCError(t, response, "abcd", -32005, "rate limit exceeded")
+	require.Equal(t, 429, statusCode)
+}
+
+func TestUserRateLimiter_DONRejectionKeepsGatewayTokens(t *testing.T) {
+	t.Parallel()
+
+	rateLimiter, err := gateway.NewUserRateLimiter(&config.GatewayConfig{
+		UserRateLimiterConfig: config.RateLimiterConfig{GlobalRPS: 0.001, GlobalBurst: 2},
+		Dons: []config.DONConfig{
+			{DonId: "limitedDON", UserRateLimiterConfig: config.RateLimiterConfig{GlobalRPS: 0.001, GlobalBurst: 1}},
+			{DonId: "otherDON"},
+		},
+	})
+	require.NoError(t, err)
+
+	require.True(t, rateLimiter.Allow("limitedDON", ""))
+	require.False(t, rateLimiter.Allow("limitedDON", ""))
+	require.True(t, rateLimiter.Allow("otherDON", ""))
+	require.False(t, rateLimiter.Allow("otherDON", ""))
+}
+
+func TestGateway_NewGatewayFromConfig_InvalidRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	tomlConfig := buildConfig(`
+[userRateLimiterConfig]
+GlobalRPS = 10.0
+GlobalBurst = 0
+
+[[dons]]
+DonId = "my_don"
+HandlerName = "dummy"
+`)
+
+	lggr := logger.TestLogger(t)
+	_, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, tomlConfig), gateway.NewHandlerFactory(nil, lggr), lggr)
+	require.Error(t, err)
+}
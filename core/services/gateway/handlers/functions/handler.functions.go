@@ -4,29 +4,70 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"math/big"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"go.uber.org/multierr"
 
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+const (
+	defaultRequestTimeoutMillis = 30_000
+	expiryCheckInterval         = time.Second
 )
 
 type FunctionsHandlerConfig struct {
 	OnchainAllowlistChainID string `json:"onchainAllowlistChainId"`
 	// Not specifying OnchainAllowlist config disables allowlist checks
 	OnchainAllowlist *OnchainAllowlistConfig `json:"onchainAllowlist"`
+	// MaxPendingRequests can be zero to disable the limit
+	MaxPendingRequests uint32 `json:"maxPendingRequests"`
+	// RequestTimeoutMillis defaults to 30s when not specified
+	RequestTimeoutMillis int64 `json:"requestTimeoutMillis"`
+	// ResponseQuorum is the number of matching node responses required to answer the user.
+	// Defaults to F+1 when not specified.
+	ResponseQuorum int `json:"responseQuorum"`
 }
 
 type functionsHandler struct {
-	handlerConfig *FunctionsHandlerConfig
-	donConfig     *config.DONConfig
-	don           handlers.DON
-	allowlist     OnchainAllowlist
-	lggr          logger.Logger
+	utils.StartStopOnce
+
+	handlerConfig   *FunctionsHandlerConfig
+	donConfig       *config.DONConfig
+	don             handlers.DON
+	allowlist       OnchainAllowlist
+	members         map[string]struct{}
+	quorum          int
+	requestTimeout  time.Duration
+	pendingRequests map[pendingRequestKey]*pendingRequest
+	mu              sync.Mutex
+	closeWait       sync.WaitGroup
+	stopCh          utils.StopChan
+	lggr            logger.Logger
+}
+
+// pendingRequestKey identifies a user request. Message IDs are chosen by users, so they are only unique per sender.
+type pendingRequestKey struct {
+	sender    string
+	messageId string
+}
+
+// pendingRequest holds node responses collected so far for a single user request.
+type pendingRequest struct {
+	callbackCh    chan<- handlers.UserCallbackPayload
+	responses     map[string]*api.Message
+	payloadCounts map[string]int
+	deadline      time.Time
 }
 
 var _ handlers.Handler = (*functionsHandler)(nil)
@@ -51,12 +92,36 @@ func NewFunctionsHandler(handlerConfig json.RawMessage, donConfig *config.DONCon
 			return nil, err
 		}
 	}
+	return newFunctionsHandler(cfg, donConfig, don, allowlist, lggr)
+}
+
+func newFunctionsHandler(cfg *FunctionsHandlerConfig, donConfig *config.DONConfig, don handlers.DON, allowlist OnchainAllowlist, lggr logger.Logger) (handlers.Handler, error) {
+	if cfg.ResponseQuorum < 0 {
+		return nil, errors.New("response quorum can't be negative")
+	}
+	quorum := cfg.ResponseQuorum
+	if quorum == 0 {
+		quorum = donConfig.F + 1
+	}
+	requestTimeout := time.Duration(cfg.RequestTimeoutMillis) * time.Millisecond
+	if requestTimeout <= 0 {
+		requestTimeout = defaultRequestTimeoutMillis * time.Millisecond
+	}
+	members := make(map[string]struct{})
+	for _, member := range donConfig.Members {
+		members[strings.ToLower(member.Address)] = struct{}{}
+	}
 	return &functionsHandler{
-		handlerConfig: cfg,
-		donConfig:     donConfig,
-		don:           don,
-		allowlist:     allowlist,
-		lggr:          lggr,
+		handlerConfig:   cfg,
+		donConfig:       donConfig,
+		don:             don,
+		allowlist:       allowlist,
+		members:         members,
+		quorum:          quorum,
+		requestTimeout:  requestTimeout,
+		pendingRequests: make(map[pendingRequestKey]*pendingRequest),
+		stopCh:          make(utils.StopChan),
+		lggr:            lggr.Named("FunctionsHandler"),
 	}, nil
 }
 
@@ -78,24 +143,150 @@ func (h *functionsHandler) HandleUserMessage(ctx context.Context, msg *api.Messa
 		h.lggr.Debugw("received a message from a non-allowlisted address", "sender", msg.Body.Sender)
 		return errors.New("sender not allowlisted")
 	}
-	h.lggr.Debugw("received a valid message", "sender", msg.Body.Sender)
+	if h.quorum > len(h.donConfig.Members) {
+		return fmt.Errorf("not enough DON members (%d) to reach quorum (%d)", len(h.donConfig.Members), h.quorum)
+	}
+	h.lggr.Debugw("received a valid message", "sender", msg.Body.Sender, "messageId", msg.Body.MessageId)
+
+	key := pendingRequestKey{sender: strings.ToLower(msg.Body.Sender), messageId: msg.Body.MessageId}
+	h.mu.Lock()
+	if _, ok := h.pendingRequests[key]; ok {
+		h.mu.Unlock()
+		return errors.New("request with this message ID is already pending")
+	}
+	if h.handlerConfig.MaxPendingRequests > 0 && len(h.pendingRequests) >= int(h.handlerConfig.MaxPendingRequests) {
+		h.mu.Unlock()
+		return errors.New("too many pending requests")
+	}
+	h.pendingRequests[key] = &pendingRequest{
+		callbackCh:    callbackCh,
+		responses:     make(map[string]*api.Message),
+		payloadCounts: make(map[string]int),
+		deadline:      time.Now().Add(h.requestTimeout),
+	}
+	h.mu.Unlock()
+
+	// Send to all nodes. The request fails only if no node could be reached.
+	var err error
+	nSent := 0
+	for _, member := range h.donConfig.Members {
+		if sendErr := h.don.SendToNode(ctx, member.Address, msg); sendErr != nil {
+			err = multierr.Combine(err, sendErr)
+			continue
+		}
+		nSent++
+	}
+	if nSent == 0 {
+		h.mu.Lock()
+		delete(h.pendingRequests, key)
+		h.mu.Unlock()
+		return err
+	}
+	if err != nil {
+		h.lggr.Warnw("failed to send request to some nodes", "messageId", msg.Body.MessageId, "nSent", nSent, "err", err)
+	}
 	return nil
 }
 
 func (h *functionsHandler) HandleNodeMessage(ctx context.Context, msg *api.Message, nodeAddr string) error {
+	if err := msg.Validate(); err != nil {
+		return err
+	}
+	if !strings.EqualFold(msg.Body.Sender, nodeAddr) {
+		return errors.New("node message not signed by the sending node")
+	}
+	if _, ok := h.members[strings.ToLower(nodeAddr)]; !ok {
+		return errors.New("node is not a member of the DON")
+	}
+
+	key := pendingRequestKey{sender: strings.ToLower(msg.Body.Receiver), messageId: msg.Body.MessageId}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	pending, ok := h.pendingRequests[key]
+	if !ok {
+		h.lggr.Debugw("received a response for an unknown or already completed request", "messageId", msg.Body.MessageId, "receiver", msg.Body.Receiver, "node", nodeAddr)
+		return nil
+	}
+	if _, ok := pending.responses[nodeAddr]; ok {
+		return errors.New("duplicate response from node")
+	}
+	pending.responses[nodeAddr] = msg
+	payload := string(msg.Body.Payload)
+	pending.payloadCounts[payload]++
+
+	if pending.payloadCounts[payload] >= h.quorum {
+		h.lggr.Debugw("reached response quorum", "messageId", msg.Body.MessageId, "nResponses", len(pending.responses))
+		h.completeLocked(key, handlers.UserCallbackPayload{Msg: msg, ErrCode: api.NoError})
+		return nil
+	}
+	maxCount := 0
+	for _, count := range pending.payloadCounts {
+		if count > maxCount {
+			maxCount = count
+		}
+	}
+	remaining := len(h.donConfig.Members) - len(pending.responses)
+	if maxCount+remaining < h.quorum {
+		h.lggr.Debugw("unable to reach response quorum", "messageId", msg.Body.MessageId, "nResponses", len(pending.responses))
+		h.completeLocked(key, handlers.UserCallbackPayload{ErrCode: api.InternalHandlerError, ErrMsg: "node responses don't match"})
+	}
 	return nil
 }
 
-func (h *functionsHandler) Start(ctx context.Context) (err error) {
-	if h.allowlist != nil {
-		err = h.allowlist.Start(ctx)
-	}
-	return
+// completeLocked sends the final response to the user and forgets the request.
+// Caller must hold h.mu.
+func (h *functionsHandler) completeLocked(key pendingRequestKey, payload handlers.UserCallbackPayload) {
+	pending := h.pendingRequests[key]
+	delete(h.pendingRequests, key)
+	// callbackCh is buffered and receives at most one response.
+	pending.callbackCh <- payload
+	close(pending.callbackCh)
 }
 
-func (h *functionsHandler) Close() (err error) {
-	if h.allowlist != nil {
-		err = h.allowlist.Close()
+func (h *functionsHandler) expirePendingRequests() {
+	now := time.Now()
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for key, pending := range h.pendingRequests {
+		if now.After(pending.deadline) {
+			h.lggr.Debugw("request timed out", "messageId", key.messageId, "sender", key.sender, "nResponses", len(pending.responses))
+			h.completeLocked(key, handlers.UserCallbackPayload{ErrCode: api.RequestTimeoutError, ErrMsg: "timed out waiting for node responses"})
+		}
 	}
-	return
+}
+
+func (h *functionsHandler) Start(ctx context.Context) error {
+	return h.StartOnce("FunctionsHandler", func() error {
+		if h.allowlist != nil {
+			if err := h.allowlist.Start(ctx); err != nil {
+				return err
+			}
+		}
+		h.closeWait.Add(1)
+		go func() {
+			defer h.closeWait.Done()
+			ticker := time.NewTicker(expiryCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-h.stopCh:
+					return
+				case <-ticker.C:
+					h.expirePendingRequests()
+				}
+			}
+		}()
+		return nil
+	})
+}
+
+func (h *functionsHandler) Close() error {
+	return h.StopOnce("FunctionsHandler", func() (err error) {
+		close(h.stopCh)
+		h.closeWait.Wait()
+		if h.allowlist != nil {
+			err = h.allowlist.Close()
+		}
+		return
+	})
 }
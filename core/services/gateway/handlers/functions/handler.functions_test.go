@@ -1,17 +1,78 @@
 package functions_test
 
 import (
+	"context"
+	"crypto/ecdsa"
 	"encoding/json"
+	"fmt"
+	"sync"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/functions"
 )
 
+type testDON struct {
+	sent map[string]int
+	mu   sync.Mutex
+}
+
+func (d *testDON) SendToNode(ctx context.Context, nodeAddress string, msg *api.Message) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.sent[nodeAddress]++
+	return nil
+}
+
+func newDON(t *testing.T, n int, f int) (*config.DONConfig, []*ecdsa.PrivateKey) {
+	donConfig := &config.DONConfig{F: f}
+	keys := make([]*ecdsa.PrivateKey, n)
+	for i := 0; i < n; i++ {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		keys[i] = key
+		donConfig.Members = append(donConfig.Members, config.NodeConfig{
+			Name:    fmt.Sprintf("node_%d", i),
+			Address: crypto.PubkeyToAddress(key.PublicKey).Hex(),
+		})
+	}
+	return donConfig, keys
+}
+
+func newSignedMessage(t *testing.T, id string, payload string, key *ecdsa.PrivateKey) *api.Message {
+	msg := &api.Message{
+		Body: api.MessageBody{
+			MessageId: id,
+			Method:    "secrets_list",
+			DonId:     "functions_don",
+			Payload:   json.RawMessage(payload),
+		},
+	}
+	require.NoError(t, msg.Sign(key))
+	return msg
+}
+
+func newNodeResponse(t *testing.T, id string, receiver *ecdsa.PrivateKey, payload string, key *ecdsa.PrivateKey) *api.Message {
+	msg := &api.Message{
+		Body: api.MessageBody{
+			MessageId: id,
+			Method:    "secrets_list",
+			DonId:     "functions_don",
+			Receiver:  crypto.PubkeyToAddress(receiver.PublicKey).Hex(),
+			Payload:   json.RawMessage(payload),
+		},
+	}
+	require.NoError(t, msg.Sign(key))
+	return msg
+}
+
 func TestFunctionsHandler_Basic(t *testing.T) {
 	t.Parallel()
 
@@ -22,3 +83,107 @@ func TestFunctionsHandler_Basic(t *testing.T) {
 	err = handler.HandleUserMessage(testutils.Context(t), nil, nil)
 	require.Error(t, err)
 }
+
+func TestFunctionsHandler_ReachesQuorum(t *testing.T) {
+	t.Parallel()
+
+	donConfig, nodeKeys := newDON(t, 4, 1)
+	don := &testDON{sent: make(map[string]int)}
+	handler, err := functions.NewFunctionsHandler(json.RawMessage("{}"), donConfig, don, nil, logger.TestLogger(t))
+	require.NoError(t, err)
+
+	userKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	callbackCh := make(chan handlers.UserCallbackPayload, 1)
+	require.NoError(t, handler.HandleUserMessage(testutils.Context(t), newSignedMessage(t, "1", `{}`, userKey), callbackCh))
+	for _, member := range donConfig.Members {
+		require.Equal(t, 1, don.sent[member.Address])
+	}
+
+	// response not signed by the sending node
+	require.Error(t, handler.HandleNodeMessage(testutils.Context(t), newNodeResponse(t, "1", userKey, `{"success":true}`, nodeKeys[0]), donConfig.Members[1].Address))
+
+	require.NoError(t, handler.HandleNodeMessage(testutils.Context(t), newNodeResponse(t, "1", userKey, `{"success":false}`, nodeKeys[0]), donConfig.Members[0].Address))
+	require.NoError(t, handler.HandleNodeMessage(testutils.Context(t), newNodeResponse(t, "1", userKey, `{"success":true}`, nodeKeys[1]), donConfig.Members[1].Address))
+	require.Len(t, callbackCh, 0)
+	require.NoError(t, handler.HandleNodeMessage(testutils.Context(t), newNodeResponse(t, "1", userKey, `{"success":true}`, nodeKeys[2]), donConfig.Members[2].Address))
+
+	response := <-callbackCh
+	require.Equal(t, api.NoError, response.ErrCode)
+	require.Equal(t, "1", response.Msg.Body.MessageId)
+	require.JSONEq(t, `{"success":true}`, string(response.Msg.Body.Payload))
+
+	// late responses are ignored
+	require.NoError(t, handler.HandleNodeMessage(testutils.Context(t), newNodeResponse(t, "1", userKey, `{"success":true}`, nodeKeys[3]), donConfig.Members[3].Address))
+}
+
+func TestFunctionsHandler_QuorumNotReachable(t *testing.T) {
+	t.Parallel()
+
+	donConfig, nodeKeys := newDON(t, 3, 1)
+	don := &testDON{sent: make(map[string]int)}
+	handler, err := functions.NewFunctionsHandler(json.RawMessage("{}"), donConfig, don, nil, logger.TestLogger(t))
+	require.NoError(t, err)
+
+	userKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	callbackCh := make(chan handlers.UserCallbackPayload, 1)
+	require.NoError(t, handler.HandleUserMessage(testutils.Context(t), newSignedMessage(t, "1", `{}`, userKey), callbackCh))
+
+	// duplicate message ID
+	require.Error(t, handler.HandleUserMessage(testutils.Context(t), newSignedMessage(t, "1", `{}`, userKey), make(chan handlers.UserCallbackPayload, 1)))
+
+	for i := range nodeKeys {
+		require.NoError(t, handler.HandleNodeMessage(testutils.Context(t), newNodeResponse(t, "1", userKey, fmt.Sprintf(`{"n":%d}`, i), nodeKeys[i]), donConfig.Members[i].Address))
+		if i < len(nodeKeys)-1 {
+			require.Len(t, callbackCh, 0)
+		}
+	}
+
+	response := <-callbackCh
+	require.Equal(t, api.InternalHandlerError, response.ErrCode)
+}
+
+func TestFunctionsHandler_MaxPendingRequests(t *testing.T) {
+	t.Parallel()
+
+	donConfig, _ := newDON(t, 1, 0)
+	don := &testDON{sent: make(map[string]int)}
+	handler, err := functions.NewFunctionsHandler(json.RawMessage(`{"maxPendingRequests": 1}`), donConfig, don, nil, logger.TestLogger(t))
+	require.NoError(t, err)
+
+	userKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	require.NoError(t, handler.HandleUserMessage(testutils.Context(t), newSignedMessage(t, "1", `{}`, userKey), make(chan handlers.UserCallbackPayload, 1)))
+	require.Error(t, handler.HandleUserMessage(testutils.Context(t), newSignedMessage(t, "2", `{}`, userKey), make(chan handlers.UserCallbackPayload, 1)))
+}
+
+func TestFunctionsHandler_SameMessageIdFromTwoUsers(t *testing.T) {
+	t.Parallel()
+
+	donConfig, nodeKeys := newDON(t, 1, 0)
+	don := &testDON{sent: make(map[string]int)}
+	handler, err := functions.NewFunctionsHandler(json.RawMessage("{}"), donConfig, don, nil, logger.TestLogger(t))
+	require.NoError(t, err)
+
+	userKeyA, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	userKeyB, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	callbackChA := make(chan handlers.UserCallbackPayload, 1)
+	callbackChB := make(chan handlers.UserCallbackPayload, 1)
+	require.NoError(t, handler.HandleUserMessage(testutils.Context(t), newSignedMessage(t, "1", `{}`, userKeyA), callbackChA))
+	require.NoError(t, handler.HandleUserMessage(testutils.Context(t), newSignedMessage(t, "1", `{}`, userKeyB), callbackChB))
+
+	// a response is only delivered to the user it is addressed to
+	require.NoError(t, handler.HandleNodeMessage(testutils.Context(t), newNodeResponse(t, "1", userKeyB, `{"user":"B"}`, nodeKeys[0]), donConfig.Members[0].Address))
+	require.Len(t, callbackChA, 0)
+	response := <-callbackChB
+	require.Equal(t, api.NoError, response.ErrCode)
+	require.JSONEq(t, `{"user":"B"}`, string(response.Msg.Body.Payload))
+
+	require.NoError(t, handler.HandleNodeMessage(testutils.Context(t), newNodeResponse(t, "1", userKeyA, `{"user":"A"}`, nodeKeys[0]), donConfig.Members[0].Address))
+	response = <-callbackChA
+	require.Equal(t, api.NoError, response.ErrCode)
+	require.JSONEq(t, `{"user":"A"}`, string(response.Msg.Body.Payload))
+}
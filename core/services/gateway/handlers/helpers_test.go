@@ -0,0 +1,8 @@
+package handlers
+
+// NumUsers returns the number of users the rate limiter keeps a per-user limiter for.
+func (rl *RateLimiter) NumUsers() int {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	return len(rl.perUser)
+}
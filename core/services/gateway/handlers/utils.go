@@ -1,40 +1,134 @@
 package handlers
 
 import (
+	"errors"
 	"sync"
+	"time"
 
 	"golang.org/x/time/rate"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
 )
 
 type RateLimiter struct {
 	global       *rate.Limiter
-	perUser      map[string]*rate.Limiter
+	perUser      map[string]*userLimiter
 	perUserRPS   rate.Limit
 	perUserBurst int
-	mu           sync.Mutex
+	// perUserIdleTTL is how long a user's bucket takes to refill completely. Users idle for longer are
+	// forgotten, since a new bucket for them would be full as well.
+	perUserIdleTTL time.Duration
+	lastSweep      time.Time
+	mu             sync.Mutex
+}
+
+type userLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
 }
 
 func NewRateLimiter(globalRPS float64, globalBurst int, perUserRPS float64, perUserBurst int) *RateLimiter {
+	return newRateLimiter(rate.Limit(globalRPS), globalBurst, rate.Limit(perUserRPS), perUserBurst)
+}
+
+// NewRateLimiterFromConfig creates a RateLimiter where zero RPS values disable the corresponding limit.
+func NewRateLimiterFromConfig(cfg config.RateLimiterConfig) (*RateLimiter, error) {
+	globalRPS, err := limitFromConfig(cfg.GlobalRPS, cfg.GlobalBurst)
+	if err != nil {
+		return nil, err
+	}
+	perSenderRPS, err := limitFromConfig(cfg.PerSenderRPS, cfg.PerSenderBurst)
+	if err != nil {
+		return nil, err
+	}
+	return newRateLimiter(globalRPS, cfg.GlobalBurst, perSenderRPS, cfg.PerSenderBurst), nil
+}
+
+func newRateLimiter(globalRPS rate.Limit, globalBurst int, perUserRPS rate.Limit, perUserBurst int) *RateLimiter {
+	var idleTTL time.Duration
+	if perUserRPS > 0 && perUserRPS != rate.Inf {
+		idleTTL = time.Duration(float64(perUserBurst) / float64(perUserRPS) * float64(time.Second))
+	}
 	return &RateLimiter{
-		global:       rate.NewLimiter(rate.Limit(globalRPS), globalBurst),
-		perUser:      make(map[string]*rate.Limiter),
-		perUserRPS:   rate.Limit(perUserRPS),
-		perUserBurst: perUserBurst,
+		global:         rate.NewLimiter(globalRPS, globalBurst),
+		perUser:        make(map[string]*userLimiter),
+		perUserRPS:     perUserRPS,
+		perUserBurst:   perUserBurst,
+		perUserIdleTTL: idleTTL,
+		lastSweep:      time.Now(),
 	}
 }
 
+func limitFromConfig(rps float64, burst int) (rate.Limit, error) {
+	if rps < 0 || burst < 0 {
+		return 0, errors.New("rate limiter RPS and burst can't be negative")
+	}
+	if rps == 0 {
+		return rate.Inf, nil
+	}
+	if burst == 0 {
+		return 0, errors.New("rate limiter burst must be positive when RPS is set")
+	}
+	return rate.Limit(rps), nil
+}
+
+// PerUserEnabled returns true if requests are limited per user.
+func (rl *RateLimiter) PerUserEnabled() bool {
+	return rl.perUserRPS != rate.Inf
+}
+
+// Allow returns true if both the global and the per-user limits admit the request.
+// Tokens are only taken when the request is admitted.
 func (rl *RateLimiter) Allow(user string) bool {
-	if !rl.global.Allow() {
-		return false
+	_, ok := rl.Reserve(user)
+	return ok
+}
+
+// Reserve is like Allow but also returns a function that gives the taken tokens back,
+// for callers that combine several limiters and reject a request admitted by this one.
+func (rl *RateLimiter) Reserve(user string) (cancel func(), ok bool) {
+	now := time.Now()
+	global := rl.global.ReserveN(now, 1)
+	if !global.OK() || global.DelayFrom(now) > 0 {
+		global.CancelAt(now)
+		return nil, false
+	}
+	if !rl.PerUserEnabled() {
+		return func() { global.CancelAt(now) }, true
 	}
 
 	rl.mu.Lock()
-	userLimiter, ok := rl.perUser[user]
+	rl.sweepIdleUsersLocked(now)
+	ul, ok := rl.perUser[user]
 	if !ok {
-		userLimiter = rate.NewLimiter(rl.perUserRPS, rl.perUserBurst)
-		rl.perUser[user] = userLimiter
+		ul = &userLimiter{limiter: rate.NewLimiter(rl.perUserRPS, rl.perUserBurst)}
+		rl.perUser[user] = ul
 	}
+	ul.lastSeen = now
 	rl.mu.Unlock()
 
-	return userLimiter.Allow()
+	perUser := ul.limiter.ReserveN(now, 1)
+	if !perUser.OK() || perUser.DelayFrom(now) > 0 {
+		perUser.CancelAt(now)
+		global.CancelAt(now)
+		return nil, false
+	}
+	return func() {
+		perUser.CancelAt(now)
+		global.CancelAt(now)
+	}, true
+}
+
+// sweepIdleUsersLocked forgets the users idle for longer than perUserIdleTTL, at most once per perUserIdleTTL, so
+// that the per-user limiters don't grow with every sender ever seen. Caller must hold rl.mu.
+func (rl *RateLimiter) sweepIdleUsersLocked(now time.Time) {
+	if rl.perUserIdleTTL <= 0 || now.Sub(rl.lastSweep) < rl.perUserIdleTTL {
+		return
+	}
+	for user, ul := range rl.perUser {
+		if now.Sub(ul.lastSeen) >= rl.perUserIdleTTL {
+			delete(rl.perUser, user)
+		}
+	}
+	rl.lastSweep = now
 }
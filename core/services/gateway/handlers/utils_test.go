@@ -2,9 +2,11 @@ package handlers_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
 )
 
@@ -18,3 +20,64 @@ func TestRateLimiter_Simple(t *testing.T) {
 	require.False(t, rl.Allow("user1"))
 	require.False(t, rl.Allow("user3"))
 }
+
+func TestRateLimiter_RejectedRequestKeepsTokens(t *testing.T) {
+	t.Parallel()
+
+	rl := handlers.NewRateLimiter(0.001, 2, 0.001, 1)
+	require.True(t, rl.Allow("user1"))
+	// rejected by the per-user limit, must not use up the last global token
+	require.False(t, rl.Allow("user1"))
+	require.True(t, rl.Allow("user2"))
+	require.False(t, rl.Allow("user3"))
+
+	rl = handlers.NewRateLimiter(0.001, 1, 0.001, 1)
+	cancel, ok := rl.Reserve("user1")
+	require.True(t, ok)
+	cancel()
+	require.True(t, rl.Allow("user1"))
+}
+
+func TestRateLimiter_ForgetsIdleUsers(t *testing.T) {
+	t.Parallel()
+
+	// per-user buckets refill completely in 10ms
+	rl := handlers.NewRateLimiter(1000.0, 1000, 100.0, 1)
+	require.True(t, rl.Allow("user1"))
+	require.True(t, rl.Allow("user2"))
+	require.False(t, rl.Allow("user1"))
+	require.Equal(t, 2, rl.NumUsers())
+
+	time.Sleep(20 * time.Millisecond)
+	require.True(t, rl.Allow("user3"))
+	require.Equal(t, 1, rl.NumUsers())
+	require.True(t, rl.Allow("user1"))
+	require.False(t, rl.Allow("user1"))
+}
+
+func TestRateLimiter_FromConfig(t *testing.T) {
+	t.Parallel()
+
+	// no limits
+	rl, err := handlers.NewRateLimiterFromConfig(config.RateLimiterConfig{})
+	require.NoError(t, err)
+	require.False(t, rl.PerUserEnabled())
+	for i := 0; i < 10; i++ {
+		require.True(t, rl.Allow("user1"))
+	}
+
+	// per-sender limits only
+	rl, err = handlers.NewRateLimiterFromConfig(config.RateLimiterConfig{PerSenderRPS: 1.0, PerSenderBurst: 2})
+	require.NoError(t, err)
+	require.True(t, rl.PerUserEnabled())
+	require.True(t, rl.Allow("user1"))
+	require.True(t, rl.Allow("user1"))
+	require.False(t, rl.Allow("user1"))
+	require.True(t, rl.Allow("user2"))
+
+	// invalid configs
+	_, err = handlers.NewRateLimiterFromConfig(config.RateLimiterConfig{GlobalRPS: 1.0})
+	require.Error(t, err)
+	_, err = handlers.NewRateLimiterFromConfig(config.RateLimiterConfig{PerSenderRPS: -1.0, PerSenderBurst: 1})
+	require.Error(t, err)
+}
@@ -0,0 +1,61 @@
+package gateway
+
+import (
+	"fmt"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
+)
+
+// UserRateLimiter applies gateway-wide and per-DON token-bucket limits to user requests.
+// All methods are thread-safe.
+type UserRateLimiter struct {
+	gatewayLimiter *handlers.RateLimiter
+	donLimiters    map[string]*handlers.RateLimiter
+	perSender      bool
+}
+
+func NewUserRateLimiter(gwConfig *config.GatewayConfig) (*UserRateLimiter, error) {
+	gatewayLimiter, err := handlers.NewRateLimiterFromConfig(gwConfig.UserRateLimiterConfig)
+	if err != nil {
+		return nil, fmt.Errorf("invalid gateway rate limiter config: %w", err)
+	}
+	limiter := &UserRateLimiter{
+		gatewayLimiter: gatewayLimiter,
+		donLimiters:    make(map[string]*handlers.RateLimiter),
+		perSender:      gatewayLimiter.PerUserEnabled(),
+	}
+	for _, donConfig := range gwConfig.Dons {
+		donLimiter, err := handlers.NewRateLimiterFromConfig(donConfig.UserRateLimiterConfig)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rate limiter config for DON %s: %w", donConfig.DonId, err)
+		}
+		limiter.donLimiters[donConfig.DonId] = donLimiter
+		limiter.perSender = limiter.perSender || donLimiter.PerUserEnabled()
+	}
+	return limiter, nil
+}
+
+// RequiresSender returns true if any per-sender limit is configured,
+// in which case the sender needs to be authenticated before calling Allow().
+func (l *UserRateLimiter) RequiresSender() bool {
+	return l.perSender
+}
+
+// Allow returns true if the gateway-wide and DON limits both admit the request.
+// A request rejected by the DON limiter does not use up gateway-wide tokens.
+func (l *UserRateLimiter) Allow(donId string, sender string) bool {
+	cancel, ok := l.gatewayLimiter.Reserve(sender)
+	if !ok {
+		return false
+	}
+	donLimiter, ok := l.donLimiters[donId]
+	if !ok {
+		return true
+	}
+	if !donLimiter.Allow(sender) {
+		cancel()
+		return false
+	}
+	return true
+}
@@ -241,6 +241,15 @@ func TestORM(t *testing.T) {
 		jb, err := blockhashstore.ValidatedSpec(
 			testspecs.GenerateBlockhashStoreSpec(testspecs.BlockhashStoreSpecParams{}).Toml())
 		require.NoError(t, err)
+		batchBHSAddress := cltest.NewEIP55Address()
+		jb.BlockhashStoreSpec.Coordinators = job.BlockhashStoreCoordinators{
+			{Address: cltest.NewEIP55Address(), Version: "v2"},
+		}
+		jb.BlockhashStoreSpec.BatchBlockhashStoreAddress = &batchBHSAddress
+		jb.BlockhashStoreSpec.StoreBlockhashesBatchSize = 20
+		jb.BlockhashStoreSpec.TrustedBlockhashStores = job.BlockhashStoreTargets{
+			{EVMChainID: utils.NewBigI(137), Address: cltest.NewEIP55Address(), FromAddresses: []ethkey.EIP55Address{cltest.NewEIP55Address()}},
+		}
 
 		err = orm.CreateJob(&jb)
 		require.NoError(t, err)
@@ -251,9 +260,13 @@ func TestORM(t *testing.T) {
 		require.Equal(t, jb.BlockhashStoreSpec.ID, savedJob.BlockhashStoreSpec.ID)
 		require.Equal(t, jb.BlockhashStoreSpec.CoordinatorV1Address, savedJob.BlockhashStoreSpec.CoordinatorV1Address)
 		require.Equal(t, jb.BlockhashStoreSpec.CoordinatorV2Address, savedJob.BlockhashStoreSpec.CoordinatorV2Address)
+		require.Equal(t, jb.BlockhashStoreSpec.Coordinators, savedJob.BlockhashStoreSpec.Coordinators)
 		require.Equal(t, jb.BlockhashStoreSpec.WaitBlocks, savedJob.BlockhashStoreSpec.WaitBlocks)
 		require.Equal(t, jb.BlockhashStoreSpec.LookbackBlocks, savedJob.BlockhashStoreSpec.LookbackBlocks)
 		require.Equal(t, jb.BlockhashStoreSpec.BlockhashStoreAddress, savedJob.BlockhashStoreSpec.BlockhashStoreAddress)
+		require.Equal(t, jb.BlockhashStoreSpec.BatchBlockhashStoreAddress, savedJob.BlockhashStoreSpec.BatchBlockhashStoreAddress)
+		require.Equal(t, jb.BlockhashStoreSpec.StoreBlockhashesBatchSize, savedJob.BlockhashStoreSpec.StoreBlockhashesBatchSize)
+		require.Equal(t, jb.BlockhashStoreSpec.TrustedBlockhashStores, savedJob.BlockhashStoreSpec.TrustedBlockhashStores)
 		require.Equal(t, jb.BlockhashStoreSpec.PollPeriod, savedJob.BlockhashStoreSpec.PollPeriod)
 		require.Equal(t, jb.BlockhashStoreSpec.RunTimeout, savedJob.BlockhashStoreSpec.RunTimeout)
 		require.Equal(t, jb.BlockhashStoreSpec.EVMChainID, savedJob.BlockhashStoreSpec.EVMChainID)
@@ -413,12 +426,14 @@ func TestORM_CreateJob_VRFV2(t *testing.T) {
 	jb, err := vrf.ValidatedVRFSpec(testspecs.GenerateVRFSpec(
 		testspecs.VRFSpecParams{
 			RequestedConfsDelay: 10,
+			V2:                  true,
 			FromAddresses:       fromAddresses,
 			ChunkSize:           25,
 			BackoffInitialDelay: time.Minute,
 			BackoffMaxDelay:     time.Hour,
 			GasLanePrice:        assets.GWei(100),
 			VRFOwnerAddress:     "0x32891BD79647DC9136Fc0a59AAB48c7825eb624c",
+			LogPollerEnabled:    true,
 		}).
 		Toml())
 	require.NoError(t, err)
@@ -444,6 +459,9 @@ func TestORM_CreateJob_VRFV2(t *testing.T) {
 	var backoffMaxDelay time.Duration
 	require.NoError(t, db.Get(&backoffMaxDelay, `SELECT backoff_max_delay FROM vrf_specs LIMIT 1`))
 	require.Equal(t, time.Hour, backoffMaxDelay)
+	var logPollerEnabled bool
+	require.NoError(t, db.Get(&logPollerEnabled, `SELECT log_poller_enabled FROM vrf_specs LIMIT 1`))
+	require.True(t, logPollerEnabled)
 	var chunkSize int
 	require.NoError(t, db.Get(&chunkSize, `SELECT chunk_size FROM vrf_specs LIMIT 1`))
 	require.Equal(t, 25, chunkSize)
@@ -155,6 +155,7 @@ type Job struct {
 	Name                          null.String
 	MaxTaskDuration               models.Interval
 	Pipeline                      pipeline.Pipeline `toml:"observationSource"`
+	Paused                        bool              `toml:"-"`
 	CreatedAt                     time.Time
 }
 
@@ -420,11 +421,32 @@ type DirectRequestSpec struct {
 	UpdatedAt                   time.Time                `toml:"-"`
 }
 
+// CronOverlapPolicy determines what happens when a cron job run is due while
+// the previous run is still in progress.
+type CronOverlapPolicy string
+
+const (
+	// CronOverlapAllow starts the run regardless, this is the default.
+	CronOverlapAllow CronOverlapPolicy = "allow"
+	// CronOverlapSkip skips the run.
+	CronOverlapSkip CronOverlapPolicy = "skip"
+	// CronOverlapQueueOne starts the run once the previous one is done. At
+	// most one run is queued, further runs are skipped.
+	CronOverlapQueueOne CronOverlapPolicy = "queue-one"
+)
+
 type CronSpec struct {
-	ID           int32     `toml:"-"`
-	CronSchedule string    `toml:"schedule"`
-	CreatedAt    time.Time `toml:"-"`
-	UpdatedAt    time.Time `toml:"-"`
+	ID           int32  `toml:"-"`
+	CronSchedule string `toml:"schedule"`
+	// Timezone is the IANA time zone the schedule is evaluated in, as an
+	// alternative to a CRON_TZ prefix in the schedule.
+	Timezone      string            `toml:"timezone"`
+	OverlapPolicy CronOverlapPolicy `toml:"overlapPolicy"`
+	// CatchUpWindow is how far back runs that were missed, e.g. while the
+	// node was down, are executed on start. Zero disables catching up.
+	CatchUpWindow time.Duration `toml:"catchUpWindow"`
+	CreatedAt     time.Time     `toml:"-"`
+	UpdatedAt     time.Time     `toml:"-"`
 }
 
 func (s CronSpec) GetID() string {
@@ -447,7 +469,10 @@ type FluxMonitorSpec struct {
 	// AbsoluteThreshold is the maximum absolute change allowed in a fluxmonitored
 	// value before a new round should be kicked off, so that the current value
 	// can be reported on-chain.
-	AbsoluteThreshold   tomlutils.Float32 `toml:"absoluteThreshold,float"`
+	AbsoluteThreshold tomlutils.Float32 `toml:"absoluteThreshold,float"`
+	// DeviationTiers replace Threshold and AbsoluteThreshold within recurring windows of time,
+	// e.g. with tighter thresholds during the trading hours of a market.
+	DeviationTiers      FluxMonitorDeviationTiers `toml:"deviationTiers"`
 	PollTimerPeriod     time.Duration
 	PollTimerDisabled   bool
 	IdleTimerPeriod     time.Duration
@@ -455,12 +480,50 @@ type FluxMonitorSpec struct {
 	DrumbeatSchedule    string
 	DrumbeatRandomDelay time.Duration
 	DrumbeatEnabled     bool
+	HeartbeatSchedule   string
 	MinPayment          *assets.Link
 	EVMChainID          *utils.Big `toml:"evmChainID"`
 	CreatedAt           time.Time  `toml:"-"`
 	UpdatedAt           time.Time  `toml:"-"`
 }
 
+// FluxMonitorDeviationTier defines deviation thresholds which apply, instead of those of the
+// FluxMonitorSpec, within a window of time recurring on given days of the week.
+type FluxMonitorDeviationTier struct {
+	Threshold         tomlutils.Float32 `toml:"threshold,float" json:"threshold"`
+	AbsoluteThreshold tomlutils.Float32 `toml:"absoluteThreshold,float" json:"absoluteThreshold"`
+	// Days are the days of the week the window starts on, e.g. ["Mon", "Tue"]. The window recurs
+	// every day if empty.
+	Days []string `toml:"days" json:"days"`
+	// From and To are the times of day the window starts and ends at, e.g. "09:30" and "16:00".
+	// They default to the start and the end of the day. A window ending before it starts spans
+	// midnight.
+	From string `toml:"from" json:"from"`
+	To   string `toml:"to" json:"to"`
+	// Timezone is the IANA time zone of the window, e.g. "America/New_York". Defaults to UTC.
+	Timezone string `toml:"timezone" json:"timezone"`
+}
+
+// FluxMonitorDeviationTiers is a list of deviation tiers, stored as JSON in the database.
+type FluxMonitorDeviationTiers []FluxMonitorDeviationTier
+
+// Value returns this instance serialized for database storage.
+func (t FluxMonitorDeviationTiers) Value() (driver.Value, error) {
+	if t == nil {
+		t = FluxMonitorDeviationTiers{}
+	}
+	return json.Marshal(t)
+}
+
+// Scan reads the database value and returns an instance.
+func (t *FluxMonitorDeviationTiers) Scan(value interface{}) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.Errorf("expected bytes got %T", value)
+	}
+	return json.Unmarshal(b, t)
+}
+
 type KeeperSpec struct {
 	ID                       int32               `toml:"-"`
 	ContractAddress          ethkey.EIP55Address `toml:"contractAddress"`
@@ -520,6 +583,10 @@ type VRFSpec struct {
 	// only.
 	BackoffMaxDelay time.Duration `toml:"backoffMaxDelay"`
 
+	// LogPollerEnabled indicates to the vrf job to get its request logs from the log poller instead
+	// of the log broadcaster. The log poller must be enabled on the chain. V2 only.
+	LogPollerEnabled bool `toml:"logPollerEnabled"`
+
 	CreatedAt time.Time `toml:"-"`
 	UpdatedAt time.Time `toml:"-"`
 }
@@ -536,6 +603,10 @@ type BlockhashStoreSpec struct {
 	// no V2 coordinator will be watched.
 	CoordinatorV2Address *ethkey.EIP55Address `toml:"coordinatorV2Address"`
 
+	// Coordinators are the VRF coordinators to watch for unfulfilled requests, in addition to
+	// CoordinatorV1Address and CoordinatorV2Address.
+	Coordinators BlockhashStoreCoordinators `toml:"coordinators"`
+
 	// LookbackBlocks defines the maximum age of blocks whose hashes should be stored.
 	LookbackBlocks int32 `toml:"lookbackBlocks"`
 
@@ -546,6 +617,20 @@ type BlockhashStoreSpec struct {
 	// into.
 	BlockhashStoreAddress ethkey.EIP55Address `toml:"blockhashStoreAddress"`
 
+	// BatchBlockhashStoreAddress is the address of the BatchBlockhashStore contract to store
+	// blockhashes through, in batches of StoreBlockhashesBatchSize. If empty, blockhashes are
+	// stored one at a time into the BlockhashStore contract.
+	BatchBlockhashStoreAddress *ethkey.EIP55Address `toml:"batchBlockhashStoreAddress"`
+
+	// StoreBlockhashesBatchSize is the maximum number of blockhashes stored in a single
+	// transaction through the BatchBlockhashStore contract.
+	StoreBlockhashesBatchSize uint16 `toml:"storeBlockhashesBatchSize"`
+
+	// TrustedBlockhashStores are the TrustedBlockhashStore contracts, on this or other chains, to
+	// also store the blockhashes of this chain into. The blockhashes are read from this chain and
+	// stored with storeTrusted, in batches of StoreBlockhashesBatchSize.
+	TrustedBlockhashStores BlockhashStoreTargets `toml:"trustedBlockhashStores"`
+
 	// PollPeriod defines how often recent blocks should be scanned for blockhash storage.
 	PollPeriod time.Duration `toml:"pollPeriod"`
 
@@ -565,6 +650,70 @@ type BlockhashStoreSpec struct {
 	UpdatedAt time.Time `toml:"-"`
 }
 
+// BlockhashStoreCoordinator is a VRF coordinator watched by a blockhash store feeder job.
+type BlockhashStoreCoordinator struct {
+	// Address is the address of the coordinator contract.
+	Address ethkey.EIP55Address `toml:"address" json:"address"`
+
+	// Version is the version of the coordinator contract, e.g. "v1" or "v2". It decides how the
+	// request and fulfillment logs of the coordinator are read.
+	Version string `toml:"version" json:"version"`
+}
+
+// BlockhashStoreCoordinators is a list of coordinators, stored as JSON in the database.
+type BlockhashStoreCoordinators []BlockhashStoreCoordinator
+
+// Value returns this instance serialized for database storage.
+func (c BlockhashStoreCoordinators) Value() (driver.Value, error) {
+	if c == nil {
+		c = BlockhashStoreCoordinators{}
+	}
+	return json.Marshal(c)
+}
+
+// Scan reads the database value and returns an instance.
+func (c *BlockhashStoreCoordinators) Scan(value interface{}) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.Errorf("expected bytes got %T", value)
+	}
+	return json.Unmarshal(b, c)
+}
+
+// BlockhashStoreTarget is a TrustedBlockhashStore contract a blockhash store feeder job stores
+// blockhashes into.
+type BlockhashStoreTarget struct {
+	// EVMChainID is the chain the contract is deployed on. Defaults to the chain of the job.
+	EVMChainID *utils.Big `toml:"evmChainID" json:"evmChainID"`
+
+	// Address is the address of the contract.
+	Address ethkey.EIP55Address `toml:"address" json:"address"`
+
+	// FromAddresses are the addresses to send the storeTrusted transactions from, which must be
+	// allowed to call storeTrusted. Defaults to the first enabled key of the chain.
+	FromAddresses []ethkey.EIP55Address `toml:"fromAddresses" json:"fromAddresses"`
+}
+
+// BlockhashStoreTargets is a list of trusted blockhash stores, stored as JSON in the database.
+type BlockhashStoreTargets []BlockhashStoreTarget
+
+// Value returns this instance serialized for database storage.
+func (t BlockhashStoreTargets) Value() (driver.Value, error) {
+	if t == nil {
+		t = BlockhashStoreTargets{}
+	}
+	return json.Marshal(t)
+}
+
+// Scan reads the database value and returns an instance.
+func (t *BlockhashStoreTargets) Scan(value interface{}) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.Errorf("expected bytes got %T", value)
+	}
+	return json.Unmarshal(b, t)
+}
+
 // BlockHeaderFeederSpec defines the job spec for the blockhash store feeder.
 type BlockHeaderFeederSpec struct {
 	ID int32
@@ -53,6 +53,8 @@ type ORM interface {
 	FindOCR2JobIDByAddress(contractID string, feedID common.Hash, qopts ...pg.QOpt) (int32, error)
 	FindJobIDsWithBridge(name string) ([]int32, error)
 	DeleteJob(id int32, qopts ...pg.QOpt) error
+	// SetJobPaused persists whether the job with the given ID is paused.
+	SetJobPaused(id int32, paused bool, qopts ...pg.QOpt) error
 	RecordError(jobID int32, description string, qopts ...pg.QOpt) error
 	// TryRecordError is a helper which calls RecordError and logs the returned error if present.
 	TryRecordError(jobID int32, description string, qopts ...pg.QOpt)
@@ -171,10 +173,10 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 			jb.DirectRequestSpecID = &specID
 		case FluxMonitor:
 			var specID int32
-			sql := `INSERT INTO flux_monitor_specs (contract_address, threshold, absolute_threshold, poll_timer_period, poll_timer_disabled, idle_timer_period, idle_timer_disabled,
-					drumbeat_schedule, drumbeat_random_delay, drumbeat_enabled, min_payment, evm_chain_id, created_at, updated_at)
-			VALUES (:contract_address, :threshold, :absolute_threshold, :poll_timer_period, :poll_timer_disabled, :idle_timer_period, :idle_timer_disabled,
-					:drumbeat_schedule, :drumbeat_random_delay, :drumbeat_enabled, :min_payment, :evm_chain_id, NOW(), NOW())
+			sql := `INSERT INTO flux_monitor_specs (contract_address, threshold, absolute_threshold, deviation_tiers, poll_timer_period, poll_timer_disabled, idle_timer_period, idle_timer_disabled,
+					drumbeat_schedule, drumbeat_random_delay, drumbeat_enabled, heartbeat_schedule, min_payment, evm_chain_id, created_at, updated_at)
+			VALUES (:contract_address, :threshold, :absolute_threshold, :deviation_tiers, :poll_timer_period, :poll_timer_disabled, :idle_timer_period, :idle_timer_disabled,
+					:drumbeat_schedule, :drumbeat_random_delay, :drumbeat_enabled, :heartbeat_schedule, :min_payment, :evm_chain_id, NOW(), NOW())
 			RETURNING id;`
 			if err := pg.PrepareQueryRowx(tx, sql, &specID, jb.FluxMonitorSpec); err != nil {
 				return errors.Wrap(err, "failed to create FluxMonitorSpec")
@@ -321,8 +323,8 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 			jb.KeeperSpecID = &specID
 		case Cron:
 			var specID int32
-			sql := `INSERT INTO cron_specs (cron_schedule, created_at, updated_at)
-			VALUES (:cron_schedule, NOW(), NOW())
+			sql := `INSERT INTO cron_specs (cron_schedule, timezone, overlap_policy, catch_up_window, created_at, updated_at)
+			VALUES (:cron_schedule, :timezone, :overlap_policy, :catch_up_window, NOW(), NOW())
 			RETURNING id;`
 			if err := pg.PrepareQueryRowx(tx, sql, &specID, jb.CronSpec); err != nil {
 				return errors.Wrap(err, "failed to create CronSpec")
@@ -335,14 +337,14 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 				evm_chain_id, from_addresses, poll_period, requested_confs_delay,
 				request_timeout, chunk_size, batch_coordinator_address, batch_fulfillment_enabled,
 				batch_fulfillment_gas_multiplier, backoff_initial_delay, backoff_max_delay, gas_lane_price,
-                vrf_owner_address,
+                vrf_owner_address, log_poller_enabled,
 				created_at, updated_at)
 			VALUES (
 				:coordinator_address, :public_key, :min_incoming_confirmations,
 				:evm_chain_id, :from_addresses, :poll_period, :requested_confs_delay,
 				:request_timeout, :chunk_size, :batch_coordinator_address, :batch_fulfillment_enabled,
 				:batch_fulfillment_gas_multiplier, :backoff_initial_delay, :backoff_max_delay, :gas_lane_price,
-			    :vrf_owner_address,
+			    :vrf_owner_address, :log_poller_enabled,
 				NOW(), NOW())
 			RETURNING id;`
 
@@ -381,8 +383,8 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 			}
 		case BlockhashStore:
 			var specID int32
-			sql := `INSERT INTO blockhash_store_specs (coordinator_v1_address, coordinator_v2_address, wait_blocks, lookback_blocks, blockhash_store_address, poll_period, run_timeout, evm_chain_id, from_addresses, created_at, updated_at)
-			VALUES (:coordinator_v1_address, :coordinator_v2_address, :wait_blocks, :lookback_blocks, :blockhash_store_address, :poll_period, :run_timeout, :evm_chain_id, :from_addresses, NOW(), NOW())
+			sql := `INSERT INTO blockhash_store_specs (coordinator_v1_address, coordinator_v2_address, coordinators, wait_blocks, lookback_blocks, blockhash_store_address, batch_blockhash_store_address, store_blockhashes_batch_size, trusted_blockhash_stores, poll_period, run_timeout, evm_chain_id, from_addresses, created_at, updated_at)
+			VALUES (:coordinator_v1_address, :coordinator_v2_address, :coordinators, :wait_blocks, :lookback_blocks, :blockhash_store_address, :batch_blockhash_store_address, :store_blockhashes_batch_size, :trusted_blockhash_stores, :poll_period, :run_timeout, :evm_chain_id, :from_addresses, NOW(), NOW())
 			RETURNING id;`
 			if err := pg.PrepareQueryRowx(tx, sql, &specID, toBlockhashStoreSpecRow(jb.BlockhashStoreSpec)); err != nil {
 				return errors.Wrap(err, "failed to create BlockhashStore spec")
@@ -570,6 +572,24 @@ func (o *orm) DeleteJob(id int32, qopts ...pg.QOpt) error {
 	return nil
 }
 
+// SetJobPaused updates the paused flag of a job
+func (o *orm) SetJobPaused(id int32, paused bool, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	res, cancel, err := q.ExecQIter(`UPDATE jobs SET paused = $1 WHERE id = $2`, paused, id)
+	defer cancel()
+	if err != nil {
+		return errors.Wrap(err, "SetJobPaused failed to update job")
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "SetJobPaused failed getting RowsAffected")
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
 func (o *orm) RecordError(jobID int32, description string, qopts ...pg.QOpt) error {
 	q := o.q.WithOpts(qopts...)
 	sql := `INSERT INTO job_spec_errors (job_id, description, occurrences, created_at, updated_at)
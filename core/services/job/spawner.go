@@ -18,6 +18,13 @@ import (
 
 //go:generate mockery --quiet --name Spawner --output ./mocks/ --case=underscore
 
+var (
+	// ErrJobAlreadyPaused is returned by PauseJob for a job that is already paused.
+	ErrJobAlreadyPaused = pkgerrors.New("job is already paused")
+	// ErrJobNotPaused is returned by ResumeJob for a job that is not paused.
+	ErrJobNotPaused = pkgerrors.New("job is not paused")
+)
+
 type (
 	// Spawner manages the spinning up and down of the long-running
 	// services that perform the work described by job specs.  Each active job spec
@@ -30,6 +37,10 @@ type (
 		CreateJob(jb *Job, qopts ...pg.QOpt) (err error)
 		// DeleteJob deletes a job and stops any active services.
 		DeleteJob(jobID int32, qopts ...pg.QOpt) error
+		// PauseJob marks a job as paused and stops any active services, without deleting the job.
+		PauseJob(jobID int32, qopts ...pg.QOpt) error
+		// ResumeJob clears the paused mark of a job and starts its services.
+		ResumeJob(jobID int32, qopts ...pg.QOpt) error
 		// ActiveJobs returns a map of jobs with active services (started without error).
 		ActiveJobs() map[int32]Job
 
@@ -134,6 +145,10 @@ func (js *spawner) startAllServices(ctx context.Context) {
 	}
 
 	for _, spec := range specs {
+		if spec.Paused {
+			js.lggr.Infow("Skipping paused job", "jobID", spec.ID, "name", spec.Name.ValueOrZero())
+			continue
+		}
 		if err = js.StartService(ctx, spec); err != nil {
 			js.lggr.Errorf("Couldn't start service %q: %v", spec.Name.ValueOrZero(), err)
 		}
@@ -335,6 +350,78 @@ func (js *spawner) DeleteJob(jobID int32, qopts ...pg.QOpt) error {
 	return err
 }
 
+// Should not get called before Start()
+func (js *spawner) PauseJob(jobID int32, qopts ...pg.QOpt) error {
+	lggr := js.lggr.With("jobID", jobID)
+
+	q := js.q.WithOpts(qopts...)
+	pctx, cancel := js.chStop.Ctx(q.ParentCtx)
+	defer cancel()
+	q.ParentCtx = pctx
+	ctx, cancel := q.Context()
+	defer cancel()
+
+	jb, err := js.orm.FindJob(ctx, jobID)
+	if err != nil {
+		return pkgerrors.Wrapf(err, "job %d not found", jobID)
+	}
+	if jb.Paused {
+		return pkgerrors.Wrapf(ErrJobAlreadyPaused, "cannot pause job %d", jobID)
+	}
+	if err = js.orm.SetJobPaused(jobID, true, pg.WithQueryer(q.Queryer), pg.WithParentCtx(ctx)); err != nil {
+		lggr.Errorw("Error pausing job", "err", err)
+		return err
+	}
+
+	js.activeJobsMu.RLock()
+	_, exists := js.activeJobs[jobID]
+	js.activeJobsMu.RUnlock()
+	if exists {
+		// Unlike DeleteJob, the delegate is not notified: the job stays in the database and may be resumed later.
+		js.stopService(jobID)
+	}
+	lggr.Infow("Paused job")
+
+	return nil
+}
+
+// Should not get called before Start()
+func (js *spawner) ResumeJob(jobID int32, qopts ...pg.QOpt) error {
+	lggr := js.lggr.With("jobID", jobID)
+
+	q := js.q.WithOpts(qopts...)
+	pctx, cancel := js.chStop.Ctx(q.ParentCtx)
+	defer cancel()
+	q.ParentCtx = pctx
+	ctx, cancel := q.Context()
+	defer cancel()
+
+	jb, err := js.orm.FindJob(ctx, jobID)
+	if err != nil {
+		return pkgerrors.Wrapf(err, "job %d not found", jobID)
+	}
+	if !jb.Paused {
+		return pkgerrors.Wrapf(ErrJobNotPaused, "cannot resume job %d", jobID)
+	}
+	jb.Paused = false
+
+	// The job is only marked as resumed once its services are running, so that it stays paused if they
+	// fail to start.
+	if err = js.StartService(pctx, jb); err != nil {
+		lggr.Errorw("Error starting job services", "type", jb.Type, "err", err)
+		js.stopService(jobID)
+		return err
+	}
+	if err = js.orm.SetJobPaused(jobID, false, pg.WithQueryer(q.Queryer), pg.WithParentCtx(ctx)); err != nil {
+		lggr.Errorw("Error resuming job", "err", err)
+		js.stopService(jobID)
+		return err
+	}
+	lggr.Infow("Resumed job", "type", jb.Type)
+
+	return nil
+}
+
 func (js *spawner) ActiveJobs() map[int32]Job {
 	js.activeJobsMu.RLock()
 	defer js.activeJobsMu.RUnlock()
@@ -1,6 +1,7 @@
 package job_test
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -251,6 +252,76 @@ func TestSpawner_CreateJobDeleteJob(t *testing.T) {
 		clearDB(t, db)
 	})
 
+	t.Run("stops and starts job services on 'PauseJob()' and 'ResumeJob()'", func(t *testing.T) {
+		jobA := makeOCRJobSpec(t, address, bridge.Name.String(), bridge2.Name.String())
+
+		serviceA1 := mocks.NewServiceCtx(t)
+		serviceA2 := mocks.NewServiceCtx(t)
+		serviceA1.On("Start", mock.Anything).Return(nil).Once()
+		serviceA2.On("Start", mock.Anything).Return(nil).Once()
+
+		lggr := logger.TestLogger(t)
+		orm := NewTestORM(t, db, cc, pipeline.NewORM(db, lggr, config.Database(), config.JobPipeline().MaxSuccessfulRuns()), bridges.NewORM(db, lggr, config.Database()), keyStore, config.Database())
+		mailMon := srvctest.Start(t, utils.NewMailboxMonitor(t.Name()))
+		d := ocr.NewDelegate(nil, orm, nil, nil, nil, monitoringEndpoint, cc, logger.TestLogger(t), config.Database(), mailMon)
+		delegateA := &delegate{jobA.Type, []job.ServiceCtx{serviceA1, serviceA2}, 0, nil, d}
+		spawner := job.NewSpawner(orm, config.Database(), map[job.Type]job.Delegate{
+			jobA.Type: delegateA,
+		}, db, lggr, nil)
+
+		err := orm.CreateJob(jobA)
+		require.NoError(t, err)
+		delegateA.jobID = jobA.ID
+
+		require.NoError(t, spawner.Start(testutils.Context(t)))
+		require.Contains(t, spawner.ActiveJobs(), jobA.ID)
+
+		serviceA1.On("Close").Return(nil).Once()
+		serviceA2.On("Close").Return(nil).Once()
+		require.NoError(t, spawner.PauseJob(jobA.ID))
+		require.NotContains(t, spawner.ActiveJobs(), jobA.ID)
+		require.ErrorIs(t, spawner.PauseJob(jobA.ID), job.ErrJobAlreadyPaused)
+
+		jb, err := orm.FindJob(testutils.Context(t), jobA.ID)
+		require.NoError(t, err)
+		assert.True(t, jb.Paused)
+
+		// Paused jobs are skipped at boot
+		spawner2 := job.NewSpawner(orm, config.Database(), map[job.Type]job.Delegate{
+			jobA.Type: delegateA,
+		}, db, lggr, nil)
+		require.NoError(t, spawner2.Start(testutils.Context(t)))
+		require.NotContains(t, spawner2.ActiveJobs(), jobA.ID)
+		require.NoError(t, spawner2.Close())
+
+		// A job whose services fail to start stays paused
+		serviceA1.On("Start", mock.Anything).Return(nil).Once()
+		serviceA2.On("Start", mock.Anything).Return(errors.New("cannot start")).Once()
+		serviceA1.On("Close").Return(nil).Once()
+		require.Error(t, spawner.ResumeJob(jobA.ID))
+		require.NotContains(t, spawner.ActiveJobs(), jobA.ID)
+
+		jb, err = orm.FindJob(testutils.Context(t), jobA.ID)
+		require.NoError(t, err)
+		assert.True(t, jb.Paused)
+
+		serviceA1.On("Start", mock.Anything).Return(nil).Once()
+		serviceA2.On("Start", mock.Anything).Return(nil).Once()
+		require.NoError(t, spawner.ResumeJob(jobA.ID))
+		require.Contains(t, spawner.ActiveJobs(), jobA.ID)
+		require.ErrorIs(t, spawner.ResumeJob(jobA.ID), job.ErrJobNotPaused)
+
+		jb, err = orm.FindJob(testutils.Context(t), jobA.ID)
+		require.NoError(t, err)
+		assert.False(t, jb.Paused)
+
+		serviceA1.On("Close").Return(nil).Once()
+		serviceA2.On("Close").Return(nil).Once()
+		require.NoError(t, spawner.Close())
+
+		clearDB(t, db)
+	})
+
 	t.Run("Unregisters filters on 'DeleteJob()'", func(t *testing.T) {
 		config = configtest2.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
 			c.Feature.LogPoller = func(b bool) *bool { return &b }(true)
@@ -273,7 +344,7 @@ func TestSpawner_CreateJobDeleteJob(t *testing.T) {
 		mailMon := srvctest.Start(t, utils.NewMailboxMonitor(t.Name()))
 
 		relayers := make(map[relay.Network]loop.Relayer)
-		evmRelayer := evmrelay.NewRelayer(db, cc, lggr, config, keyStore, nil)
+		evmRelayer := evmrelay.NewRelayer(db, cc, lggr, config.Database(), keyStore, nil)
 		relayers[relay.EVM] = relay.NewRelayerAdapter(evmRelayer, cc)
 
 		processConfig := plugins.NewRegistrarConfig(loop.GRPCOpts{}, func(name string) (*plugins.RegisteredLoop, error) { return nil, nil })
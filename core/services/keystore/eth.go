@@ -1,6 +1,7 @@
 package keystore
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"sort"
@@ -10,6 +11,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/pkg/errors"
 
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
@@ -32,6 +34,7 @@ type Eth interface {
 	Enable(address common.Address, chainID *big.Int, qopts ...pg.QOpt) error
 	Disable(address common.Address, chainID *big.Int, qopts ...pg.QOpt) error
 	Add(address common.Address, chainID *big.Int, qopts ...pg.QOpt) error
+	AddExternal(address common.Address, signer ExternalSigner, chainIDs ...*big.Int) error
 	Reset(address common.Address, chainID *big.Int, nonce int64, qopts ...pg.QOpt) error
 
 	NextSequence(address common.Address, chainID *big.Int, qopts ...pg.QOpt) (evmtypes.Nonce, error)
@@ -40,7 +43,8 @@ type Eth interface {
 	EnsureKeys(chainIDs ...*big.Int) error
 	SubscribeToKeyChanges() (ch chan struct{}, unsub func())
 
-	SignTx(fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+	SignTx(ctx context.Context, fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+	SignHash(address common.Address, hash common.Hash) ([]byte, error)
 
 	EnabledKeysForChain(chainID *big.Int) (keys []ethkey.KeyV2, err error)
 	GetRoundRobinAddress(chainID *big.Int, addresses ...common.Address) (address common.Address, err error)
@@ -60,15 +64,18 @@ type eth struct {
 	*keyManager
 	subscribers   [](chan struct{})
 	subscribersMu *sync.RWMutex
+	// externalSigners holds keys whose private key never enters the key ring
+	externalSigners map[common.Address]ExternalSigner
 }
 
 var _ Eth = &eth{}
 
 func newEthKeyStore(km *keyManager) *eth {
 	return &eth{
-		keyManager:    km,
-		subscribers:   make([](chan struct{}), 0),
-		subscribersMu: new(sync.RWMutex),
+		keyManager:      km,
+		subscribers:     make([](chan struct{}), 0),
+		subscribersMu:   new(sync.RWMutex),
+		externalSigners: make(map[common.Address]ExternalSigner),
 	}
 }
 
@@ -95,6 +102,9 @@ func (ks *eth) getAll() (keys []ethkey.KeyV2) {
 	for _, key := range ks.keyRing.Eth {
 		keys = append(keys, key)
 	}
+	for address := range ks.externalSigners {
+		keys = append(keys, ethkey.FromAddress(address))
+	}
 	sort.Slice(keys, func(i, j int) bool { return keys[i].Cmp(keys[j]) < 0 })
 	return
 }
@@ -179,6 +189,9 @@ func (ks *eth) Export(id string, password string) ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
+	if !key.HasPrivateKey() {
+		return nil, errors.Errorf("key with ID %s is held by an external signer and cannot be exported", id)
+	}
 	return key.ToEncryptedJSON(password, ks.scryptParams)
 }
 
@@ -230,13 +243,39 @@ func (ks *eth) IncrementNextSequence(address common.Address, chainID *big.Int, c
 func (ks *eth) Add(address common.Address, chainID *big.Int, qopts ...pg.QOpt) error {
 	ks.lock.Lock()
 	defer ks.lock.Unlock()
-	_, found := ks.keyRing.Eth[address.Hex()]
-	if !found {
+	if !ks.has(address) {
 		return ErrKeyNotFound
 	}
 	return ks.addKey(address, chainID, qopts...)
 }
 
+// AddExternal registers a key whose private key is held by the given external
+// signer and enables it for the given chains. Only the address and per-chain
+// state are stored by the node, the private key never enters the key ring.
+// External signers are not persisted and must be registered on every boot.
+func (ks *eth) AddExternal(address common.Address, signer ExternalSigner, chainIDs ...*big.Int) error {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return ErrLocked
+	}
+	if _, found := ks.keyRing.Eth[address.Hex()]; found {
+		return ErrKeyExists
+	}
+	ks.externalSigners[address] = signer
+	for _, chainID := range chainIDs {
+		if ks.keyStates.get(address, chainID) != nil {
+			continue
+		}
+		if err := ks.addKey(address, chainID); err != nil {
+			return err
+		}
+	}
+	ks.logger.Infow(fmt.Sprintf("Registered external signer for EVM key with ID %s", address.Hex()), "address", address.Hex(), "evmChainIDs", chainIDs)
+	ks.notify()
+	return nil
+}
+
 // caller must hold lock!
 func (ks *eth) addKey(address common.Address, chainID *big.Int, qopts ...pg.QOpt) error {
 	state := new(ethkey.State)
@@ -256,8 +295,7 @@ func (ks *eth) addKey(address common.Address, chainID *big.Int, qopts ...pg.QOpt
 func (ks *eth) Enable(address common.Address, chainID *big.Int, qopts ...pg.QOpt) error {
 	ks.lock.Lock()
 	defer ks.lock.Unlock()
-	_, found := ks.keyRing.Eth[address.Hex()]
-	if !found {
+	if !ks.has(address) {
 		return ErrKeyNotFound
 	}
 	return ks.enable(address, chainID, qopts...)
@@ -281,8 +319,7 @@ func (ks *eth) enable(address common.Address, chainID *big.Int, qopts ...pg.QOpt
 func (ks *eth) Disable(address common.Address, chainID *big.Int, qopts ...pg.QOpt) error {
 	ks.lock.Lock()
 	defer ks.lock.Unlock()
-	_, found := ks.keyRing.Eth[address.Hex()]
-	if !found {
+	if !ks.has(address) {
 		return errors.Errorf("no key exists with ID %s", address.Hex())
 	}
 	return ks.disable(address, chainID, qopts...)
@@ -336,10 +373,17 @@ func (ks *eth) Delete(id string) (ethkey.KeyV2, error) {
 	if err != nil {
 		return ethkey.KeyV2{}, err
 	}
-	err = ks.safeRemoveKey(key, func(tx pg.Queryer) error {
-		_, err2 := tx.Exec(`DELETE FROM evm_key_states WHERE address = $1`, key.Address)
-		return err2
-	})
+	if _, external := ks.externalSigners[key.Address]; external {
+		_, err = ks.orm.q.Exec(`DELETE FROM evm_key_states WHERE address = $1`, key.Address)
+		if err == nil {
+			delete(ks.externalSigners, key.Address)
+		}
+	} else {
+		err = ks.safeRemoveKey(key, func(tx pg.Queryer) error {
+			_, err2 := tx.Exec(`DELETE FROM evm_key_states WHERE address = $1`, key.Address)
+			return err2
+		})
+	}
 	if err != nil {
 		return ethkey.KeyV2{}, errors.Wrap(err, "unable to remove eth key")
 	}
@@ -365,8 +409,13 @@ func (ks *eth) SubscribeToKeyChanges() (ch chan struct{}, unsub func()) {
 	}
 }
 
-func (ks *eth) SignTx(address common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+func (ks *eth) SignTx(ctx context.Context, address common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
 	ks.lock.RLock()
+	if signer, external := ks.externalSigners[address]; external && !ks.isLocked() {
+		// do not hold the lock while waiting on the external signer
+		ks.lock.RUnlock()
+		return signer.SignTx(ctx, address, tx, chainID)
+	}
 	defer ks.lock.RUnlock()
 	if ks.isLocked() {
 		return nil, ErrLocked
@@ -375,10 +424,32 @@ func (ks *eth) SignTx(address common.Address, tx *types.Transaction, chainID *bi
 	if err != nil {
 		return nil, err
 	}
+	if !key.HasPrivateKey() {
+		return nil, errors.Errorf("eth key with address %s has no private key", address.String())
+	}
 	signer := types.LatestSignerForChainID(chainID)
 	return types.SignTx(tx, signer, key.ToEcdsaPrivKey())
 }
 
+// SignHash signs a 32 byte digest with the key of the given address, returning a 65 byte
+// [R || S || V] signature where V is 0 or 1. Keys held by external signers can only sign
+// transactions.
+func (ks *eth) SignHash(address common.Address, hash common.Hash) ([]byte, error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return nil, ErrLocked
+	}
+	key, err := ks.getByID(address.String())
+	if err != nil {
+		return nil, err
+	}
+	if !key.HasPrivateKey() {
+		return nil, errors.Errorf("eth key with address %s is held by an external signer, which can only sign transactions", address.String())
+	}
+	return crypto.Sign(hash[:], key.ToEcdsaPrivKey())
+}
+
 // EnabledKeysForChain returns all keys that are enabled for the given chain
 func (ks *eth) EnabledKeysForChain(chainID *big.Int) (sendingKeys []ethkey.KeyV2, err error) {
 	if chainID == nil {
@@ -450,14 +521,7 @@ func (ks *eth) CheckEnabled(address common.Address, chainID *big.Int) error {
 	if ks.isLocked() {
 		return ErrLocked
 	}
-	var found bool
-	for _, k := range ks.keyRing.Eth {
-		if k.Address == address {
-			found = true
-			break
-		}
-	}
-	if !found {
+	if !ks.has(address) {
 		return errors.Errorf("no eth key exists with address %s", address.String())
 	}
 	states := ks.keyStates.KeyIDChainID[address.String()]
@@ -613,18 +677,31 @@ func (ks *eth) XXXTestingOnlyAdd(key ethkey.KeyV2) {
 
 // caller must hold lock!
 func (ks *eth) getByID(id string) (ethkey.KeyV2, error) {
-	key, found := ks.keyRing.Eth[id]
-	if !found {
-		return ethkey.KeyV2{}, ErrKeyNotFound
+	if key, found := ks.keyRing.Eth[id]; found {
+		return key, nil
 	}
-	return key, nil
+	if common.IsHexAddress(id) {
+		address := common.HexToAddress(id)
+		if _, found := ks.externalSigners[address]; found {
+			return ethkey.FromAddress(address), nil
+		}
+	}
+	return ethkey.KeyV2{}, ErrKeyNotFound
+}
+
+// caller must hold lock!
+func (ks *eth) has(address common.Address) bool {
+	if _, found := ks.keyRing.Eth[address.Hex()]; found {
+		return true
+	}
+	_, found := ks.externalSigners[address]
+	return found
 }
 
 func (ks *eth) exists(address common.Address) bool {
 	ks.lock.RLock()
 	defer ks.lock.RUnlock()
-	_, found := ks.keyRing.Eth[address.Hex()]
-	return found
+	return ks.has(address)
 }
 
 // caller must hold lock!
@@ -640,7 +717,11 @@ func (ks *eth) keysForChain(chainID *big.Int, includeDisabled bool) (keys []ethk
 	}
 	for keyID, state := range states {
 		if includeDisabled || !state.Disabled {
-			k := ks.keyRing.Eth[keyID]
+			// states of external keys whose signer has not been registered are skipped
+			k, err := ks.getByID(keyID)
+			if err != nil {
+				continue
+			}
 			keys = append(keys, k)
 		}
 	}
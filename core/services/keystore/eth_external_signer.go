@@ -0,0 +1,109 @@
+package keystore
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+)
+
+// web3SignerTimeout bounds a single signing request to a web3signer endpoint,
+// even when the caller's context has no deadline
+const web3SignerTimeout = 10 * time.Second
+
+// ExternalSigner signs transactions for an EVM key whose private key is held
+// outside of the node, e.g. by a remote signing service or an HSM plugin.
+//
+//go:generate mockery --quiet --name ExternalSigner --output mocks/ --case=underscore
+type ExternalSigner interface {
+	SignTx(ctx context.Context, address common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+}
+
+type web3Signer struct {
+	url        *url.URL
+	httpClient *http.Client
+}
+
+// NewWeb3Signer returns an ExternalSigner that delegates to a
+// web3signer-compatible eth_signTransaction JSON-RPC endpoint. If httpClient
+// has no timeout, a copy of it with web3SignerTimeout is used instead.
+func NewWeb3Signer(u *url.URL, httpClient *http.Client) ExternalSigner {
+	if httpClient.Timeout == 0 {
+		withTimeout := *httpClient
+		withTimeout.Timeout = web3SignerTimeout
+		httpClient = &withTimeout
+	}
+	return &web3Signer{url: u, httpClient: httpClient}
+}
+
+type web3SignerTxArgs struct {
+	From                 common.Address  `json:"from"`
+	To                   *common.Address `json:"to,omitempty"`
+	Gas                  hexutil.Uint64  `json:"gas"`
+	GasPrice             *hexutil.Big    `json:"gasPrice,omitempty"`
+	MaxFeePerGas         *hexutil.Big    `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas *hexutil.Big    `json:"maxPriorityFeePerGas,omitempty"`
+	Value                *hexutil.Big    `json:"value"`
+	Nonce                hexutil.Uint64  `json:"nonce"`
+	Data                 hexutil.Bytes   `json:"data"`
+	ChainID              *hexutil.Big    `json:"chainId"`
+}
+
+func (s *web3Signer) SignTx(ctx context.Context, address common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	args := web3SignerTxArgs{
+		From:    address,
+		To:      tx.To(),
+		Gas:     hexutil.Uint64(tx.Gas()),
+		Value:   (*hexutil.Big)(tx.Value()),
+		Nonce:   hexutil.Uint64(tx.Nonce()),
+		Data:    tx.Data(),
+		ChainID: (*hexutil.Big)(chainID),
+	}
+	switch tx.Type() {
+	case types.LegacyTxType:
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	case types.DynamicFeeTxType:
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+	default:
+		return nil, errors.Errorf("web3signer: unsupported transaction type %d", tx.Type())
+	}
+
+	client, err := rpc.DialHTTPWithClient(s.url.String(), s.httpClient)
+	if err != nil {
+		return nil, errors.Wrap(err, "web3signer: failed to dial")
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(ctx, web3SignerTimeout)
+	defer cancel()
+	var raw hexutil.Bytes
+	if err = client.CallContext(ctx, &raw, "eth_signTransaction", args); err != nil {
+		return nil, errors.Wrap(err, "web3signer: eth_signTransaction failed")
+	}
+
+	signed := new(types.Transaction)
+	if err = signed.UnmarshalBinary(raw); err != nil {
+		return nil, errors.Wrap(err, "web3signer: failed to decode signed transaction")
+	}
+	// Never trust the remote end to have signed what we asked for, with the key we asked for
+	signer := types.LatestSignerForChainID(chainID)
+	if signer.Hash(signed) != signer.Hash(tx) {
+		return nil, errors.New("web3signer: signed transaction does not match the requested transaction")
+	}
+	sender, err := types.Sender(signer, signed)
+	if err != nil {
+		return nil, errors.Wrap(err, "web3signer: failed to recover sender")
+	}
+	if sender != address {
+		return nil, errors.Errorf("web3signer: transaction signed by %s, expected %s", sender.Hex(), address.Hex())
+	}
+	return signed, nil
+}
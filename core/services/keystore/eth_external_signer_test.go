@@ -0,0 +1,102 @@
+package keystore_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+)
+
+// newWeb3SignerServer returns a JSON-RPC server which answers eth_signTransaction by signing the given tx with key
+func newWeb3SignerServer(t *testing.T, key ethkey.KeyV2, tx *types.Transaction, chainID *big.Int) *url.URL {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage   `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "eth_signTransaction", req.Method)
+		require.Len(t, req.Params, 1)
+
+		signed, err := types.SignTx(tx, types.LatestSignerForChainID(chainID), key.ToEcdsaPrivKey())
+		require.NoError(t, err)
+		raw, err := signed.MarshalBinary()
+		require.NoError(t, err)
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      req.ID,
+			"result":  hexutil.Encode(raw),
+		}))
+	}))
+	t.Cleanup(srv.Close)
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	return u
+}
+
+func Test_Web3Signer_SignTx(t *testing.T) {
+	t.Parallel()
+
+	chainID := testutils.SimulatedChainID
+	key := cltest.MustGenerateRandomKey(t)
+	tx := types.NewTransaction(3, testutils.NewAddress(), big.NewInt(53), 21000, big.NewInt(1000000000), []byte{1, 2, 3, 4})
+
+	t.Run("returns the remotely signed transaction", func(t *testing.T) {
+		signer := keystore.NewWeb3Signer(newWeb3SignerServer(t, key, tx, chainID), http.DefaultClient)
+
+		signed, err := signer.SignTx(testutils.Context(t), key.Address, tx, chainID)
+		require.NoError(t, err)
+		sender, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+		require.NoError(t, err)
+		assert.Equal(t, key.Address, sender)
+		assert.Equal(t, tx.Nonce(), signed.Nonce())
+	})
+
+	t.Run("rejects a transaction signed by a different key", func(t *testing.T) {
+		other := cltest.MustGenerateRandomKey(t)
+		signer := keystore.NewWeb3Signer(newWeb3SignerServer(t, other, tx, chainID), http.DefaultClient)
+
+		_, err := signer.SignTx(testutils.Context(t), key.Address, tx, chainID)
+		assert.ErrorContains(t, err, "transaction signed by")
+	})
+
+	t.Run("rejects a transaction that differs from the request", func(t *testing.T) {
+		tampered := types.NewTransaction(3, testutils.NewAddress(), big.NewInt(5300), 21000, big.NewInt(1000000000), nil)
+		signer := keystore.NewWeb3Signer(newWeb3SignerServer(t, key, tampered, chainID), http.DefaultClient)
+
+		_, err := signer.SignTx(testutils.Context(t), key.Address, tx, chainID)
+		assert.ErrorContains(t, err, "does not match the requested transaction")
+	})
+
+	t.Run("gives up when the caller's context is done", func(t *testing.T) {
+		release := make(chan struct{})
+		defer close(release)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-release
+		}))
+		t.Cleanup(srv.Close)
+		u, err := url.Parse(srv.URL)
+		require.NoError(t, err)
+		signer := keystore.NewWeb3Signer(u, http.DefaultClient)
+
+		ctx, cancel := context.WithTimeout(testutils.Context(t), 100*time.Millisecond)
+		defer cancel()
+		_, err = signer.SignTx(ctx, key.Address, tx, chainID)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
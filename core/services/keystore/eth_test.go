@@ -11,7 +11,9 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
@@ -22,6 +24,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+	ksmocks "github.com/smartcontractkit/chainlink/v2/core/services/keystore/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
@@ -341,15 +344,42 @@ func Test_EthKeyStore_SignTx(t *testing.T) {
 	tx := types.NewTransaction(0, testutils.NewAddress(), big.NewInt(53), 21000, big.NewInt(1000000000), []byte{1, 2, 3, 4})
 
 	randomAddress := testutils.NewAddress()
-	_, err := ethKeyStore.SignTx(randomAddress, tx, chainID)
+	_, err := ethKeyStore.SignTx(testutils.Context(t), randomAddress, tx, chainID)
 	require.EqualError(t, err, "Key not found")
 
-	signed, err := ethKeyStore.SignTx(k.Address, tx, chainID)
+	signed, err := ethKeyStore.SignTx(testutils.Context(t), k.Address, tx, chainID)
 	require.NoError(t, err)
 
 	require.NotEqual(t, tx, signed)
 }
 
+func Test_EthKeyStore_SignHash(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	config := configtest.NewTestGeneralConfig(t)
+	keyStore := cltest.NewKeyStore(t, db, config.Database())
+	ethKeyStore := keyStore.Eth()
+
+	k, _ := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore)
+	hash := crypto.Keccak256Hash([]byte("attestation"))
+
+	_, err := ethKeyStore.SignHash(testutils.NewAddress(), hash)
+	require.EqualError(t, err, "Key not found")
+
+	sig, err := ethKeyStore.SignHash(k.Address, hash)
+	require.NoError(t, err)
+	require.Len(t, sig, 65)
+	pub, err := crypto.SigToPub(hash[:], sig)
+	require.NoError(t, err)
+	assert.Equal(t, k.Address, crypto.PubkeyToAddress(*pub))
+
+	external := testutils.NewAddress()
+	require.NoError(t, ethKeyStore.AddExternal(external, ksmocks.NewExternalSigner(t), testutils.FixtureChainID))
+	_, err = ethKeyStore.SignHash(external, hash)
+	assert.ErrorContains(t, err, "held by an external signer")
+}
+
 func Test_EthKeyStore_E2E(t *testing.T) {
 	t.Parallel()
 
@@ -879,3 +909,71 @@ func Test_EthKeyStore_CheckEnabled(t *testing.T) {
 		require.Contains(t, err.Error(), fmt.Sprintf("eth key with address %s exists but is disabled for chain 1337 (enabled only for chain IDs: 0)", addr2.Hex()))
 	})
 }
+
+func Test_EthKeyStore_AddExternal(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	keyStore := cltest.NewKeyStore(t, db, cfg.Database())
+	ks := keyStore.Eth()
+
+	k, _ := cltest.MustInsertRandomKey(t, ks, testutils.FixtureChainID)
+	addr := testutils.NewAddress()
+	signer := ksmocks.NewExternalSigner(t)
+
+	t.Run("rejects a key already in the key ring", func(t *testing.T) {
+		err := ks.AddExternal(k.Address, signer, testutils.FixtureChainID)
+		assert.ErrorIs(t, err, keystore.ErrKeyExists)
+	})
+
+	t.Run("adds state and makes the key available for sending", func(t *testing.T) {
+		require.NoError(t, ks.AddExternal(addr, signer, testutils.FixtureChainID))
+		testutils.AssertCount(t, db, "evm_key_states", 2)
+		// registering again on reboot is idempotent
+		require.NoError(t, ks.AddExternal(addr, signer, testutils.FixtureChainID))
+		testutils.AssertCount(t, db, "evm_key_states", 2)
+
+		keys, err := ks.EnabledKeysForChain(testutils.FixtureChainID)
+		require.NoError(t, err)
+		require.Len(t, keys, 2)
+		assert.Contains(t, []common.Address{keys[0].Address, keys[1].Address}, addr)
+		require.NoError(t, ks.CheckEnabled(addr, testutils.FixtureChainID))
+
+		found, err := ks.Get(addr.Hex())
+		require.NoError(t, err)
+		assert.Equal(t, addr, found.Address)
+		assert.Nil(t, found.ToEcdsaPrivKey())
+
+		rrAddr, err := ks.GetRoundRobinAddress(testutils.FixtureChainID, addr)
+		require.NoError(t, err)
+		assert.Equal(t, addr, rrAddr)
+
+		_, err = ks.Export(addr.Hex(), cltest.Password)
+		assert.ErrorContains(t, err, "held by an external signer")
+	})
+
+	t.Run("delegates signing to the external signer", func(t *testing.T) {
+		tx := types.NewTransaction(0, testutils.NewAddress(), big.NewInt(53), 21000, big.NewInt(1000000000), []byte{1, 2, 3, 4})
+		signed := types.NewTransaction(0, testutils.NewAddress(), big.NewInt(54), 21000, big.NewInt(1000000000), nil)
+		signer.On("SignTx", mock.Anything, addr, tx, testutils.FixtureChainID).Return(signed, nil).Once()
+
+		res, err := ks.SignTx(testutils.Context(t), addr, tx, testutils.FixtureChainID)
+		require.NoError(t, err)
+		assert.Same(t, signed, res)
+	})
+
+	t.Run("Delete removes the state and unregisters the signer", func(t *testing.T) {
+		deleted, err := ks.Delete(addr.Hex())
+		require.NoError(t, err)
+		assert.Equal(t, addr, deleted.Address)
+		testutils.AssertCount(t, db, "evm_key_states", 1)
+
+		_, err = ks.Get(addr.Hex())
+		assert.ErrorIs(t, err, keystore.ErrKeyNotFound)
+		keys, err := ks.EnabledKeysForChain(testutils.FixtureChainID)
+		require.NoError(t, err)
+		require.Len(t, keys, 1)
+		assert.Equal(t, k.Address, keys[0].Address)
+	})
+}
@@ -3,6 +3,7 @@ package keystore
 import (
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/sqlx"
@@ -32,6 +33,7 @@ func (m *master) ExportedSave() error {
 func (m *master) ResetXXXTestOnly() {
 	m.keyRing = newKeyRing()
 	m.keyStates = newKeyStates()
+	m.eth.externalSigners = make(map[common.Address]ExternalSigner)
 	m.password = ""
 }
 
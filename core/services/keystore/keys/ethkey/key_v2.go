@@ -64,6 +64,15 @@ func FromPrivateKey(privKey *ecdsa.PrivateKey) (key KeyV2) {
 	}
 }
 
+// FromAddress returns a KeyV2 that carries no private key. It represents a key
+// whose private key is held by an external signer.
+func FromAddress(address common.Address) (key KeyV2) {
+	return KeyV2{
+		Address:      address,
+		EIP55Address: EIP55AddressFromAddress(address),
+	}
+}
+
 func (key KeyV2) ID() string {
 	return key.Address.Hex()
 }
@@ -72,10 +81,18 @@ func (key KeyV2) Raw() Raw {
 	return key.privateKey.D.Bytes()
 }
 
+// ToEcdsaPrivKey returns the private key, or nil for a key created with
+// FromAddress. Callers must check HasPrivateKey before using the result.
 func (key KeyV2) ToEcdsaPrivKey() *ecdsa.PrivateKey {
 	return key.privateKey
 }
 
+// HasPrivateKey returns false for keys whose private key is held by an
+// external signer.
+func (key KeyV2) HasPrivateKey() bool {
+	return key.privateKey != nil
+}
+
 func (key KeyV2) String() string {
 	return fmt.Sprintf("EthKeyV2{PrivateKey: <redacted>, Address: %s}", key.Address)
 }
@@ -41,3 +41,15 @@ func TestEthKeyV2_NewV2(t *testing.T) {
 	assert.NotNil(t, keyV2.privateKey)
 	assert.Equal(t, keyV2.Address.Hex(), keyV2.ID())
 }
+
+func TestEthKeyV2_FromAddress(t *testing.T) {
+	keyV2, err := NewV2()
+	require.NoError(t, err)
+	assert.True(t, keyV2.HasPrivateKey())
+
+	external := FromAddress(keyV2.Address)
+	assert.Equal(t, keyV2.Address, external.Address)
+	assert.Equal(t, keyV2.ID(), external.ID())
+	assert.False(t, external.HasPrivateKey())
+	assert.Nil(t, external.ToEcdsaPrivKey())
+}
@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	context "context"
+
 	big "math/big"
 
 	common "github.com/ethereum/go-ethereum/common"
@@ -10,6 +12,8 @@ import (
 
 	ethkey "github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
 
+	keystore "github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+
 	mock "github.com/stretchr/testify/mock"
 
 	pg "github.com/smartcontractkit/chainlink/v2/core/services/pg"
@@ -43,6 +47,27 @@ func (_m *Eth) Add(address common.Address, chainID *big.Int, qopts ...pg.QOpt) e
 	return r0
 }
 
+// AddExternal provides a mock function with given fields: address, signer, chainIDs
+func (_m *Eth) AddExternal(address common.Address, signer keystore.ExternalSigner, chainIDs ...*big.Int) error {
+	_va := make([]interface{}, len(chainIDs))
+	for _i := range chainIDs {
+		_va[_i] = chainIDs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, address, signer)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(common.Address, keystore.ExternalSigner, ...*big.Int) error); ok {
+		r0 = rf(address, signer, chainIDs...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CheckEnabled provides a mock function with given fields: address, chainID
 func (_m *Eth) CheckEnabled(address common.Address, chainID *big.Int) error {
 	ret := _m.Called(address, chainID)
@@ -538,25 +563,51 @@ func (_m *Eth) Reset(address common.Address, chainID *big.Int, nonce int64, qopt
 	return r0
 }
 
-// SignTx provides a mock function with given fields: fromAddress, tx, chainID
-func (_m *Eth) SignTx(fromAddress common.Address, tx *coretypes.Transaction, chainID *big.Int) (*coretypes.Transaction, error) {
-	ret := _m.Called(fromAddress, tx, chainID)
+// SignHash provides a mock function with given fields: address, hash
+func (_m *Eth) SignHash(address common.Address, hash common.Hash) ([]byte, error) {
+	ret := _m.Called(address, hash)
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(common.Address, common.Hash) ([]byte, error)); ok {
+		return rf(address, hash)
+	}
+	if rf, ok := ret.Get(0).(func(common.Address, common.Hash) []byte); ok {
+		r0 = rf(address, hash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(common.Address, common.Hash) error); ok {
+		r1 = rf(address, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SignTx provides a mock function with given fields: ctx, fromAddress, tx, chainID
+func (_m *Eth) SignTx(ctx context.Context, fromAddress common.Address, tx *coretypes.Transaction, chainID *big.Int) (*coretypes.Transaction, error) {
+	ret := _m.Called(ctx, fromAddress, tx, chainID)
 
 	var r0 *coretypes.Transaction
 	var r1 error
-	if rf, ok := ret.Get(0).(func(common.Address, *coretypes.Transaction, *big.Int) (*coretypes.Transaction, error)); ok {
-		return rf(fromAddress, tx, chainID)
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *coretypes.Transaction, *big.Int) (*coretypes.Transaction, error)); ok {
+		return rf(ctx, fromAddress, tx, chainID)
 	}
-	if rf, ok := ret.Get(0).(func(common.Address, *coretypes.Transaction, *big.Int) *coretypes.Transaction); ok {
-		r0 = rf(fromAddress, tx, chainID)
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *coretypes.Transaction, *big.Int) *coretypes.Transaction); ok {
+		r0 = rf(ctx, fromAddress, tx, chainID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*coretypes.Transaction)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(common.Address, *coretypes.Transaction, *big.Int) error); ok {
-		r1 = rf(fromAddress, tx, chainID)
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, *coretypes.Transaction, *big.Int) error); ok {
+		r1 = rf(ctx, fromAddress, tx, chainID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1,6 +1,7 @@
 package functions
 
 import (
+	"context"
 	"encoding/json"
 	"math/big"
 	"time"
@@ -27,6 +28,7 @@ import (
 	s4_plugin "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/s4"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/threshold"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/s4"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
@@ -44,6 +46,7 @@ type FunctionsServicesConfig struct {
 	URLsMonEndpoint   commontypes.MonitoringEndpoint
 	EthKeystore       keystore.Eth
 	ThresholdKeyShare []byte
+	PipelineRunner    pipeline.Runner
 }
 
 const (
@@ -136,6 +139,9 @@ func NewFunctionsServices(functionsOracleArgs, thresholdOracleArgs, s4OracleArgs
 			return nil, errors.Wrap(err, "failed to create a GatewayConnector")
 		}
 		allServices = append(allServices, connector)
+		if conf.PipelineRunner != nil {
+			allServices = append(allServices, &pipelineS4Storage{runner: conf.PipelineRunner, donID: pluginConfig.GatewayConnectorConfig.DonId, storage: s4Storage})
+		}
 	} else {
 		listenerLogger.Warn("No GatewayConnectorConfig, S4Constraints or OnchainAllowlist is found in the plugin config, GatewayConnector will not be enabled")
 	}
@@ -168,6 +174,9 @@ func NewConnector(gwcCfg *connector.ConnectorConfig, ethKeystore keystore.Eth, c
 	if idx == -1 {
 		return nil, errors.New("key for configured node address not found")
 	}
+	if !enabledKeys[idx].HasPrivateKey() {
+		return nil, errors.New("key for configured node address is held by an external signer and cannot sign gateway messages")
+	}
 	signerKey := enabledKeys[idx].ToEcdsaPrivKey()
 	nodeAddreess := enabledKeys[idx].ID()
 
@@ -179,3 +188,26 @@ func NewConnector(gwcCfg *connector.ConnectorConfig, ethKeystore keystore.Eth, c
 	handler.SetConnector(connector)
 	return connector, nil
 }
+
+// pipelineS4Storage makes the job's S4 storage available to the s4get and s4put
+// pipeline tasks of its DON while the job is running.
+type pipelineS4Storage struct {
+	runner     pipeline.Runner
+	donID      string
+	storage    s4.Storage
+	unregister func()
+}
+
+var _ job.ServiceCtx = (*pipelineS4Storage)(nil)
+
+func (p *pipelineS4Storage) Start(context.Context) error {
+	p.unregister = p.runner.RegisterS4Storage(p.donID, p.storage)
+	return nil
+}
+
+func (p *pipelineS4Storage) Close() error {
+	if p.unregister != nil {
+		p.unregister()
+	}
+	return nil
+}
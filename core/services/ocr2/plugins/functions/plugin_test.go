@@ -18,6 +18,24 @@ import (
 )
 
 func TestNewConnector_Success(t *testing.T) {
+	t.Parallel()
+	key, err := ethkey.NewV2()
+	require.NoError(t, err)
+
+	gwcCfg := &connector.ConnectorConfig{
+		NodeAddress: key.Address.Hex(),
+		DonId:       "my_don",
+	}
+	chainID := big.NewInt(80001)
+	ethKeystore := ksmocks.NewEth(t)
+	s4Storage := s4mocks.NewStorage(t)
+	allowlist := gfmocks.NewOnchainAllowlist(t)
+	ethKeystore.On("EnabledKeysForChain", mock.Anything).Return([]ethkey.KeyV2{key}, nil)
+	_, err = functions.NewConnector(gwcCfg, ethKeystore, chainID, s4Storage, allowlist, logger.TestLogger(t))
+	require.NoError(t, err)
+}
+
+func TestNewConnector_ExternalKey(t *testing.T) {
 	t.Parallel()
 	address := "0x00000000DE801ceE9471ADf23370c48b011f82a6"
 
@@ -29,9 +47,9 @@ func TestNewConnector_Success(t *testing.T) {
 	ethKeystore := ksmocks.NewEth(t)
 	s4Storage := s4mocks.NewStorage(t)
 	allowlist := gfmocks.NewOnchainAllowlist(t)
-	ethKeystore.On("EnabledKeysForChain", mock.Anything).Return([]ethkey.KeyV2{{Address: common.HexToAddress(address)}}, nil)
+	ethKeystore.On("EnabledKeysForChain", mock.Anything).Return([]ethkey.KeyV2{ethkey.FromAddress(common.HexToAddress(address))}, nil)
 	_, err := functions.NewConnector(gwcCfg, ethKeystore, chainID, s4Storage, allowlist, logger.TestLogger(t))
-	require.NoError(t, err)
+	require.ErrorContains(t, err, "held by an external signer")
 }
 
 func TestNewConnector_NoKeyForConfiguredAddress(t *testing.T) {
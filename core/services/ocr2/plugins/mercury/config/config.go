@@ -16,8 +16,18 @@ import (
 )
 
 type PluginConfig struct {
+	// Must either specify details for single server OR multiple servers.
+	// Specifying both is not valid.
+
+	// Single mercury server
+	// LEGACY: This is the old way of specifying a mercury server
 	RawServerURL string              `json:"serverURL" toml:"serverURL"`
 	ServerPubKey utils.PlainHexBytes `json:"serverPubKey" toml:"serverPubKey"`
+
+	// Multi mercury servers
+	// This is the preferred way to specify mercury server(s)
+	Servers map[string]utils.PlainHexBytes `json:"servers" toml:"servers"`
+
 	// InitialBlockNumber allows to set a custom "validFromBlockNumber" for
 	// the first ever report in the case of a brand new feed, where the mercury
 	// server does not have any previous reports. For a brand new feed, this
@@ -25,25 +35,50 @@ type PluginConfig struct {
 	InitialBlockNumber null.Int64 `json:"initialBlockNumber" toml:"initialBlockNumber"`
 }
 
-func ValidatePluginConfig(config PluginConfig) (merr error) {
-	if config.RawServerURL == "" {
-		merr = errors.New("Mercury: ServerURL must be specified")
+func validateURL(rawServerURL string) error {
+	var normalizedURI string
+	if schemeRegexp.MatchString(rawServerURL) {
+		normalizedURI = rawServerURL
 	} else {
-		var normalizedURI string
-		if schemeRegexp.MatchString(config.RawServerURL) {
-			normalizedURI = config.RawServerURL
+		normalizedURI = fmt.Sprintf("wss://%s", rawServerURL)
+	}
+	uri, err := url.ParseRequestURI(normalizedURI)
+	if err != nil {
+		return pkgerrors.Wrap(err, "Mercury: invalid value for ServerURL")
+	} else if !(uri.Scheme == "" || uri.Scheme == "wss") {
+		return pkgerrors.Errorf(`Mercury: invalid scheme specified for MercuryServer, got: %q (scheme: %q) but expected a websocket url e.g. "192.0.2.2:4242" or "wss://192.0.2.2:4242"`, rawServerURL, uri.Scheme)
+	}
+	return nil
+}
+
+func ValidatePluginConfig(config PluginConfig) (merr error) {
+	if len(config.Servers) > 0 {
+		if config.RawServerURL != "" || len(config.ServerPubKey) != 0 {
+			merr = errors.Join(merr, errors.New("Mercury: Servers and RawServerURL/ServerPubKey may not be specified together"))
 		} else {
-			normalizedURI = fmt.Sprintf("wss://%s", config.RawServerURL)
+			normalized := make(map[string]string, len(config.Servers))
+			for serverName, serverPubKey := range config.Servers {
+				if err := validateURL(serverName); err != nil {
+					merr = errors.Join(merr, pkgerrors.Wrap(err, "Mercury: invalid value for Servers"))
+				}
+				if other, exists := normalized[normalizeURL(serverName)]; exists {
+					merr = errors.Join(merr, pkgerrors.Errorf("Mercury: duplicate server in Servers, %q and %q refer to the same server", other, serverName))
+				}
+				normalized[normalizeURL(serverName)] = serverName
+				if len(serverPubKey) != 32 {
+					merr = errors.Join(merr, errors.New("Mercury: ServerPubKey is required and must be a 32-byte hex string"))
+				}
+			}
 		}
-		uri, err := url.ParseRequestURI(normalizedURI)
-		if err != nil {
-			merr = pkgerrors.Wrap(err, "Mercury: invalid value for ServerURL")
-		} else if !(uri.Scheme == "" || uri.Scheme == "wss") {
-			merr = pkgerrors.Errorf(`Mercury: invalid scheme specified for MercuryServer, got: %q (scheme: %q) but expected a websocket url e.g. "192.0.2.2:4242" or "wss://192.0.2.2:4242"`, config.RawServerURL, uri.Scheme)
+	} else {
+		if config.RawServerURL == "" {
+			merr = errors.New("Mercury: ServerURL must be specified")
+		} else if err := validateURL(config.RawServerURL); err != nil {
+			merr = err
+		}
+		if len(config.ServerPubKey) != 32 {
+			merr = errors.Join(merr, errors.New("Mercury: ServerPubKey is required and must be a 32-byte hex string"))
 		}
-	}
-	if len(config.ServerPubKey) != 32 {
-		merr = errors.Join(merr, errors.New("Mercury: ServerPubKey is required and must be a 32-byte hex string"))
 	}
 	return merr
 }
@@ -52,5 +87,21 @@ var schemeRegexp = regexp.MustCompile(`^(.*)://`)
 var wssRegexp = regexp.MustCompile(`^wss://`)
 
 func (p PluginConfig) ServerURL() string {
-	return wssRegexp.ReplaceAllString(p.RawServerURL, "")
+	return normalizeURL(p.RawServerURL)
+}
+
+func normalizeURL(rawServerURL string) string {
+	return wssRegexp.ReplaceAllString(rawServerURL, "")
+}
+
+// GetServers returns the configured mercury servers keyed by normalized URL.
+func (p PluginConfig) GetServers() map[string][]byte {
+	if len(p.Servers) > 0 {
+		servers := make(map[string][]byte, len(p.Servers))
+		for rawURL, pubKey := range p.Servers {
+			servers[normalizeURL(rawURL)] = pubKey
+		}
+		return servers
+	}
+	return map[string][]byte{p.ServerURL(): p.ServerPubKey}
 }
@@ -6,6 +6,8 @@ import (
 	"github.com/pelletier/go-toml/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 func Test_PluginConfig(t *testing.T) {
@@ -43,6 +45,60 @@ ServerPubKey = "4242"
 	})
 }
 
+func Test_PluginConfig_Servers(t *testing.T) {
+	t.Run("with multiple servers", func(t *testing.T) {
+		rawToml := `
+[Servers]
+"example.com:80" = "724ff6eae9e900270edfff233e16322a70ec06e1a6e62a81ef13921f398f6c93"
+"wss://example2.invalid:1234" = "524ff6eae9e900270edfff233e16322a70ec06e1a6e62a81ef13921f398f6c93"
+`
+
+		var mc PluginConfig
+		err := toml.Unmarshal([]byte(rawToml), &mc)
+		require.NoError(t, err)
+		require.NoError(t, ValidatePluginConfig(mc))
+
+		servers := mc.GetServers()
+		require.Len(t, servers, 2)
+		assert.Contains(t, servers, "example.com:80")
+		assert.Contains(t, servers, "example2.invalid:1234")
+	})
+
+	t.Run("with legacy single server", func(t *testing.T) {
+		pc := PluginConfig{RawServerURL: "wss://example.com", ServerPubKey: make([]byte, 32)}
+		require.NoError(t, ValidatePluginConfig(pc))
+		assert.Equal(t, map[string][]byte{"example.com": make([]byte, 32)}, pc.GetServers())
+	})
+
+	t.Run("with both single and multiple servers", func(t *testing.T) {
+		pc := PluginConfig{
+			RawServerURL: "example.com",
+			ServerPubKey: make([]byte, 32),
+			Servers:      map[string]utils.PlainHexBytes{"example2.invalid": make([]byte, 32)},
+		}
+		err := ValidatePluginConfig(pc)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Mercury: Servers and RawServerURL/ServerPubKey may not be specified together")
+	})
+
+	t.Run("with invalid server pub key", func(t *testing.T) {
+		pc := PluginConfig{Servers: map[string]utils.PlainHexBytes{"example.com": {1, 2}}}
+		err := ValidatePluginConfig(pc)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Mercury: ServerPubKey is required and must be a 32-byte hex string")
+	})
+
+	t.Run("with the same server with and without scheme", func(t *testing.T) {
+		pc := PluginConfig{Servers: map[string]utils.PlainHexBytes{
+			"example.com:80":       make([]byte, 32),
+			"wss://example.com:80": make([]byte, 32),
+		}}
+		err := ValidatePluginConfig(pc)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Mercury: duplicate server in Servers")
+	})
+}
+
 func Test_PluginConfig_ServerURL(t *testing.T) {
 	pc := PluginConfig{RawServerURL: "example.com"}
 	assert.Equal(t, "example.com", pc.ServerURL())
@@ -69,6 +69,7 @@ type (
 		MaxRunDuration() time.Duration
 		ReaperInterval() time.Duration
 		ReaperThreshold() time.Duration
+		SignTaskEnabled() bool
 	}
 
 	BridgeConfig interface {
@@ -106,6 +107,8 @@ const (
 type RunInfo struct {
 	IsRetryable bool
 	IsPending   bool
+	// RejectedInputs holds the indices of the values discarded as outliers by aggregation tasks
+	RejectedInputs []int
 }
 
 // retryableMeta should be returned if the error is non-deterministic; i.e. a
@@ -212,6 +215,12 @@ func (result *TaskRunResult) IsPending() bool {
 	return !result.FinishedAt.Valid && result.Result == Result{}
 }
 
+// RejectedInputs returns the indices of the values the task discarded as outliers, for aggregation tasks
+// such as trimmedmean and madfilter.
+func (result *TaskRunResult) RejectedInputs() []int {
+	return result.runInfo.RejectedInputs
+}
+
 func (result *TaskRunResult) IsTerminal() bool {
 	return len(result.Task.Outputs()) == 0
 }
@@ -362,6 +371,7 @@ func (t TaskType) String() string {
 }
 
 const (
+	TaskTypeAbs              TaskType = "abs"
 	TaskTypeAny              TaskType = "any"
 	TaskTypeBase64Decode     TaskType = "base64decode"
 	TaskTypeBase64Encode     TaskType = "base64encode"
@@ -374,22 +384,37 @@ const (
 	TaskTypeETHABIEncode     TaskType = "ethabiencode"
 	TaskTypeETHABIEncode2    TaskType = "ethabiencode2"
 	TaskTypeETHCall          TaskType = "ethcall"
+	TaskTypeETHCallBatch     TaskType = "ethcallbatch"
 	TaskTypeETHTx            TaskType = "ethtx"
 	TaskTypeEstimateGasLimit TaskType = "estimategaslimit"
+	TaskTypeGreaterThan      TaskType = "greaterthan"
 	TaskTypeHTTP             TaskType = "http"
+	TaskTypeHash             TaskType = "hash"
 	TaskTypeHexDecode        TaskType = "hexdecode"
 	TaskTypeHexEncode        TaskType = "hexencode"
 	TaskTypeJSONParse        TaskType = "jsonparse"
+	TaskTypeJSONQuery        TaskType = "jsonquery"
 	TaskTypeLength           TaskType = "length"
 	TaskTypeLessThan         TaskType = "lessthan"
 	TaskTypeLookup           TaskType = "lookup"
 	TaskTypeLowercase        TaskType = "lowercase"
+	TaskTypeMADFilter        TaskType = "madfilter"
+	TaskTypeMax              TaskType = "max"
 	TaskTypeMean             TaskType = "mean"
 	TaskTypeMedian           TaskType = "median"
 	TaskTypeMerge            TaskType = "merge"
+	TaskTypeMin              TaskType = "min"
 	TaskTypeMode             TaskType = "mode"
 	TaskTypeMultiply         TaskType = "multiply"
+	TaskTypePercentChange    TaskType = "percentchange"
+	TaskTypePow              TaskType = "pow"
+	TaskTypeRound            TaskType = "round"
+	TaskTypeS4Get            TaskType = "s4get"
+	TaskTypeS4Put            TaskType = "s4put"
+	TaskTypeSign             TaskType = "sign"
+	TaskTypeSubtract         TaskType = "subtract"
 	TaskTypeSum              TaskType = "sum"
+	TaskTypeTrimmedMean      TaskType = "trimmedmean"
 	TaskTypeUppercase        TaskType = "uppercase"
 	TaskTypeVRF              TaskType = "vrf"
 	TaskTypeVRFV2            TaskType = "vrfv2"
@@ -489,6 +514,38 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &Base64DecodeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeBase64Encode:
 		task = &Base64EncodeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeS4Get:
+		task = &S4GetTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeS4Put:
+		task = &S4PutTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeSubtract:
+		task = &SubtractTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeMin:
+		task = &MinTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeMax:
+		task = &MaxTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeGreaterThan:
+		task = &GreaterThanTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeAbs:
+		task = &AbsTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeRound:
+		task = &RoundTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypePow:
+		task = &PowTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypePercentChange:
+		task = &PercentChangeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeTrimmedMean:
+		task = &TrimmedMeanTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeMADFilter:
+		task = &MADFilterTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeJSONQuery:
+		task = &JSONQueryTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeHash:
+		task = &HashTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeSign:
+		task = &SignTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeETHCallBatch:
+		task = &ETHCallBatchTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	default:
 		return nil, pkgerrors.Errorf(`unknown task type: "%v"`, taskType)
 	}
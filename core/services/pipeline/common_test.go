@@ -162,6 +162,20 @@ func TestUnmarshalTaskFromMap(t *testing.T) {
 		{pipeline.TaskTypeConditional, &pipeline.ConditionalTask{}},
 		{pipeline.TaskTypeHexDecode, &pipeline.HexDecodeTask{}},
 		{pipeline.TaskTypeBase64Decode, &pipeline.Base64DecodeTask{}},
+		{pipeline.TaskTypeSubtract, &pipeline.SubtractTask{}},
+		{pipeline.TaskTypeMin, &pipeline.MinTask{}},
+		{pipeline.TaskTypeMax, &pipeline.MaxTask{}},
+		{pipeline.TaskTypeGreaterThan, &pipeline.GreaterThanTask{}},
+		{pipeline.TaskTypeAbs, &pipeline.AbsTask{}},
+		{pipeline.TaskTypeRound, &pipeline.RoundTask{}},
+		{pipeline.TaskTypePow, &pipeline.PowTask{}},
+		{pipeline.TaskTypePercentChange, &pipeline.PercentChangeTask{}},
+		{pipeline.TaskTypeTrimmedMean, &pipeline.TrimmedMeanTask{}},
+		{pipeline.TaskTypeMADFilter, &pipeline.MADFilterTask{}},
+		{pipeline.TaskTypeJSONQuery, &pipeline.JSONQueryTask{}},
+		{pipeline.TaskTypeHash, &pipeline.HashTask{}},
+		{pipeline.TaskTypeSign, &pipeline.SignTask{}},
+		{pipeline.TaskTypeETHCallBatch, &pipeline.ETHCallBatchTask{}},
 	}
 
 	for _, test := range tests {
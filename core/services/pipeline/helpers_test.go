@@ -7,6 +7,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/v2/core/services/s4"
 )
 
 const (
@@ -57,9 +58,29 @@ func (t *ETHCallTask) HelperSetDependencies(cc evm.ChainSet, config Config, spec
 	t.jobType = jobType
 }
 
+func (t *S4GetTask) HelperSetDependencies(donID string, storage s4.Storage) {
+	t.storages = newS4Storages()
+	t.storages.register(donID, storage)
+}
+
+func (t *S4PutTask) HelperSetDependencies(donID string, storage s4.Storage) {
+	t.storages = newS4Storages()
+	t.storages.register(donID, storage)
+}
+
 func (t *ETHTxTask) HelperSetDependencies(cc evm.ChainSet, keyStore ETHKeyStore, specGasLimit *uint32, jobType string) {
 	t.chainSet = cc
 	t.keyStore = keyStore
 	t.specGasLimit = specGasLimit
 	t.jobType = jobType
 }
+
+func (t *SignTask) HelperSetDependencies(config Config, keyStore ETHKeyStore, cc evm.ChainSet) {
+	t.config = config
+	t.keyStore = keyStore
+	t.chainSet = cc
+}
+
+func (t *ETHCallBatchTask) HelperSetDependencies(cc evm.ChainSet) {
+	t.chainSet = cc
+}
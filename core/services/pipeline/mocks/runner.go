@@ -12,6 +12,8 @@ import (
 
 	pipeline "github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 
+	s4 "github.com/smartcontractkit/chainlink/v2/core/services/s4"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -189,6 +191,22 @@ func (_m *Runner) Ready() error {
 	return r0
 }
 
+// RegisterS4Storage provides a mock function with given fields: donID, storage
+func (_m *Runner) RegisterS4Storage(donID string, storage s4.Storage) func() {
+	ret := _m.Called(donID, storage)
+
+	var r0 func()
+	if rf, ok := ret.Get(0).(func(string, s4.Storage) func()); ok {
+		r0 = rf(donID, storage)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(func())
+		}
+	}
+
+	return r0
+}
+
 // ResumeRun provides a mock function with given fields: taskID, value, err
 func (_m *Runner) ResumeRun(taskID uuid.UUID, value interface{}, err error) error {
 	ret := _m.Called(taskID, value, err)
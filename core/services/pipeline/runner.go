@@ -20,6 +20,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/recovery"
 	"github.com/smartcontractkit/chainlink/v2/core/services"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/core/services/s4"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
@@ -48,6 +49,10 @@ type Runner interface {
 	ExecuteAndInsertFinishedRun(ctx context.Context, spec Spec, vars Vars, l logger.Logger, saveSuccessfulTaskRuns bool) (runID int64, finalResult FinalResult, err error)
 
 	OnRunFinished(func(*Run))
+
+	// RegisterS4Storage makes storage available to the s4get and s4put tasks of donID until
+	// the returned func is called.
+	RegisterS4Storage(donID string, storage s4.Storage) (unregister func())
 }
 
 type runner struct {
@@ -58,6 +63,7 @@ type runner struct {
 	chainSet               evm.ChainSet
 	ethKeyStore            ETHKeyStore
 	vrfKeyStore            VRFKeyStore
+	s4Storages             *s4Storages
 	runReaperWorker        utils.SleeperTask
 	lggr                   logger.Logger
 	httpClient             *http.Client
@@ -99,6 +105,12 @@ var (
 	},
 		[]string{"job_id", "job_name", "task_id", "task_type", "status"},
 	)
+	PromPipelineTaskRejectedInputs = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_task_rejected_inputs",
+		Help: "The total number of inputs discarded as outliers by aggregation tasks",
+	},
+		[]string{"job_id", "job_name", "task_id", "task_type"},
+	)
 )
 
 func NewRunner(orm ORM, btORM bridges.ORM, cfg Config, bridgeCfg BridgeConfig, chainSet evm.ChainSet, ethks ETHKeyStore, vrfks VRFKeyStore, lggr logger.Logger, httpClient, unrestrictedHTTPClient *http.Client) *runner {
@@ -110,6 +122,7 @@ func NewRunner(orm ORM, btORM bridges.ORM, cfg Config, bridgeCfg BridgeConfig, c
 		chainSet:               chainSet,
 		ethKeyStore:            ethks,
 		vrfKeyStore:            vrfks,
+		s4Storages:             newS4Storages(),
 		chStop:                 make(chan struct{}),
 		wgDone:                 sync.WaitGroup{},
 		runFinished:            func(*Run) {},
@@ -210,6 +223,12 @@ func (r *runner) OnRunFinished(fn func(*Run)) {
 	r.runFinished = fn
 }
 
+// RegisterS4Storage is called by every Functions job that owns an S4 namespace, so that
+// the s4get and s4put tasks are bound by the S4 constraints of its plugin config.
+func (r *runner) RegisterS4Storage(donID string, storage s4.Storage) (unregister func()) {
+	return r.s4Storages.register(donID, storage)
+}
+
 // Be careful with the ctx passed in here: it applies to requests in individual
 // tasks but should _not_ apply to the scheduler or run itself
 func (r *runner) ExecuteRun(
@@ -278,6 +297,16 @@ func (r *runner) initializePipeline(run *Run) (*Pipeline, error) {
 			task.(*ETHTxTask).specGasLimit = run.PipelineSpec.GasLimit
 			task.(*ETHTxTask).jobType = run.PipelineSpec.JobType
 			task.(*ETHTxTask).forwardingAllowed = run.PipelineSpec.ForwardingAllowed
+		case TaskTypeS4Get:
+			task.(*S4GetTask).storages = r.s4Storages
+		case TaskTypeS4Put:
+			task.(*S4PutTask).storages = r.s4Storages
+		case TaskTypeSign:
+			task.(*SignTask).config = r.config
+			task.(*SignTask).keyStore = r.ethKeyStore
+			task.(*SignTask).chainSet = r.chainSet
+		case TaskTypeETHCallBatch:
+			task.(*ETHCallBatchTask).chainSet = r.chainSet
 		default:
 		}
 	}
@@ -458,6 +487,9 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 		loggerFields = append(loggerFields, "resultHex", fmt.Sprintf("%x", v))
 	}
 	l.Tracew("Pipeline task completed", loggerFields...)
+	if len(runInfo.RejectedInputs) > 0 {
+		l.Debugw("Pipeline task rejected outlier inputs", "rejectedInputs", runInfo.RejectedInputs)
+	}
 
 	now := time.Now()
 
@@ -486,6 +518,9 @@ func logTaskRunToPrometheus(trr TaskRunResult, spec Spec) {
 		status = "completed"
 	}
 	PromPipelineTasksTotalFinished.WithLabelValues(fmt.Sprintf("%d", spec.JobID), spec.JobName, trr.Task.DotID(), string(trr.Task.Type()), status).Inc()
+	if len(trr.runInfo.RejectedInputs) > 0 {
+		PromPipelineTaskRejectedInputs.WithLabelValues(fmt.Sprintf("%d", spec.JobID), spec.JobName, trr.Task.DotID(), string(trr.Task.Type())).Add(float64(len(trr.runInfo.RejectedInputs)))
+	}
 }
 
 // ExecuteAndInsertFinishedRun executes a run in memory then inserts the finished run/task run records, returning the final result
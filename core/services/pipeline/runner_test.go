@@ -530,6 +530,33 @@ a->b2->c;`,
 	assert.Equal(t, mustDecimal(t, "10").String(), result.Value.(decimal.Decimal).String())
 }
 
+func Test_PipelineRunner_RejectedInputs(t *testing.T) {
+	cfg := configtest.NewTestGeneralConfig(t)
+	btORM := bridgesMocks.NewORM(t)
+	r, _ := newRunner(t, pgtest.NewSqlxDB(t), btORM, cfg)
+	lggr := logger.TestLogger(t)
+	_, trrs, err := r.ExecuteRun(testutils.Context(t), pipeline.Spec{
+		DotDagSource: `
+filter [type=madfilter values=<[ 100, 102, 98, 1000 ]>]
+mean [type=mean values="$(filter)"]
+filter->mean;`,
+	}, pipeline.NewVarsFrom(nil), lggr)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(trrs))
+
+	for _, trr := range trrs {
+		switch trr.Task.DotID() {
+		case "filter":
+			assert.Equal(t, []int{3}, trr.RejectedInputs())
+		case "mean":
+			assert.Empty(t, trr.RejectedInputs())
+		}
+	}
+	result, err := trrs.FinalResult(lggr).SingularResult()
+	require.NoError(t, err)
+	assert.Equal(t, mustDecimal(t, "100").String(), result.Value.(decimal.Decimal).String())
+}
+
 func Test_PipelineRunner_MultipleTerminatingOutputs(t *testing.T) {
 	cfg := configtest.NewTestGeneralConfig(t)
 	btORM := bridgesMocks.NewORM(t)
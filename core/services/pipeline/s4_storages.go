@@ -0,0 +1,65 @@
+package pipeline
+
+import (
+	"sync"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/s4"
+)
+
+// s4Storages holds the S4 storage of every running Functions job, keyed by DON ID.
+// Several jobs may serve the same DON, the storage registered last is used until
+// its job stops, after which the storage of the remaining jobs is used again.
+type s4Storages struct {
+	mu       sync.RWMutex
+	storages map[string][]*s4Registration
+}
+
+type s4Registration struct {
+	storage s4.Storage
+}
+
+func newS4Storages() *s4Storages {
+	return &s4Storages{storages: make(map[string][]*s4Registration)}
+}
+
+// register makes storage available for donID until the returned func is called.
+func (s *s4Storages) register(donID string, storage s4.Storage) (unregister func()) {
+	reg := &s4Registration{storage}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.storages[donID] = append(s.storages[donID], reg)
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			regs := s.storages[donID]
+			for i, r := range regs {
+				if r == reg {
+					regs = append(regs[:i:i], regs[i+1:]...)
+					break
+				}
+			}
+			if len(regs) == 0 {
+				delete(s.storages, donID)
+			} else {
+				s.storages[donID] = regs
+			}
+		})
+	}
+}
+
+// get returns the storage of donID, or nil if no job serving donID is running.
+func (s *s4Storages) get(donID string) s4.Storage {
+	if s == nil {
+		return nil
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	regs := s.storages[donID]
+	if len(regs) == 0 {
+		return nil
+	}
+	return regs[len(regs)-1].storage
+}
@@ -0,0 +1,36 @@
+package pipeline
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	s4mocks "github.com/smartcontractkit/chainlink/v2/core/services/s4/mocks"
+)
+
+func TestS4Storages(t *testing.T) {
+	t.Parallel()
+
+	storages := newS4Storages()
+	don1a, don1b, don2 := s4mocks.NewStorage(t), s4mocks.NewStorage(t), s4mocks.NewStorage(t)
+
+	unregister1a := storages.register("don1", don1a)
+	unregister1b := storages.register("don1", don1b)
+	unregister2 := storages.register("don2", don2)
+	assert.Same(t, don1b, storages.get("don1"))
+	assert.Same(t, don2, storages.get("don2"))
+	assert.Nil(t, storages.get("don3"))
+
+	// stopping one job of a DON keeps the storage of the other one available
+	unregister1b()
+	assert.Same(t, don1a, storages.get("don1"))
+	// and does not affect other DONs, even when called twice
+	unregister1b()
+	assert.Same(t, don1a, storages.get("don1"))
+	assert.Same(t, don2, storages.get("don2"))
+
+	unregister1a()
+	assert.Nil(t, storages.get("don1"))
+	unregister2()
+	assert.Nil(t, storages.get("don2"))
+}
@@ -0,0 +1,91 @@
+package pipeline_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestAbsTask_Happy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    interface{}
+		expected *decimal.Decimal
+	}{
+		{"string", "12345.67", mustDecimal(t, "12345.67")},
+		{"string, negative", "-12345.67", mustDecimal(t, "12345.67")},
+		{"string, zero", "0", mustDecimal(t, "0")},
+		{"int, negative", int(-2), mustDecimal(t, "2")},
+		{"int64, negative", int64(-2), mustDecimal(t, "2")},
+		{"uint64", uint64(2), mustDecimal(t, "2")},
+		{"float64, negative", float64(-1.5), mustDecimal(t, "1.5")},
+	}
+
+	for _, test := range tests {
+		assertOK := func(result pipeline.Result, runInfo pipeline.RunInfo) {
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			require.NoError(t, result.Error)
+			require.Equal(t, test.expected.String(), result.Value.(decimal.Decimal).String())
+		}
+		t.Run(test.name, func(t *testing.T) {
+			t.Run("without vars through job DAG", func(t *testing.T) {
+				vars := pipeline.NewVarsFrom(nil)
+				task := pipeline.AbsTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0)}
+				assertOK(task.Run(testutils.Context(t), logger.TestLogger(t), vars, []pipeline.Result{{Value: test.input}}))
+			})
+			t.Run("without vars through input param", func(t *testing.T) {
+				vars := pipeline.NewVarsFrom(nil)
+				task := pipeline.AbsTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0), Input: fmt.Sprintf("%v", test.input)}
+				assertOK(task.Run(testutils.Context(t), logger.TestLogger(t), vars, []pipeline.Result{}))
+			})
+			t.Run("with vars", func(t *testing.T) {
+				vars := pipeline.NewVarsFrom(map[string]interface{}{
+					"foo": map[string]interface{}{"bar": test.input},
+				})
+				task := pipeline.AbsTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0), Input: "$(foo.bar)"}
+				assertOK(task.Run(testutils.Context(t), logger.TestLogger(t), vars, []pipeline.Result{}))
+			})
+		})
+	}
+}
+
+func TestAbsTask_Unhappy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		input             string
+		inputs            []pipeline.Result
+		vars              pipeline.Vars
+		wantErrorCause    error
+		wantErrorContains string
+	}{
+		{"map as input from inputs", "", []pipeline.Result{{Value: map[string]interface{}{"chain": "link"}}}, pipeline.NewVarsFrom(nil), pipeline.ErrBadInput, "input"},
+		{"input as missing var", "$(foo)", nil, pipeline.NewVarsFrom(nil), pipeline.ErrKeypathNotFound, "input"},
+		{"errored inputs", "", []pipeline.Result{{Error: errors.New("uh oh")}}, pipeline.NewVarsFrom(nil), pipeline.ErrTooManyErrors, "task inputs"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.AbsTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0), Input: test.input}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), test.vars, test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			require.Equal(t, test.wantErrorCause, errors.Cause(result.Error))
+			if test.wantErrorContains != "" {
+				require.Contains(t, result.Error.Error(), test.wantErrorContains)
+			}
+		})
+	}
+}
@@ -0,0 +1,206 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm"
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// ETHCallBatchTask executes a list of read-only calls, given as [{"contract": ..., "data": ...}, ...],
+// either in a single JSON-RPC batch or, if a Multicall3 contract address is given, in a single
+// aggregate3 call. Batched calls are made at the latest head of the chain, so that their results
+// are consistent. Like ethcall with gasUnlimited, the calls are not gas limited.
+//
+// Return types:
+//
+//	[]interface{} with a map[string]interface{}{"result": []byte, "error": string} per call,
+//	where result is nil and error is set if the call failed
+type ETHCallBatchTask struct {
+	BaseTask   `mapstructure:",squash"`
+	Calls      string `json:"calls"`
+	From       string `json:"from"`
+	Multicall  string `json:"multicall"`
+	EVMChainID string `json:"evmChainID" mapstructure:"evmChainID"`
+
+	chainSet evm.ChainSet
+}
+
+var _ Task = (*ETHCallBatchTask)(nil)
+
+var multicall3ABI = evmtypes.MustGetABI(`[{"inputs":[{"components":[{"internalType":"address","name":"target","type":"address"},{"internalType":"bool","name":"allowFailure","type":"bool"},{"internalType":"bytes","name":"callData","type":"bytes"}],"internalType":"struct Multicall3.Call3[]","name":"calls","type":"tuple[]"}],"name":"aggregate3","outputs":[{"components":[{"internalType":"bool","name":"success","type":"bool"},{"internalType":"bytes","name":"returnData","type":"bytes"}],"internalType":"struct Multicall3.Result[]","name":"returnData","type":"tuple[]"}],"stateMutability":"payable","type":"function"}]`)
+
+type multicall3Call struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+type multicall3Result struct {
+	Success    bool
+	ReturnData []byte
+}
+
+func (t *ETHCallBatchTask) Type() TaskType {
+	return TaskTypeETHCallBatch
+}
+
+func (t *ETHCallBatchTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		calls          SliceParam
+		from           AddressParam
+		maybeMulticall StringParam
+		chainID        StringParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&calls, From(VarExpr(t.Calls, vars), JSONWithVarExprs(t.Calls, vars, false))), "calls"),
+		errors.Wrap(ResolveParam(&from, From(VarExpr(t.From, vars), NonemptyString(t.From), utils.ZeroAddress)), "from"),
+		errors.Wrap(ResolveParam(&maybeMulticall, From(VarExpr(t.Multicall, vars), NonemptyString(t.Multicall), "")), "multicall"),
+		errors.Wrap(ResolveParam(&chainID, From(VarExpr(t.EVMChainID, vars), NonemptyString(t.EVMChainID), "")), "evmChainID"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	} else if len(calls) == 0 {
+		return Result{Error: errors.Wrapf(ErrBadInput, "calls param must not be empty")}, runInfo
+	}
+
+	msgs := make([]ethereum.CallMsg, len(calls))
+	for i, call := range calls {
+		var (
+			callMap      MapParam
+			contractAddr AddressParam
+			data         BytesParam
+		)
+		err = callMap.UnmarshalPipelineParam(call)
+		if err == nil {
+			err = multierr.Combine(
+				errors.Wrap(ResolveParam(&contractAddr, From(callMap["contract"])), "contract"),
+				errors.Wrap(ResolveParam(&data, From(callMap["data"])), "data"),
+			)
+		}
+		if err != nil {
+			return Result{Error: errors.Wrapf(ErrBadInput, "calls: call %d: %v", i, err)}, runInfo
+		}
+		msgs[i] = ethereum.CallMsg{
+			To:   (*common.Address)(&contractAddr),
+			From: common.Address(from),
+			Data: []byte(data),
+		}
+	}
+
+	chain, err := getChainByString(t.chainSet, string(chainID))
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	var blockNumber *big.Int
+	if head := chain.HeadTracker().LatestChain(); head != nil {
+		blockNumber = big.NewInt(head.Number)
+	}
+	lggr = lggr.With("calls", len(msgs), "blockNumber", blockNumber, "multicall", string(maybeMulticall))
+
+	var results []interface{}
+	if maybeMulticall != "" {
+		var multicall AddressParam
+		if err = multicall.UnmarshalPipelineParam(string(maybeMulticall)); err != nil {
+			return Result{Error: errors.Wrap(err, "multicall")}, runInfo
+		}
+		results, err = t.multicall(ctx, chain.Client(), common.Address(multicall), common.Address(from), msgs, blockNumber)
+	} else {
+		results, err = t.batchCall(ctx, chain.Client(), msgs, blockNumber)
+	}
+	if err != nil {
+		lggr.Warnw("ETHCallBatchTask failed", "err", err)
+		return Result{Error: err}, retryableRunInfo()
+	}
+	return Result{Value: results}, runInfo
+}
+
+func (t *ETHCallBatchTask) batchCall(ctx context.Context, client evmclient.Client, msgs []ethereum.CallMsg, blockNumber *big.Int) ([]interface{}, error) {
+	block := "latest"
+	if blockNumber != nil {
+		block = hexutil.EncodeBig(blockNumber)
+	}
+	reqs := make([]rpc.BatchElem, len(msgs))
+	for i, msg := range msgs {
+		reqs[i] = rpc.BatchElem{
+			Method: "eth_call",
+			Args:   []interface{}{evmclient.CallArgs{From: msg.From, To: *msg.To, Data: msg.Data}, block},
+			Result: new(hexutil.Bytes),
+		}
+	}
+	if err := client.BatchCallContext(ctx, reqs); err != nil {
+		return nil, errors.Wrap(err, "batch eth_call failed")
+	}
+
+	results := make([]interface{}, len(reqs))
+	for i, req := range reqs {
+		if req.Error != nil {
+			results[i] = callBatchResult(nil, req.Error.Error())
+			continue
+		}
+		results[i] = callBatchResult([]byte(*req.Result.(*hexutil.Bytes)), nil)
+	}
+	return results, nil
+}
+
+func (t *ETHCallBatchTask) multicall(ctx context.Context, client evmclient.Client, multicall, from common.Address, msgs []ethereum.CallMsg, blockNumber *big.Int) ([]interface{}, error) {
+	calls := make([]multicall3Call, len(msgs))
+	for i, msg := range msgs {
+		calls[i] = multicall3Call{Target: *msg.To, AllowFailure: true, CallData: msg.Data}
+	}
+	data, err := multicall3ABI.Pack("aggregate3", calls)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to pack aggregate3 call")
+	}
+
+	resp, err := client.CallContract(ctx, ethereum.CallMsg{To: &multicall, From: from, Data: data}, blockNumber)
+	if err != nil {
+		return nil, errors.Wrap(err, "aggregate3 call failed")
+	}
+	unpacked, err := multicall3ABI.Unpack("aggregate3", resp)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unpack aggregate3 result")
+	}
+	var returnData []multicall3Result
+	if err = multicall3ABI.Methods["aggregate3"].Outputs.Copy(&returnData, unpacked); err != nil {
+		return nil, errors.Wrap(err, "failed to decode aggregate3 result")
+	} else if len(returnData) != len(msgs) {
+		return nil, errors.Errorf("aggregate3 returned %d results for %d calls", len(returnData), len(msgs))
+	}
+
+	results := make([]interface{}, len(returnData))
+	for i, r := range returnData {
+		if !r.Success {
+			callErr := "execution reverted"
+			if len(r.ReturnData) > 0 {
+				callErr = fmt.Sprintf("%s: %s", callErr, hexutil.Encode(r.ReturnData))
+			}
+			results[i] = callBatchResult(nil, callErr)
+			continue
+		}
+		results[i] = callBatchResult(r.ReturnData, nil)
+	}
+	return results, nil
+}
+
+func callBatchResult(data []byte, callErr interface{}) map[string]interface{} {
+	return map[string]interface{}{"result": data, "error": callErr}
+}
@@ -0,0 +1,140 @@
+package pipeline_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	htmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker/mocks"
+	evmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/mocks"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestETHCallBatchTask(t *testing.T) {
+	t.Parallel()
+
+	contract1 := common.HexToAddress("0x2ab9a2Dc53736b361b72d900CdF9F78F9406fbbb")
+	contract2 := common.HexToAddress("0x6E225058950f237371261C985Db6bDe26df2200E")
+	multicall := common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+	calls := `[{"contract": "0x2ab9a2Dc53736b361b72d900CdF9F78F9406fbbb", "data": "0x01"}, {"contract": $(contract2), "data": $(data2)}]`
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"contract2": contract2.Hex(),
+		"data2":     "0x0202",
+	})
+
+	newChainSet := func(t *testing.T, ethClient *evmclimocks.Client, head *evmtypes.Head) *evmmocks.ChainSet {
+		ht := htmocks.NewHeadTracker(t)
+		ht.On("LatestChain").Return(head)
+		chain := evmmocks.NewChain(t)
+		chain.On("Client").Return(ethClient)
+		chain.On("HeadTracker").Return(ht)
+		cc := evmmocks.NewChainSet(t)
+		cc.On("Default").Return(chain, nil)
+		return cc
+	}
+
+	t.Run("json-rpc batch at the latest head", func(t *testing.T) {
+		ethClient := evmclimocks.NewClient(t)
+		ethClient.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(reqs []rpc.BatchElem) bool {
+			return len(reqs) == 2 &&
+				reqs[0].Method == "eth_call" &&
+				assert.ObjectsAreEqual([]interface{}{evmclient.CallArgs{To: contract1, Data: hexutil.Bytes{1}}, "0x2a"}, reqs[0].Args) &&
+				assert.ObjectsAreEqual([]interface{}{evmclient.CallArgs{To: contract2, Data: hexutil.Bytes{2, 2}}, "0x2a"}, reqs[1].Args)
+		})).Return(nil).Run(func(args mock.Arguments) {
+			reqs := args.Get(1).([]rpc.BatchElem)
+			*reqs[0].Result.(*hexutil.Bytes) = hexutil.Bytes{0xab}
+			reqs[1].Error = errors.New("execution reverted")
+		})
+
+		task := pipeline.ETHCallBatchTask{BaseTask: pipeline.NewBaseTask(0, "ethcallbatch", nil, nil, 0), Calls: calls}
+		task.HelperSetDependencies(newChainSet(t, ethClient, &evmtypes.Head{Number: 42}))
+
+		result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+		require.NoError(t, result.Error)
+		assert.False(t, runInfo.IsRetryable)
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"result": []byte{0xab}, "error": nil},
+			map[string]interface{}{"result": []byte(nil), "error": "execution reverted"},
+		}, result.Value)
+	})
+
+	t.Run("json-rpc batch without a head", func(t *testing.T) {
+		ethClient := evmclimocks.NewClient(t)
+		ethClient.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(reqs []rpc.BatchElem) bool {
+			return len(reqs) == 2 && reqs[0].Args[1] == "latest" && reqs[1].Args[1] == "latest"
+		})).Return(errors.New("connection refused"))
+
+		task := pipeline.ETHCallBatchTask{BaseTask: pipeline.NewBaseTask(0, "ethcallbatch", nil, nil, 0), Calls: calls}
+		task.HelperSetDependencies(newChainSet(t, ethClient, nil))
+
+		result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+		require.EqualError(t, result.Error, "batch eth_call failed: connection refused")
+		assert.True(t, runInfo.IsRetryable)
+	})
+
+	t.Run("multicall3", func(t *testing.T) {
+		aggregate3 := evmtypes.MustGetABI(`[{"inputs":[{"components":[{"name":"target","type":"address"},{"name":"allowFailure","type":"bool"},{"name":"callData","type":"bytes"}],"name":"calls","type":"tuple[]"}],"name":"aggregate3","outputs":[{"components":[{"name":"success","type":"bool"},{"name":"returnData","type":"bytes"}],"name":"returnData","type":"tuple[]"}],"stateMutability":"payable","type":"function"}]`).Methods["aggregate3"]
+		type call3 struct {
+			Target       common.Address
+			AllowFailure bool
+			CallData     []byte
+		}
+		type result3 struct {
+			Success    bool
+			ReturnData []byte
+		}
+		packedCalls, err := aggregate3.Inputs.Pack([]call3{{contract1, true, []byte{1}}, {contract2, true, []byte{2, 2}}})
+		require.NoError(t, err)
+		packedResults, err := aggregate3.Outputs.Pack([]result3{{true, []byte{0xab}}, {false, []byte{0xde, 0xad}}})
+		require.NoError(t, err)
+
+		ethClient := evmclimocks.NewClient(t)
+		ethClient.On("CallContract", mock.Anything, ethereum.CallMsg{To: &multicall, Data: append(aggregate3.ID, packedCalls...)}, big.NewInt(42)).
+			Return(packedResults, nil)
+
+		task := pipeline.ETHCallBatchTask{BaseTask: pipeline.NewBaseTask(0, "ethcallbatch", nil, nil, 0), Calls: calls, Multicall: multicall.Hex()}
+		task.HelperSetDependencies(newChainSet(t, ethClient, &evmtypes.Head{Number: 42}))
+
+		result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+		require.NoError(t, result.Error)
+		assert.False(t, runInfo.IsRetryable)
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"result": []byte{0xab}, "error": nil},
+			map[string]interface{}{"result": []byte(nil), "error": "execution reverted: 0xdead"},
+		}, result.Value)
+	})
+
+	t.Run("bad input", func(t *testing.T) {
+		for _, test := range []struct {
+			name  string
+			calls string
+		}{
+			{"empty", `[]`},
+			{"missing contract", `[{"data": "0x01"}]`},
+			{"bad contract", `[{"contract": "0x1234", "data": "0x01"}]`},
+			{"not an object", `["0x01"]`},
+		} {
+			test := test
+			t.Run(test.name, func(t *testing.T) {
+				task := pipeline.ETHCallBatchTask{BaseTask: pipeline.NewBaseTask(0, "ethcallbatch", nil, nil, 0), Calls: test.calls}
+				task.HelperSetDependencies(evmmocks.NewChainSet(t))
+
+				result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+				require.Equal(t, pipeline.ErrBadInput, errors.Cause(result.Error))
+			})
+		}
+	})
+}
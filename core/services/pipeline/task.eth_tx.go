@@ -47,6 +47,8 @@ type ETHTxTask struct {
 
 type ETHKeyStore interface {
 	GetRoundRobinAddress(chainID *big.Int, addrs ...common.Address) (common.Address, error)
+	CheckEnabled(address common.Address, chainID *big.Int) error
+	SignHash(address common.Address, hash common.Hash) ([]byte, error)
 }
 
 var _ Task = (*ETHTxTask)(nil)
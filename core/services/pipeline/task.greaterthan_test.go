@@ -0,0 +1,116 @@
+package pipeline_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestGreaterThanTask_Happy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		left  interface{}
+		right string
+		want  bool
+	}{
+		{"string, gt 100", "1.23", "100", false},
+		{"string, gt negative", "1.23", "-5", true},
+		{"string, gt zero", "1.23", "0", true},
+		{"string, gt itself", "1.23", "1.23", false},
+		{"large string, gt large value", "10000000000000000001", "1000000000000000000", true},
+
+		{"int, true", int(2), "-5", true},
+		{"int, false", int(2), "100", false},
+
+		{"int64, true", int64(2), "-5", true},
+		{"int64, false", int64(2), "100", false},
+
+		{"uint64, true", uint64(2), "-5", true},
+		{"uint64, false", uint64(2), "100", false},
+
+		{"float64, true", float64(1.23), "-5", true},
+		{"float64, false", float64(1.23), "10", false},
+	}
+
+	for _, test := range tests {
+		assertOK := func(result pipeline.Result, runInfo pipeline.RunInfo) {
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			require.NoError(t, result.Error)
+			require.Equal(t, test.want, result.Value.(bool))
+		}
+		t.Run(test.name, func(t *testing.T) {
+			t.Run("without vars through job DAG", func(t *testing.T) {
+				vars := pipeline.NewVarsFrom(nil)
+				task := pipeline.GreaterThanTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0), Right: test.right}
+				assertOK(task.Run(testutils.Context(t), logger.TestLogger(t), vars, []pipeline.Result{{Value: test.left}}))
+			})
+			t.Run("without vars through input param", func(t *testing.T) {
+				vars := pipeline.NewVarsFrom(nil)
+				task := pipeline.GreaterThanTask{
+					BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0),
+					Left:     fmt.Sprintf("%v", test.left),
+					Right:    test.right,
+				}
+				assertOK(task.Run(testutils.Context(t), logger.TestLogger(t), vars, []pipeline.Result{}))
+			})
+			t.Run("with vars", func(t *testing.T) {
+				vars := pipeline.NewVarsFrom(map[string]interface{}{
+					"foo":   map[string]interface{}{"bar": test.left},
+					"chain": map[string]interface{}{"link": test.right},
+				})
+				task := pipeline.GreaterThanTask{
+					BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0),
+					Left:     "$(foo.bar)",
+					Right:    "$(chain.link)",
+				}
+				assertOK(task.Run(testutils.Context(t), logger.TestLogger(t), vars, []pipeline.Result{}))
+			})
+		})
+	}
+}
+
+func TestGreaterThanTask_Unhappy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		left              string
+		right             string
+		inputs            []pipeline.Result
+		vars              pipeline.Vars
+		wantErrorCause    error
+		wantErrorContains string
+	}{
+		{"map as input from inputs", "", "100", []pipeline.Result{{Value: map[string]interface{}{"chain": "link"}}}, pipeline.NewVarsFrom(nil), pipeline.ErrBadInput, "left"},
+		{"slice as input from var", "$(foo)", "100", nil, pipeline.NewVarsFrom(map[string]interface{}{"foo": []interface{}{"chain", "link"}}), pipeline.ErrBadInput, "left"},
+		{"input as missing var", "$(foo)", "100", nil, pipeline.NewVarsFrom(nil), pipeline.ErrKeypathNotFound, "left"},
+		{"limit as missing var", "", "$(foo)", []pipeline.Result{{Value: "123"}}, pipeline.NewVarsFrom(nil), pipeline.ErrKeypathNotFound, "right"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.GreaterThanTask{
+				BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Left:     test.left,
+				Right:    test.right,
+			}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), test.vars, test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			require.Equal(t, test.wantErrorCause, errors.Cause(result.Error))
+			if test.wantErrorContains != "" {
+				require.Contains(t, result.Error.Error(), test.wantErrorContains)
+			}
+		})
+	}
+}
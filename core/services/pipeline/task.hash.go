@@ -0,0 +1,66 @@
+package pipeline
+
+import (
+	"context"
+	"crypto/sha256"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+	"golang.org/x/crypto/ripemd160" //nolint:gosec // offered for compatibility with existing digests, not for new security uses
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// HashTask computes the digest of its input, which is hex decoded if it is a 0x prefixed hex string,
+// and hashed as is otherwise.
+//
+// Algorithm is one of keccak256 (default), sha256 or ripemd160.
+//
+// Return types:
+//
+//	[]byte
+type HashTask struct {
+	BaseTask  `mapstructure:",squash"`
+	Input     string `json:"input"`
+	Algorithm string `json:"algorithm"`
+}
+
+var _ Task = (*HashTask)(nil)
+
+func (t *HashTask) Type() TaskType {
+	return TaskTypeHash
+}
+
+func (t *HashTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		input     BytesParam
+		algorithm StringParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&input, From(VarExpr(t.Input, vars), NonemptyString(t.Input), Input(inputs, 0))), "input"),
+		errors.Wrap(ResolveParam(&algorithm, From(NonemptyString(t.Algorithm), "keccak256")), "algorithm"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	switch algorithm {
+	case "keccak256":
+		return Result{Value: crypto.Keccak256(input)}, runInfo
+	case "sha256":
+		digest := sha256.Sum256(input)
+		return Result{Value: digest[:]}, runInfo
+	case "ripemd160":
+		hasher := ripemd160.New()
+		hasher.Write(input)
+		return Result{Value: hasher.Sum(nil)}, runInfo
+	default:
+		return Result{Error: errors.Wrapf(ErrBadInput, "algorithm: unsupported hash algorithm %q", algorithm)}, runInfo
+	}
+}
@@ -0,0 +1,72 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestHashTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		input     interface{}
+		algorithm string
+		result    string
+		wantError error
+	}{
+		// success
+		{"keccak256 (default), string", "hello", "", "0x1c8aff950685c2ed4bc3174f3472287b56d9517b9c948127319a09a7a36deac8", nil},
+		{"keccak256, bytes", []byte("hello"), "keccak256", "0x1c8aff950685c2ed4bc3174f3472287b56d9517b9c948127319a09a7a36deac8", nil},
+		{"keccak256, hex", "0x68656c6c6f", "keccak256", "0x1c8aff950685c2ed4bc3174f3472287b56d9517b9c948127319a09a7a36deac8", nil},
+		{"keccak256, empty", "", "keccak256", "0xc5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470", nil},
+		{"sha256, string", "hello", "sha256", "0x2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", nil},
+		{"sha256, hex", "0x68656c6c6f", "sha256", "0x2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", nil},
+		{"ripemd160, string", "hello", "ripemd160", "0x108f07b8382412612c048d07d13f814118445acd", nil},
+
+		// failure
+		{"unknown algorithm", "hello", "md5", "", pipeline.ErrBadInput},
+		{"int", 234, "", "", pipeline.ErrBadInput},
+		{"map", map[string]interface{}{"chain": "link"}, "", "", pipeline.ErrBadInput},
+	}
+
+	for _, test := range tests {
+		assertOK := func(result pipeline.Result, runInfo pipeline.RunInfo) {
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.wantError == nil {
+				require.NoError(t, result.Error)
+				require.Equal(t, test.result, hexutil.Encode(result.Value.([]byte)))
+			} else {
+				require.Equal(t, test.wantError, errors.Cause(result.Error))
+				require.Nil(t, result.Value)
+			}
+		}
+		t.Run(test.name, func(t *testing.T) {
+			t.Run("without vars through job DAG", func(t *testing.T) {
+				vars := pipeline.NewVarsFrom(nil)
+				task := pipeline.HashTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0), Algorithm: test.algorithm}
+				assertOK(task.Run(testutils.Context(t), logger.TestLogger(t), vars, []pipeline.Result{{Value: test.input}}))
+			})
+			t.Run("with vars", func(t *testing.T) {
+				vars := pipeline.NewVarsFrom(map[string]interface{}{
+					"foo": map[string]interface{}{"bar": test.input},
+				})
+				task := pipeline.HashTask{
+					BaseTask:  pipeline.NewBaseTask(0, "task", nil, nil, 0),
+					Input:     "$(foo.bar)",
+					Algorithm: test.algorithm,
+				}
+				assertOK(task.Run(testutils.Context(t), logger.TestLogger(t), vars, []pipeline.Result{}))
+			})
+		})
+	}
+}
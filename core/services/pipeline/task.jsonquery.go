@@ -0,0 +1,183 @@
+package pipeline
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/jmespath/go-jmespath"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// JSONQueryTask evaluates a JMESPath (https://jmespath.org) query over its JSON input, which supports
+// filters, projections, array slicing and functions such as max_by, e.g.
+//
+//	quotes[?volume > `1000`] | max_by(@, &timestamp).price
+//
+// JMESPath compares numbers as float64, so queries are evaluated over float64 approximations of
+// the input numbers. Numbers in the result are restored to the exact input value and returned
+// like jsonparse does, as int64, uint64, *big.Int or float64. Distinct input numbers sharing the
+// same float64 approximation can not be told apart and are returned as float64.
+//
+// Return types:
+//
+//	int64
+//	uint64
+//	*big.Int
+//	float64
+//	string
+//	bool
+//	map[string]interface{}
+//	[]interface{}
+//	nil
+type JSONQueryTask struct {
+	BaseTask `mapstructure:",squash"`
+	Query    string `json:"query"`
+	Data     string `json:"data"`
+	// Lax when disabled will return an error if the query evaluates to null
+	// Lax when enabled will return nil with no error if the query evaluates to null
+	Lax string
+}
+
+var _ Task = (*JSONQueryTask)(nil)
+
+func (t *JSONQueryTask) Type() TaskType {
+	return TaskTypeJSONQuery
+}
+
+func (t *JSONQueryTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		query StringParam
+		data  BytesParam
+		lax   BoolParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&query, From(VarExpr(t.Query, vars), NonemptyString(t.Query))), "query"),
+		errors.Wrap(ResolveParam(&data, From(VarExpr(t.Data, vars), Input(inputs, 0))), "data"),
+		errors.Wrap(ResolveParam(&lax, From(NonemptyString(t.Lax), false)), "lax"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	compiled, err := jmespath.Compile(string(query))
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "query: %v", err)}, runInfo
+	}
+
+	var decoded interface{}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	if err = d.Decode(&decoded); err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	numbers := newJSONQueryNumbers()
+	approximated, err := numbers.approximate(decoded)
+	if err != nil {
+		return Result{Error: multierr.Combine(ErrBadInput, err)}, runInfo
+	}
+
+	value, err := compiled.Search(approximated)
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "could not evaluate query %q: %v", query, err)}, runInfo
+	}
+	if value == nil && !bool(lax) {
+		return Result{Error: errors.Wrapf(ErrKeypathNotFound, "query %q evaluated to null in %s", query, data)}, runInfo
+	}
+
+	value, err = numbers.restore(value)
+	if err != nil {
+		return Result{Error: multierr.Combine(ErrBadInput, err)}, runInfo
+	}
+	return Result{Value: value}, runInfo
+}
+
+// jsonQueryNumbers maps the float64 approximations handed to JMESPath back to the exact numbers
+// they were decoded from.
+type jsonQueryNumbers struct {
+	exact     map[float64]json.Number
+	ambiguous map[float64]bool
+}
+
+func newJSONQueryNumbers() *jsonQueryNumbers {
+	return &jsonQueryNumbers{exact: map[float64]json.Number{}, ambiguous: map[float64]bool{}}
+}
+
+// approximate returns a copy of val with every json.Number replaced by its float64 approximation.
+func (n *jsonQueryNumbers) approximate(val interface{}) (interface{}, error) {
+	switch v := val.(type) {
+	case json.Number:
+		f, err := v.Float64()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse json.Number %s", v)
+		}
+		if prev, ok := n.exact[f]; ok && prev != v {
+			n.ambiguous[f] = true
+		}
+		n.exact[f] = v
+		return f, nil
+	case []interface{}:
+		s := make([]interface{}, len(v))
+		for i, vv := range v {
+			ival, err := n.approximate(vv)
+			if err != nil {
+				return nil, err
+			}
+			s[i] = ival
+		}
+		return s, nil
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, vv := range v {
+			ival, err := n.approximate(vv)
+			if err != nil {
+				return nil, err
+			}
+			m[k] = ival
+		}
+		return m, nil
+	}
+	return val, nil
+}
+
+// restore returns a copy of val with every float64 replaced by the exact number it approximates,
+// unless that is ambiguous.
+func (n *jsonQueryNumbers) restore(val interface{}) (interface{}, error) {
+	switch v := val.(type) {
+	case float64:
+		if exact, ok := n.exact[v]; ok && !n.ambiguous[v] {
+			return getJsonNumberValue(exact)
+		}
+		return v, nil
+	case []interface{}:
+		s := make([]interface{}, len(v))
+		for i, vv := range v {
+			ival, err := n.restore(vv)
+			if err != nil {
+				return nil, err
+			}
+			s[i] = ival
+		}
+		return s, nil
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, vv := range v {
+			ival, err := n.restore(vv)
+			if err != nil {
+				return nil, err
+			}
+			m[k] = ival
+		}
+		return m, nil
+	}
+	return val, nil
+}
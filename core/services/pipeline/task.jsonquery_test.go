@@ -0,0 +1,246 @@
+package pipeline_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestJSONQueryTask(t *testing.T) {
+	t.Parallel()
+
+	const quotes = `{"quotes":[` +
+		`{"exchange":"a","price":100.5,"volume":2000,"timestamp":3},` +
+		`{"exchange":"b","price":101,"volume":500,"timestamp":5},` +
+		`{"exchange":"c","price":99.5,"volume":3000,"timestamp":4}]}`
+	maxUint256, ok := new(big.Int).SetString("115792089237316195423570985008687907853269984665640564039457584007913129639935", 10)
+	require.True(t, ok)
+
+	tests := []struct {
+		name              string
+		data              string
+		query             string
+		lax               string
+		vars              pipeline.Vars
+		inputs            []pipeline.Result
+		wantData          interface{}
+		wantErrorCause    error
+		wantErrorContains string
+	}{
+		{
+			"field",
+			"",
+			"quotes[0].price",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: quotes}},
+			100.5,
+			nil,
+			"",
+		},
+		{
+			"projection",
+			"",
+			"quotes[*].price",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: quotes}},
+			[]interface{}{100.5, int64(101), 99.5},
+			nil,
+			"",
+		},
+		{
+			"filter",
+			"",
+			"quotes[?volume > `1000`].exchange",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: quotes}},
+			[]interface{}{"a", "c"},
+			nil,
+			"",
+		},
+		{
+			"slice",
+			"",
+			"quotes[::-1].exchange",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: quotes}},
+			[]interface{}{"c", "b", "a"},
+			nil,
+			"",
+		},
+		{
+			"pipe and max_by",
+			"",
+			"quotes[?volume > `1000`] | max_by(@, &timestamp).price",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: quotes}},
+			99.5,
+			nil,
+			"",
+		},
+		{
+			"multiselect hash",
+			"",
+			"{best: max_by(quotes, &price).exchange, volume: sum(quotes[*].volume)}",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: quotes}},
+			map[string]interface{}{"best": "b", "volume": float64(5500)},
+			nil,
+			"",
+		},
+		{
+			"data and query from vars",
+			"$(foo.bar)",
+			"$(chain.link)",
+			"",
+			pipeline.NewVarsFrom(map[string]interface{}{
+				"foo":   map[string]interface{}{"bar": quotes},
+				"chain": map[string]interface{}{"link": "length(quotes)"},
+			}),
+			[]pipeline.Result{},
+			int64(3),
+			nil,
+			"",
+		},
+		{
+			"uint256",
+			"",
+			"max_by(balances, &amount).amount",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"balances":[` +
+				`{"amount":115792089237316195423570985008687907853269984665640564039457584007913129639935},` +
+				`{"amount":18446744073709551615},` +
+				`{"amount":1}]}`}},
+			maxUint256,
+			nil,
+			"",
+		},
+		{
+			"uint64 filter",
+			"",
+			"balances[?amount > `1`].amount",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"balances":[{"amount":18446744073709551615},{"amount":1}]}`}},
+			[]interface{}{uint64(18446744073709551615)},
+			nil,
+			"",
+		},
+		{
+			"indistinguishable numbers",
+			"",
+			"amounts[0]",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"amounts":[9007199254740993,9007199254740992]}`}},
+			float64(9007199254740992),
+			nil,
+			"",
+		},
+		{
+			"null result",
+			"",
+			"missing",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: quotes}},
+			nil,
+			pipeline.ErrKeypathNotFound,
+			"evaluated to null",
+		},
+		{
+			"null result (lax)",
+			"",
+			"missing",
+			"true",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: quotes}},
+			nil,
+			nil,
+			"",
+		},
+		{
+			"missing query",
+			"",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: quotes}},
+			nil,
+			pipeline.ErrParameterEmpty,
+			"query",
+		},
+		{
+			"invalid query",
+			"",
+			"quotes[?",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: quotes}},
+			nil,
+			pipeline.ErrBadInput,
+			"query",
+		},
+		{
+			"invalid function argument",
+			"",
+			"abs(quotes[0].exchange)",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: quotes}},
+			nil,
+			pipeline.ErrBadInput,
+			"could not evaluate query",
+		},
+		{
+			"errored input",
+			"",
+			"quotes",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Error: errors.New("uh oh")}},
+			nil,
+			pipeline.ErrTooManyErrors,
+			"task inputs",
+		},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.JSONQueryTask{
+				BaseTask: pipeline.NewBaseTask(0, "json", nil, nil, 0),
+				Query:    test.query,
+				Data:     test.data,
+				Lax:      test.lax,
+			}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), test.vars, test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+
+			if test.wantErrorCause != nil {
+				require.Equal(t, test.wantErrorCause, errors.Cause(result.Error))
+				if test.wantErrorContains != "" {
+					require.Contains(t, result.Error.Error(), test.wantErrorContains)
+				}
+				require.Nil(t, result.Value)
+			} else {
+				require.NoError(t, result.Error)
+				require.Equal(t, test.wantData, result.Value)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package pipeline
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// MADFilterTask drops the values that deviate from their median by more than threshold times the
+// median absolute deviation (MAD), and returns the remaining values in their input order, to be
+// aggregated by e.g. a mean or median task. The indices of the dropped values are returned by
+// TaskRunResult.RejectedInputs.
+//
+// Values within tolerance of the median are always kept. When more than half of the values are equal the
+// MAD is 0, and the mean absolute deviation from the median is used instead, so that gross outliers are
+// still dropped without dropping every value differing from the median.
+//
+// Return types:
+//
+//	[]interface{} containing decimal.Decimal values
+type MADFilterTask struct {
+	BaseTask      `mapstructure:",squash"`
+	Values        string `json:"values"`
+	AllowedFaults string `json:"allowedFaults"`
+	Threshold     string `json:"threshold"`
+	Tolerance     string `json:"tolerance"`
+}
+
+var _ Task = (*MADFilterTask)(nil)
+
+func (t *MADFilterTask) Type() TaskType {
+	return TaskTypeMADFilter
+}
+
+func (t *MADFilterTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	var (
+		maybeAllowedFaults MaybeUint64Param
+		threshold          DecimalParam
+		tolerance          DecimalParam
+		valuesAndErrs      SliceParam
+		allowedFaults      int
+		faults             int
+	)
+	err := multierr.Combine(
+		errors.Wrap(ResolveParam(&maybeAllowedFaults, From(t.AllowedFaults)), "allowedFaults"),
+		errors.Wrap(ResolveParam(&threshold, From(VarExpr(t.Threshold, vars), NonemptyString(t.Threshold), "3")), "threshold"),
+		errors.Wrap(ResolveParam(&tolerance, From(VarExpr(t.Tolerance, vars), NonemptyString(t.Tolerance), "0")), "tolerance"),
+		errors.Wrap(ResolveParam(&valuesAndErrs, From(VarExpr(t.Values, vars), JSONWithVarExprs(t.Values, vars, true), Inputs(inputs))), "values"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	if threshold.Decimal().IsNegative() {
+		return Result{Error: errors.Wrapf(ErrBadInput, "threshold: must not be negative, got %s", threshold.Decimal())}, runInfo
+	}
+	if tolerance.Decimal().IsNegative() {
+		return Result{Error: errors.Wrapf(ErrBadInput, "tolerance: must not be negative, got %s", tolerance.Decimal())}, runInfo
+	}
+
+	if allowed, isSet := maybeAllowedFaults.Uint64(); isSet {
+		allowedFaults = int(allowed)
+	} else {
+		allowedFaults = len(valuesAndErrs) - 1
+	}
+
+	values, faults := valuesAndErrs.FilterErrors()
+	if faults > allowedFaults {
+		return Result{Error: errors.Wrapf(ErrTooManyErrors, "Number of faulty inputs %v to madfilter task > number allowed faults %v", faults, allowedFaults)}, runInfo
+	} else if len(values) == 0 {
+		return Result{Error: errors.Wrap(ErrWrongInputCardinality, "values")}, runInfo
+	}
+
+	decimalValues, indices, err := decimalValuesWithIndices(valuesAndErrs)
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "values: %v", err)}, runInfo
+	}
+
+	median := medianOf(decimalValues)
+	deviations := make([]decimal.Decimal, len(decimalValues))
+	for i, value := range decimalValues {
+		deviations[i] = value.Sub(median).Abs()
+	}
+	scale := medianOf(deviations)
+	if scale.IsZero() {
+		scale = decimal.Avg(deviations[0], deviations[1:]...)
+	}
+	limit := decimal.Max(scale.Mul(threshold.Decimal()), tolerance.Decimal())
+
+	var kept []interface{}
+	for i, value := range decimalValues {
+		if deviations[i].GreaterThan(limit) {
+			runInfo.RejectedInputs = append(runInfo.RejectedInputs, indices[i])
+			continue
+		}
+		kept = append(kept, value)
+	}
+	return Result{Value: kept}, runInfo
+}
+
+// medianOf returns the median of a non-empty slice of values, without modifying it.
+func medianOf(values []decimal.Decimal) decimal.Decimal {
+	sorted := make([]decimal.Decimal, len(values))
+	copy(sorted, values)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].LessThan(sorted[j])
+	})
+	k := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[k]
+	}
+	return sorted[k].Add(sorted[k-1]).Div(decimal.NewFromInt(2))
+}
@@ -0,0 +1,236 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestMADFilterTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		inputs        []pipeline.Result
+		allowedFaults string
+		threshold     string
+		tolerance     string
+		want          []string
+		wantError     error
+		wantRejected  []int
+	}{
+		{
+			"default threshold",
+			[]pipeline.Result{{Value: "100"}, {Value: "101"}, {Value: "99"}, {Value: "100"}, {Value: "150"}},
+			"",
+			"",
+			"",
+			[]string{"100", "101", "99", "100"},
+			nil,
+			[]int{4},
+		},
+		{
+			"custom threshold",
+			[]pipeline.Result{{Value: "100"}, {Value: "101"}, {Value: "99"}, {Value: "100"}, {Value: "150"}},
+			"",
+			"0.5",
+			"",
+			[]string{"100", "100"},
+			nil,
+			[]int{1, 2, 4},
+		},
+		{
+			"no outliers",
+			[]pipeline.Result{{Value: "1"}, {Value: "2"}, {Value: "3"}, {Value: "4"}},
+			"",
+			"",
+			"",
+			[]string{"1", "2", "3", "4"},
+			nil,
+			nil,
+		},
+		{
+			"zero MAD",
+			[]pipeline.Result{{Value: "1"}, {Value: "1"}, {Value: "1"}, {Value: "1"}, {Value: "1000"}},
+			"",
+			"",
+			"",
+			[]string{"1", "1", "1", "1"},
+			nil,
+			[]int{4},
+		},
+		{
+			"zero MAD, close value",
+			[]pipeline.Result{{Value: "5"}, {Value: "5"}, {Value: "5"}, {Value: "5.1"}, {Value: "5.1"}},
+			"",
+			"",
+			"",
+			[]string{"5", "5", "5", "5.1", "5.1"},
+			nil,
+			nil,
+		},
+		{
+			"all equal",
+			[]pipeline.Result{{Value: "5"}, {Value: "5"}, {Value: "5"}},
+			"",
+			"",
+			"",
+			[]string{"5", "5", "5"},
+			nil,
+			nil,
+		},
+		{
+			"zero MAD with tolerance",
+			[]pipeline.Result{{Value: "5"}, {Value: "5"}, {Value: "5"}, {Value: "5.1"}, {Value: "5.01"}},
+			"",
+			"",
+			"0.05",
+			[]string{"5", "5", "5", "5.01"},
+			nil,
+			[]int{3},
+		},
+		{
+			"tolerance above the MAD limit",
+			[]pipeline.Result{{Value: "100"}, {Value: "101"}, {Value: "99"}, {Value: "100"}, {Value: "150"}},
+			"",
+			"0.5",
+			"2",
+			[]string{"100", "101", "99", "100"},
+			nil,
+			[]int{4},
+		},
+		{
+			"one input",
+			[]pipeline.Result{{Value: "1"}},
+			"0",
+			"",
+			"",
+			[]string{"1"},
+			nil,
+			nil,
+		},
+		{
+			"zero inputs",
+			[]pipeline.Result{},
+			"0",
+			"",
+			"",
+			nil,
+			pipeline.ErrWrongInputCardinality,
+			nil,
+		},
+		{
+			"fewer errors than threshold",
+			[]pipeline.Result{{Error: errors.New("")}, {Value: "10"}, {Value: "10"}, {Value: "11"}, {Value: "1000"}},
+			"1",
+			"",
+			"",
+			[]string{"10", "10", "11"},
+			nil,
+			[]int{4},
+		},
+		{
+			"more errors than threshold",
+			[]pipeline.Result{{Error: errors.New("")}, {Error: errors.New("")}, {Value: "3"}, {Value: "4"}},
+			"1",
+			"",
+			"",
+			nil,
+			pipeline.ErrTooManyErrors,
+			nil,
+		},
+		{
+			"negative threshold",
+			[]pipeline.Result{{Value: "1"}},
+			"",
+			"-1",
+			"",
+			nil,
+			pipeline.ErrBadInput,
+			nil,
+		},
+		{
+			"negative tolerance",
+			[]pipeline.Result{{Value: "1"}},
+			"",
+			"",
+			"-1",
+			nil,
+			pipeline.ErrBadInput,
+			nil,
+		},
+	}
+
+	for _, test := range tests {
+		assertResult := func(output pipeline.Result, runInfo pipeline.RunInfo) {
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.wantError != nil {
+				require.Equal(t, test.wantError, errors.Cause(output.Error))
+				require.Nil(t, output.Value)
+			} else {
+				require.NoError(t, output.Error)
+				var got []string
+				for _, value := range output.Value.([]interface{}) {
+					got = append(got, value.(decimal.Decimal).String())
+				}
+				require.Equal(t, test.want, got)
+			}
+			assert.Equal(t, test.wantRejected, runInfo.RejectedInputs)
+		}
+		t.Run(test.name, func(t *testing.T) {
+			t.Run("without vars", func(t *testing.T) {
+				task := pipeline.MADFilterTask{
+					BaseTask:      pipeline.NewBaseTask(0, "task", nil, nil, 0),
+					AllowedFaults: test.allowedFaults,
+					Threshold:     test.threshold,
+					Tolerance:     test.tolerance,
+				}
+				assertResult(task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), test.inputs))
+			})
+			t.Run("with vars", func(t *testing.T) {
+				var inputs []interface{}
+				for _, input := range test.inputs {
+					if input.Error != nil {
+						inputs = append(inputs, input.Error)
+					} else {
+						inputs = append(inputs, input.Value)
+					}
+				}
+				vars := pipeline.NewVarsFrom(map[string]interface{}{
+					"foo": map[string]interface{}{"bar": inputs},
+				})
+				task := pipeline.MADFilterTask{
+					BaseTask:      pipeline.NewBaseTask(0, "task", nil, nil, 0),
+					Values:        "$(foo.bar)",
+					AllowedFaults: test.allowedFaults,
+					Threshold:     test.threshold,
+					Tolerance:     test.tolerance,
+				}
+				assertResult(task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil))
+			})
+		})
+	}
+}
+
+func TestMADFilterTask_ThenMean(t *testing.T) {
+	t.Parallel()
+
+	filter := pipeline.MADFilterTask{BaseTask: pipeline.NewBaseTask(0, "filter", nil, nil, 0)}
+	filtered, runInfo := filter.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil),
+		[]pipeline.Result{{Value: "100"}, {Value: "102"}, {Value: "98"}, {Value: "1000"}})
+	require.NoError(t, filtered.Error)
+	assert.Equal(t, []int{3}, runInfo.RejectedInputs)
+
+	mean := pipeline.MeanTask{BaseTask: pipeline.NewBaseTask(1, "mean", nil, nil, 0), Values: "$(filter)"}
+	result, _ := mean.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(map[string]interface{}{"filter": filtered.Value}), nil)
+	require.NoError(t, result.Error)
+	assert.Equal(t, "100", result.Value.(decimal.Decimal).String())
+}
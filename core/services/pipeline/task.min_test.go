@@ -0,0 +1,118 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestMinTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		inputs        []pipeline.Result
+		allowedFaults string
+		want          pipeline.Result
+	}{
+		{
+			"happy",
+			[]pipeline.Result{{Value: mustDecimal(t, "2")}, {Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "3")}},
+			"1",
+			pipeline.Result{Value: mustDecimal(t, "1")},
+		},
+		{
+			"happy (one input)",
+			[]pipeline.Result{{Value: mustDecimal(t, "1")}},
+			"0",
+			pipeline.Result{Value: mustDecimal(t, "1")},
+		},
+		{
+			"happy (negative and fractional values)",
+			[]pipeline.Result{{Value: mustDecimal(t, "-4")}, {Value: mustDecimal(t, "3")}, {Value: mustDecimal(t, "2.5")}},
+			"0",
+			pipeline.Result{Value: mustDecimal(t, "-4")},
+		},
+		{
+			"zero inputs",
+			[]pipeline.Result{},
+			"0",
+			pipeline.Result{Error: pipeline.ErrWrongInputCardinality},
+		},
+		{
+			"fewer errors than threshold",
+			[]pipeline.Result{{Error: errors.New("")}, {Value: mustDecimal(t, "2")}, {Value: mustDecimal(t, "3")}, {Value: mustDecimal(t, "4")}},
+			"2",
+			pipeline.Result{Value: mustDecimal(t, "2")},
+		},
+		{
+			"more errors than threshold",
+			[]pipeline.Result{{Error: errors.New("")}, {Error: errors.New("")}, {Error: errors.New("")}, {Value: mustDecimal(t, "4")}},
+			"2",
+			pipeline.Result{Error: pipeline.ErrTooManyErrors},
+		},
+		{
+			"(unspecified AllowedFaults) fewer errors than threshold",
+			[]pipeline.Result{{Error: errors.New("")}, {Error: errors.New("")}, {Value: mustDecimal(t, "3")}, {Value: mustDecimal(t, "4")}},
+			"",
+			pipeline.Result{Value: mustDecimal(t, "3")},
+		},
+		{
+			"(unspecified AllowedFaults) more errors than threshold",
+			[]pipeline.Result{{Error: errors.New("")}, {Error: errors.New("")}, {Error: errors.New("")}},
+			"",
+			pipeline.Result{Error: pipeline.ErrTooManyErrors},
+		},
+	}
+
+	for _, test := range tests {
+		assertResult := func(t *testing.T, output pipeline.Result, runInfo pipeline.RunInfo) {
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if output.Error != nil {
+				require.Equal(t, test.want.Error, errors.Cause(output.Error))
+				require.Nil(t, output.Value)
+			} else {
+				require.Equal(t, test.want.Value.(*decimal.Decimal).String(), output.Value.(decimal.Decimal).String())
+				require.NoError(t, output.Error)
+			}
+		}
+		t.Run(test.name, func(t *testing.T) {
+			t.Run("without vars", func(t *testing.T) {
+				task := pipeline.MinTask{
+					BaseTask:      pipeline.NewBaseTask(0, "task", nil, nil, 0),
+					AllowedFaults: test.allowedFaults,
+				}
+				output, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), test.inputs)
+				assertResult(t, output, runInfo)
+			})
+			t.Run("with vars", func(t *testing.T) {
+				var inputs []interface{}
+				for _, input := range test.inputs {
+					if input.Error != nil {
+						inputs = append(inputs, input.Error)
+					} else {
+						inputs = append(inputs, input.Value)
+					}
+				}
+				vars := pipeline.NewVarsFrom(map[string]interface{}{
+					"foo": map[string]interface{}{"bar": inputs},
+				})
+				task := pipeline.MinTask{
+					BaseTask:      pipeline.NewBaseTask(0, "task", nil, nil, 0),
+					Values:        "$(foo.bar)",
+					AllowedFaults: test.allowedFaults,
+				}
+				output, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+				assertResult(t, output, runInfo)
+			})
+		})
+	}
+}
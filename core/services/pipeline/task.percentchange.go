@@ -0,0 +1,64 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// PercentChangeTask computes the change from base to input in percent of base, i.e. (input - base) / |base| * 100,
+// which is positive when input is greater than base.
+//
+// Return types:
+//
+//	*decimal.Decimal
+type PercentChangeTask struct {
+	BaseTask  `mapstructure:",squash"`
+	Input     string `json:"input"`
+	BaseValue string `json:"base" mapstructure:"base"`
+	Precision string `json:"precision"`
+}
+
+var _ Task = (*PercentChangeTask)(nil)
+
+func (t *PercentChangeTask) Type() TaskType {
+	return TaskTypePercentChange
+}
+
+func (t *PercentChangeTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		a              DecimalParam
+		b              DecimalParam
+		maybePrecision MaybeInt32Param
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&a, From(VarExpr(t.Input, vars), NonemptyString(t.Input), Input(inputs, 0))), "input"),
+		errors.Wrap(ResolveParam(&b, From(VarExpr(t.BaseValue, vars), NonemptyString(t.BaseValue))), "base"),
+		errors.Wrap(ResolveParam(&maybePrecision, From(VarExpr(t.Precision, vars), t.Precision)), "precision"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	base := b.Decimal()
+	if base.IsZero() {
+		return Result{Error: ErrDivideByZero}, runInfo
+	}
+
+	change := a.Decimal().Sub(base).Mul(decimal.NewFromInt(100))
+	if precision, isSet := maybePrecision.Int32(); isSet {
+		return Result{Value: change.DivRound(base.Abs(), precision)}, runInfo
+	}
+	// Note that decimal library defaults to rounding to 16 precision
+	// https://github.com/shopspring/decimal/blob/2568a29459476f824f35433dfbef158d6ad8618c/decimal.go#L44
+	return Result{Value: change.Div(base.Abs())}, runInfo
+}
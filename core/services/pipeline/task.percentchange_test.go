@@ -0,0 +1,131 @@
+package pipeline_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestPercentChangeTask_Happy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		input     interface{}
+		base      string
+		precision string
+		expected  *decimal.Decimal
+	}{
+		{"increase", "110", "100", "", mustDecimal(t, "10")},
+		{"decrease", "90", "100", "", mustDecimal(t, "-10")},
+		{"unchanged", "100", "100", "", mustDecimal(t, "0")},
+		{"negative base", "-90", "-100", "", mustDecimal(t, "10")},
+		{"sign change", "50", "-100", "", mustDecimal(t, "150")},
+		{"default precision", "1", "3", "", mustDecimal(t, "-66.6666666666666667")},
+		{"int", int(3), "2", "", mustDecimal(t, "50")},
+		{"float64", float64(1.5), "1.2", "", mustDecimal(t, "25")},
+
+		{"precision", "1", "3", "2", mustDecimal(t, "-66.67")},
+		{"precision (negative)", "1234", "1", "-2", mustDecimal(t, "123300")},
+	}
+
+	for _, test := range tests {
+		assertOK := func(result pipeline.Result, runInfo pipeline.RunInfo) {
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			require.NoError(t, result.Error)
+			require.Equal(t, test.expected.String(), result.Value.(decimal.Decimal).String())
+		}
+		t.Run(test.name, func(t *testing.T) {
+			t.Run("without vars through job DAG", func(t *testing.T) {
+				vars := pipeline.NewVarsFrom(nil)
+				task := pipeline.PercentChangeTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0), BaseValue: test.base, Precision: test.precision}
+				assertOK(task.Run(testutils.Context(t), logger.TestLogger(t), vars, []pipeline.Result{{Value: test.input}}))
+			})
+			t.Run("without vars through input param", func(t *testing.T) {
+				vars := pipeline.NewVarsFrom(nil)
+				task := pipeline.PercentChangeTask{
+					BaseTask:  pipeline.NewBaseTask(0, "task", nil, nil, 0),
+					Input:     fmt.Sprintf("%v", test.input),
+					BaseValue: test.base,
+					Precision: test.precision,
+				}
+				assertOK(task.Run(testutils.Context(t), logger.TestLogger(t), vars, []pipeline.Result{}))
+			})
+			t.Run("with vars", func(t *testing.T) {
+				vars := pipeline.NewVarsFrom(map[string]interface{}{
+					"foo":    map[string]interface{}{"bar": test.input},
+					"chain":  map[string]interface{}{"link": test.base},
+					"sergey": map[string]interface{}{"steve": test.precision},
+				})
+				task := pipeline.PercentChangeTask{
+					BaseTask:  pipeline.NewBaseTask(0, "task", nil, nil, 0),
+					Input:     "$(foo.bar)",
+					BaseValue: "$(chain.link)",
+					Precision: "$(sergey.steve)",
+				}
+				assertOK(task.Run(testutils.Context(t), logger.TestLogger(t), vars, []pipeline.Result{}))
+			})
+		})
+	}
+}
+
+func TestPercentChangeTask_Unhappy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		base              string
+		input             string
+		inputs            []pipeline.Result
+		vars              pipeline.Vars
+		wantErrorCause    error
+		wantErrorContains string
+	}{
+		{"map as input from inputs", "100", "", []pipeline.Result{{Value: map[string]interface{}{"chain": "link"}}}, pipeline.NewVarsFrom(nil), pipeline.ErrBadInput, "input"},
+		{"input as missing var", "100", "$(foo)", nil, pipeline.NewVarsFrom(nil), pipeline.ErrKeypathNotFound, "input"},
+		{"base as missing var", "$(foo)", "", []pipeline.Result{{Value: "123"}}, pipeline.NewVarsFrom(nil), pipeline.ErrKeypathNotFound, "base"},
+		{"errored inputs", "100", "", []pipeline.Result{{Error: errors.New("uh oh")}}, pipeline.NewVarsFrom(nil), pipeline.ErrTooManyErrors, "task inputs"},
+		{"zero base", "0", "", []pipeline.Result{{Value: "123"}}, pipeline.NewVarsFrom(nil), pipeline.ErrDivideByZero, "divide by zero"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.PercentChangeTask{
+				BaseTask:  pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Input:     test.input,
+				BaseValue: test.base,
+			}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), test.vars, test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			require.Equal(t, test.wantErrorCause, errors.Cause(result.Error))
+			if test.wantErrorContains != "" {
+				require.Contains(t, result.Error.Error(), test.wantErrorContains)
+			}
+		})
+	}
+}
+
+func TestPercentChangeTask_Unmarshal(t *testing.T) {
+	t.Parallel()
+
+	task, err := pipeline.UnmarshalTaskFromMap(pipeline.TaskTypePercentChange, map[string]string{
+		"input":     "$(a)",
+		"base":      "$(b)",
+		"precision": "2",
+	}, 0, "change")
+	require.NoError(t, err)
+	require.IsType(t, &pipeline.PercentChangeTask{}, task)
+	assert.Equal(t, "$(a)", task.(*pipeline.PercentChangeTask).Input)
+	assert.Equal(t, "$(b)", task.(*pipeline.PercentChangeTask).BaseValue)
+	assert.Equal(t, "2", task.(*pipeline.PercentChangeTask).Precision)
+}
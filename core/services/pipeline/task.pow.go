@@ -0,0 +1,85 @@
+package pipeline
+
+import (
+	"context"
+	"math"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// Return types:
+//
+//	*decimal.Decimal
+type PowTask struct {
+	BaseTask  `mapstructure:",squash"`
+	Input     string `json:"input"`
+	Exponent  string `json:"exponent"`
+	Precision string `json:"precision"`
+}
+
+var (
+	_              Task = (*PowTask)(nil)
+	ErrPowOverflow      = errors.New("pow overflow")
+)
+
+// maxPowResultBits bounds the size of the coefficient of a pow result, to avoid
+// unbounded allocations for large exponents.
+const maxPowResultBits = 4096
+
+func (t *PowTask) Type() TaskType {
+	return TaskTypePow
+}
+
+func (t *PowTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		a              DecimalParam
+		maybeExponent  MaybeInt32Param
+		maybePrecision MaybeInt32Param
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&a, From(VarExpr(t.Input, vars), NonemptyString(t.Input), Input(inputs, 0))), "input"),
+		errors.Wrap(ResolveParam(&maybeExponent, From(VarExpr(t.Exponent, vars), NonemptyString(t.Exponent))), "exponent"),
+		errors.Wrap(ResolveParam(&maybePrecision, From(VarExpr(t.Precision, vars), t.Precision)), "precision"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	exponent, isSet := maybeExponent.Int32()
+	if !isSet {
+		return Result{Error: errors.Wrap(ErrParameterEmpty, "exponent")}, runInfo
+	}
+
+	base := a.Decimal()
+	n := int64(exponent)
+	if n < 0 {
+		n = -n
+	}
+	newExp := int64(base.Exponent()) * n
+	if newExp > math.MaxInt32 || newExp < math.MinInt32 || int64(base.Coefficient().BitLen())*n > maxPowResultBits {
+		return Result{Error: ErrPowOverflow}, runInfo
+	}
+
+	value := base.Pow(decimal.NewFromInt(n))
+	if exponent >= 0 {
+		return Result{Value: roundToPrecision(value, maybePrecision)}, runInfo
+	}
+
+	if value.IsZero() {
+		return Result{Error: ErrDivideByZero}, runInfo
+	}
+	if precision, isSet := maybePrecision.Int32(); isSet {
+		return Result{Value: decimal.NewFromInt(1).DivRound(value, precision)}, runInfo
+	}
+	// Note that decimal library defaults to rounding to 16 precision
+	// https://github.com/shopspring/decimal/blob/2568a29459476f824f35433dfbef158d6ad8618c/decimal.go#L44
+	return Result{Value: decimal.NewFromInt(1).Div(value)}, runInfo
+}
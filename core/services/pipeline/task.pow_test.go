@@ -0,0 +1,114 @@
+package pipeline_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestPowTask_Happy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		input     interface{}
+		exponent  string
+		precision string
+		expected  *decimal.Decimal
+	}{
+		{"string", "1.5", "2", "", mustDecimal(t, "2.25")},
+		{"string, zero exponent", "1.5", "0", "", mustDecimal(t, "1")},
+		{"string, negative base", "-2", "3", "", mustDecimal(t, "-8")},
+		{"string, negative exponent", "2", "-2", "", mustDecimal(t, "0.25")},
+		{"string, negative exponent, default precision", "3", "-1", "", mustDecimal(t, "0.3333333333333333")},
+		{"int, decimals", int(10), "18", "", mustDecimal(t, "1000000000000000000")},
+		{"uint64", uint64(2), "10", "", mustDecimal(t, "1024")},
+		{"float64", float64(1.1), "2", "", mustDecimal(t, "1.21")},
+
+		{"precision", "1.05", "3", "2", mustDecimal(t, "1.16")},
+		{"precision, negative exponent", "3", "-1", "4", mustDecimal(t, "0.3333")},
+	}
+
+	for _, test := range tests {
+		assertOK := func(result pipeline.Result, runInfo pipeline.RunInfo) {
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			require.NoError(t, result.Error)
+			require.Equal(t, test.expected.String(), result.Value.(decimal.Decimal).String())
+		}
+		t.Run(test.name, func(t *testing.T) {
+			t.Run("without vars through job DAG", func(t *testing.T) {
+				vars := pipeline.NewVarsFrom(nil)
+				task := pipeline.PowTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0), Exponent: test.exponent, Precision: test.precision}
+				assertOK(task.Run(testutils.Context(t), logger.TestLogger(t), vars, []pipeline.Result{{Value: test.input}}))
+			})
+			t.Run("without vars through input param", func(t *testing.T) {
+				vars := pipeline.NewVarsFrom(nil)
+				task := pipeline.PowTask{
+					BaseTask:  pipeline.NewBaseTask(0, "task", nil, nil, 0),
+					Input:     fmt.Sprintf("%v", test.input),
+					Exponent:  test.exponent,
+					Precision: test.precision,
+				}
+				assertOK(task.Run(testutils.Context(t), logger.TestLogger(t), vars, []pipeline.Result{}))
+			})
+			t.Run("with vars", func(t *testing.T) {
+				vars := pipeline.NewVarsFrom(map[string]interface{}{
+					"foo":    map[string]interface{}{"bar": test.input},
+					"chain":  map[string]interface{}{"link": test.exponent},
+					"sergey": map[string]interface{}{"steve": test.precision},
+				})
+				task := pipeline.PowTask{
+					BaseTask:  pipeline.NewBaseTask(0, "task", nil, nil, 0),
+					Input:     "$(foo.bar)",
+					Exponent:  "$(chain.link)",
+					Precision: "$(sergey.steve)",
+				}
+				assertOK(task.Run(testutils.Context(t), logger.TestLogger(t), vars, []pipeline.Result{}))
+			})
+		})
+	}
+}
+
+func TestPowTask_Unhappy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		input             string
+		exponent          string
+		vars              pipeline.Vars
+		wantErrorCause    error
+		wantErrorContains string
+	}{
+		{"missing exponent", "2", "", pipeline.NewVarsFrom(nil), pipeline.ErrParameterEmpty, "exponent"},
+		{"fractional exponent", "2", "0.5", pipeline.NewVarsFrom(nil), pipeline.ErrBadInput, "exponent"},
+		{"exponent as missing var", "2", "$(foo)", pipeline.NewVarsFrom(nil), pipeline.ErrKeypathNotFound, "exponent"},
+		{"zero to a negative exponent", "0", "-1", pipeline.NewVarsFrom(nil), pipeline.ErrDivideByZero, "divide by zero"},
+		{"overflow", "12345678901234567890", "1000", pipeline.NewVarsFrom(nil), pipeline.ErrPowOverflow, "pow overflow"},
+		{"exponent overflow", "1e-1000000", "10000", pipeline.NewVarsFrom(nil), pipeline.ErrPowOverflow, "pow overflow"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.PowTask{
+				BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Input:    test.input,
+				Exponent: test.exponent,
+			}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), test.vars, nil)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			require.Equal(t, test.wantErrorCause, errors.Cause(result.Error))
+			require.Contains(t, result.Error.Error(), test.wantErrorContains)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// Return types:
+//
+//	*decimal.Decimal
+//
+// Mode is one of:
+//   - halfup (default): round half away from zero
+//   - halfeven: round half to even, a.k.a. banker's rounding
+//   - up: round away from zero
+//   - down: round towards zero, i.e. truncate
+//   - ceil: round towards positive infinity
+//   - floor: round towards negative infinity
+type RoundTask struct {
+	BaseTask  `mapstructure:",squash"`
+	Input     string `json:"input"`
+	Precision string `json:"precision"`
+	Mode      string `json:"mode"`
+}
+
+var _ Task = (*RoundTask)(nil)
+
+func (t *RoundTask) Type() TaskType {
+	return TaskTypeRound
+}
+
+func (t *RoundTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		a              DecimalParam
+		maybePrecision MaybeInt32Param
+		mode           StringParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&a, From(VarExpr(t.Input, vars), NonemptyString(t.Input), Input(inputs, 0))), "input"),
+		errors.Wrap(ResolveParam(&maybePrecision, From(VarExpr(t.Precision, vars), t.Precision)), "precision"),
+		errors.Wrap(ResolveParam(&mode, From(VarExpr(t.Mode, vars), NonemptyString(t.Mode), "halfup")), "mode"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	// Rounds to an integer by default
+	precision, _ := maybePrecision.Int32()
+	value := a.Decimal()
+	switch mode {
+	case "halfup":
+		value = value.Round(precision)
+	case "halfeven":
+		value = value.RoundBank(precision)
+	case "up":
+		value = value.RoundUp(precision)
+	case "down":
+		value = value.RoundDown(precision)
+	case "ceil":
+		value = value.RoundCeil(precision)
+	case "floor":
+		value = value.RoundFloor(precision)
+	default:
+		return Result{Error: errors.Wrapf(ErrBadInput, "mode: unknown rounding mode %q", mode)}, runInfo
+	}
+	return Result{Value: value}, runInfo
+}
+
+// roundToPrecision rounds value half away from zero to the given number of decimal places, if set.
+// Tasks whose result may need more decimal places than their inputs take the same optional precision
+// parameter, so that they can be chained without accumulating digits.
+func roundToPrecision(value decimal.Decimal, maybePrecision MaybeInt32Param) decimal.Decimal {
+	if precision, isSet := maybePrecision.Int32(); isSet {
+		return value.Round(precision)
+	}
+	return value
+}
@@ -0,0 +1,103 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestRoundTask_Happy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		input     interface{}
+		precision string
+		mode      string
+		expected  *decimal.Decimal
+	}{
+		{"default", "2.5", "", "", mustDecimal(t, "3")},
+		{"default, negative", "-2.5", "", "", mustDecimal(t, "-3")},
+		{"precision", "1.2345", "2", "", mustDecimal(t, "1.23")},
+		{"precision (negative)", "12345.67", "-2", "", mustDecimal(t, "12300")},
+		{"int", int(12), "2", "", mustDecimal(t, "12")},
+		{"float64", float64(1.005), "1", "", mustDecimal(t, "1")},
+
+		{"halfup", "2.45", "1", "halfup", mustDecimal(t, "2.5")},
+		{"halfeven", "2.45", "1", "halfeven", mustDecimal(t, "2.4")},
+		{"up", "-2.41", "1", "up", mustDecimal(t, "-2.5")},
+		{"down", "-2.49", "1", "down", mustDecimal(t, "-2.4")},
+		{"ceil", "-2.49", "1", "ceil", mustDecimal(t, "-2.4")},
+		{"floor", "-2.41", "1", "floor", mustDecimal(t, "-2.5")},
+	}
+
+	for _, test := range tests {
+		assertOK := func(result pipeline.Result, runInfo pipeline.RunInfo) {
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			require.NoError(t, result.Error)
+			require.Equal(t, test.expected.String(), result.Value.(decimal.Decimal).String())
+		}
+		t.Run(test.name, func(t *testing.T) {
+			t.Run("without vars through job DAG", func(t *testing.T) {
+				vars := pipeline.NewVarsFrom(nil)
+				task := pipeline.RoundTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0), Precision: test.precision, Mode: test.mode}
+				assertOK(task.Run(testutils.Context(t), logger.TestLogger(t), vars, []pipeline.Result{{Value: test.input}}))
+			})
+			t.Run("with vars", func(t *testing.T) {
+				vars := pipeline.NewVarsFrom(map[string]interface{}{
+					"foo":    map[string]interface{}{"bar": test.input},
+					"sergey": map[string]interface{}{"steve": test.precision},
+				})
+				task := pipeline.RoundTask{
+					BaseTask:  pipeline.NewBaseTask(0, "task", nil, nil, 0),
+					Input:     "$(foo.bar)",
+					Precision: "$(sergey.steve)",
+					Mode:      test.mode,
+				}
+				assertOK(task.Run(testutils.Context(t), logger.TestLogger(t), vars, []pipeline.Result{}))
+			})
+		})
+	}
+}
+
+func TestRoundTask_Unhappy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		input             string
+		precision         string
+		mode              string
+		inputs            []pipeline.Result
+		wantErrorCause    error
+		wantErrorContains string
+	}{
+		{"map as input from inputs", "", "", "", []pipeline.Result{{Value: map[string]interface{}{"chain": "link"}}}, pipeline.ErrBadInput, "input"},
+		{"bad precision", "", "1.5", "", []pipeline.Result{{Value: "123"}}, pipeline.ErrBadInput, "precision"},
+		{"unknown mode", "", "", "nearest", []pipeline.Result{{Value: "123"}}, pipeline.ErrBadInput, "unknown rounding mode"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.RoundTask{
+				BaseTask:  pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Input:     test.input,
+				Precision: test.precision,
+				Mode:      test.mode,
+			}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			require.Equal(t, test.wantErrorCause, errors.Cause(result.Error))
+			require.Contains(t, result.Error.Error(), test.wantErrorContains)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/s4"
+)
+
+var errS4StorageNotAvailable = errors.New("S4 storage is not available")
+
+// S4GetTask reads a record from the slot of address in the node's local S4
+// storage of donID, which is available while a Functions job serving that DON
+// is running. If version is set, the task fails when the stored record is older.
+//
+// Return types:
+//
+//	map[string]interface{} with keys:
+//	  payload    []byte
+//	  version    uint64
+//	  expiration int64
+//	  confirmed  bool
+type S4GetTask struct {
+	BaseTask `mapstructure:",squash"`
+	DonID    string `json:"donID" mapstructure:"donID"`
+	Address  string `json:"address"`
+	SlotID   string `json:"slotID" mapstructure:"slotID"`
+	Version  string `json:"version"`
+
+	storages *s4Storages
+}
+
+var _ Task = (*S4GetTask)(nil)
+
+func (t *S4GetTask) Type() TaskType {
+	return TaskTypeS4Get
+}
+
+func (t *S4GetTask) Run(ctx context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		donID   StringParam
+		address AddressParam
+		slotID  Uint64Param
+		version MaybeUint64Param
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&donID, From(VarExpr(t.DonID, vars), NonemptyString(t.DonID))), "donID"),
+		errors.Wrap(ResolveParam(&address, From(VarExpr(t.Address, vars), NonemptyString(t.Address))), "address"),
+		errors.Wrap(ResolveParam(&slotID, From(VarExpr(t.SlotID, vars), NonemptyString(t.SlotID), 0)), "slotID"),
+		errors.Wrap(ResolveParam(&version, From(VarExpr(t.Version, vars), t.Version)), "version"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	storage := t.storages.get(string(donID))
+	if storage == nil {
+		return Result{Error: errors.Wrapf(errS4StorageNotAvailable, "no running Functions job serves DON %q", donID)}, runInfo
+	}
+
+	key := &s4.Key{
+		Address: common.Address(address),
+		SlotId:  uint(slotID),
+	}
+	record, metadata, err := storage.Get(ctx, key)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "failed to get S4 record")}, runInfo
+	}
+	if minVersion, isSet := version.Uint64(); isSet && metadata.Version < minVersion {
+		return Result{Error: errors.Wrapf(s4.ErrVersionTooLow, "stored version %d is lower than %d", metadata.Version, minVersion)}, runInfo
+	}
+
+	return Result{Value: map[string]interface{}{
+		"payload":    record.Payload,
+		"version":    metadata.Version,
+		"expiration": record.Expiration,
+		"confirmed":  metadata.Confirmed,
+	}}, runInfo
+}
@@ -0,0 +1,115 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/services/s4"
+	s4mocks "github.com/smartcontractkit/chainlink/v2/core/services/s4/mocks"
+)
+
+func TestS4GetTask(t *testing.T) {
+	t.Parallel()
+
+	address := testutils.NewAddress()
+	record := &s4.Record{Payload: []byte("hello"), Expiration: 1000}
+	metadata := &s4.Metadata{Confirmed: true, Version: 3}
+
+	t.Run("returns record", func(t *testing.T) {
+		storage := s4mocks.NewStorage(t)
+		storage.On("Get", mock.Anything, &s4.Key{Address: address, SlotId: 2}).Return(record, metadata, nil).Once()
+
+		task := pipeline.S4GetTask{
+			BaseTask: pipeline.NewBaseTask(0, "s4get", nil, nil, 0),
+			DonID:    "don1",
+			Address:  "$(address)",
+			SlotID:   "2",
+		}
+		task.HelperSetDependencies("don1", storage)
+		vars := pipeline.NewVarsFrom(map[string]interface{}{"address": address.Hex()})
+
+		result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+		assert.False(t, runInfo.IsPending)
+		assert.False(t, runInfo.IsRetryable)
+		require.NoError(t, result.Error)
+		assert.Equal(t, map[string]interface{}{
+			"payload":    []byte("hello"),
+			"version":    uint64(3),
+			"expiration": int64(1000),
+			"confirmed":  true,
+		}, result.Value)
+	})
+
+	t.Run("version too low", func(t *testing.T) {
+		storage := s4mocks.NewStorage(t)
+		storage.On("Get", mock.Anything, mock.Anything).Return(record, metadata, nil).Once()
+
+		task := pipeline.S4GetTask{
+			BaseTask: pipeline.NewBaseTask(0, "s4get", nil, nil, 0),
+			DonID:    "don1",
+			Address:  address.Hex(),
+			SlotID:   "2",
+			Version:  "4",
+		}
+		task.HelperSetDependencies("don1", storage)
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.ErrorIs(t, result.Error, s4.ErrVersionTooLow)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		storage := s4mocks.NewStorage(t)
+		storage.On("Get", mock.Anything, mock.Anything).Return(nil, nil, s4.ErrNotFound).Once()
+
+		task := pipeline.S4GetTask{
+			BaseTask: pipeline.NewBaseTask(0, "s4get", nil, nil, 0),
+			DonID:    "don1",
+			Address:  address.Hex(),
+		}
+		task.HelperSetDependencies("don1", storage)
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.ErrorIs(t, result.Error, s4.ErrNotFound)
+	})
+
+	t.Run("missing address", func(t *testing.T) {
+		task := pipeline.S4GetTask{
+			BaseTask: pipeline.NewBaseTask(0, "s4get", nil, nil, 0),
+			DonID:    "don1",
+		}
+		task.HelperSetDependencies("don1", s4mocks.NewStorage(t))
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.ErrorIs(t, result.Error, pipeline.ErrParameterEmpty)
+	})
+
+	t.Run("storage of another DON", func(t *testing.T) {
+		task := pipeline.S4GetTask{
+			BaseTask: pipeline.NewBaseTask(0, "s4get", nil, nil, 0),
+			DonID:    "don2",
+			Address:  address.Hex(),
+		}
+		task.HelperSetDependencies("don1", s4mocks.NewStorage(t))
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.ErrorContains(t, result.Error, `no running Functions job serves DON "don2"`)
+	})
+
+	t.Run("no storage", func(t *testing.T) {
+		task := pipeline.S4GetTask{
+			BaseTask: pipeline.NewBaseTask(0, "s4get", nil, nil, 0),
+			DonID:    "don1",
+			Address:  common.HexToAddress("0x1").Hex(),
+		}
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.Error(t, result.Error)
+	})
+}
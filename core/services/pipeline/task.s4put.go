@@ -0,0 +1,87 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/s4"
+)
+
+// S4PutTask stores a record signed by the owner of address in the node's local
+// S4 storage of donID. The signature is calculated over the S4 envelope, see
+// s4.Envelope.
+//
+// Return types:
+//
+//	nil
+type S4PutTask struct {
+	BaseTask   `mapstructure:",squash"`
+	DonID      string `json:"donID" mapstructure:"donID"`
+	Address    string `json:"address"`
+	SlotID     string `json:"slotID" mapstructure:"slotID"`
+	Version    string `json:"version"`
+	Expiration string `json:"expiration"`
+	Payload    string `json:"payload"`
+	Signature  string `json:"signature"`
+
+	storages *s4Storages
+}
+
+var _ Task = (*S4PutTask)(nil)
+
+func (t *S4PutTask) Type() TaskType {
+	return TaskTypeS4Put
+}
+
+func (t *S4PutTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		donID      StringParam
+		address    AddressParam
+		slotID     Uint64Param
+		version    Uint64Param
+		expiration Uint64Param
+		payload    BytesParam
+		signature  BytesParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&donID, From(VarExpr(t.DonID, vars), NonemptyString(t.DonID))), "donID"),
+		errors.Wrap(ResolveParam(&address, From(VarExpr(t.Address, vars), NonemptyString(t.Address))), "address"),
+		errors.Wrap(ResolveParam(&slotID, From(VarExpr(t.SlotID, vars), NonemptyString(t.SlotID), 0)), "slotID"),
+		errors.Wrap(ResolveParam(&version, From(VarExpr(t.Version, vars), NonemptyString(t.Version))), "version"),
+		errors.Wrap(ResolveParam(&expiration, From(VarExpr(t.Expiration, vars), NonemptyString(t.Expiration))), "expiration"),
+		errors.Wrap(ResolveParam(&payload, From(VarExpr(t.Payload, vars), NonemptyString(t.Payload), Input(inputs, 0))), "payload"),
+		errors.Wrap(ResolveParam(&signature, From(VarExpr(t.Signature, vars), NonemptyString(t.Signature))), "signature"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	storage := t.storages.get(string(donID))
+	if storage == nil {
+		return Result{Error: errors.Wrapf(errS4StorageNotAvailable, "no running Functions job serves DON %q", donID)}, runInfo
+	}
+
+	key := &s4.Key{
+		Address: common.Address(address),
+		SlotId:  uint(slotID),
+		Version: uint64(version),
+	}
+	record := &s4.Record{
+		Payload:    []byte(payload),
+		Expiration: int64(expiration),
+	}
+	if err = storage.Put(ctx, key, record, signature); err != nil {
+		return Result{Error: errors.Wrap(err, "failed to put S4 record")}, runInfo
+	}
+
+	lggr.Debugw("S4 record stored", "address", key.Address, "slotID", key.SlotId, "version", key.Version)
+	return Result{}, runInfo
+}
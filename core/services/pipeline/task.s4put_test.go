@@ -0,0 +1,80 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/services/s4"
+	s4mocks "github.com/smartcontractkit/chainlink/v2/core/services/s4/mocks"
+)
+
+func TestS4PutTask(t *testing.T) {
+	t.Parallel()
+
+	address := testutils.NewAddress()
+
+	t.Run("stores record from input", func(t *testing.T) {
+		storage := s4mocks.NewStorage(t)
+		key := &s4.Key{Address: address, SlotId: 1, Version: 5}
+		record := &s4.Record{Payload: []byte("hello"), Expiration: 1000}
+		storage.On("Put", mock.Anything, key, record, []byte{0xab, 0xcd}).Return(nil).Once()
+
+		task := pipeline.S4PutTask{
+			BaseTask:   pipeline.NewBaseTask(0, "s4put", nil, nil, 0),
+			DonID:      "don1",
+			Address:    address.Hex(),
+			SlotID:     "1",
+			Version:    "$(version)",
+			Expiration: "1000",
+			Signature:  "0xabcd",
+		}
+		task.HelperSetDependencies("don1", storage)
+		vars := pipeline.NewVarsFrom(map[string]interface{}{"version": 5})
+
+		result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), vars, []pipeline.Result{{Value: "hello"}})
+		assert.False(t, runInfo.IsPending)
+		assert.False(t, runInfo.IsRetryable)
+		require.NoError(t, result.Error)
+		assert.Nil(t, result.Value)
+	})
+
+	t.Run("storage error", func(t *testing.T) {
+		storage := s4mocks.NewStorage(t)
+		storage.On("Put", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(s4.ErrWrongSignature).Once()
+
+		task := pipeline.S4PutTask{
+			BaseTask:   pipeline.NewBaseTask(0, "s4put", nil, nil, 0),
+			DonID:      "don1",
+			Address:    address.Hex(),
+			Version:    "1",
+			Expiration: "1000",
+			Payload:    "hello",
+			Signature:  "0xabcd",
+		}
+		task.HelperSetDependencies("don1", storage)
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.ErrorIs(t, result.Error, s4.ErrWrongSignature)
+	})
+
+	t.Run("missing version", func(t *testing.T) {
+		task := pipeline.S4PutTask{
+			BaseTask:   pipeline.NewBaseTask(0, "s4put", nil, nil, 0),
+			DonID:      "don1",
+			Address:    address.Hex(),
+			Expiration: "1000",
+			Payload:    "hello",
+			Signature:  "0xabcd",
+		}
+		task.HelperSetDependencies("don1", s4mocks.NewStorage(t))
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.ErrorIs(t, result.Error, pipeline.ErrParameterEmpty)
+	})
+}
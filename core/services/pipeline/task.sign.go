@@ -0,0 +1,104 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// SignTask signs its input with an EVM key of the node, which must be enabled for the given chain.
+//
+// Mode is one of:
+//   - eip191 (default): signs the EIP-191 personal message hash of the data, as personal_sign does
+//   - raw: signs the data as is, which must be a 32 byte digest
+//
+// The signature is 65 bytes long, [R || S || V] where V is 27 or 28 as expected by ecrecover.
+// As the raw mode can sign any digest, including transaction hashes, the task must be enabled
+// with JobPipeline.SignTaskEnabled.
+//
+// Return types:
+//
+//	[]byte
+type SignTask struct {
+	BaseTask   `mapstructure:",squash"`
+	Data       string `json:"data"`
+	From       string `json:"from"`
+	Mode       string `json:"mode"`
+	EVMChainID string `json:"evmChainID" mapstructure:"evmChainID"`
+
+	config   Config
+	keyStore ETHKeyStore
+	chainSet evm.ChainSet
+}
+
+var (
+	_                   Task = (*SignTask)(nil)
+	ErrSignTaskDisabled      = errors.New("sign task is disabled, set JobPipeline.SignTaskEnabled to enable it")
+)
+
+func (t *SignTask) Type() TaskType {
+	return TaskTypeSign
+}
+
+func (t *SignTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	if !t.config.SignTaskEnabled() {
+		return Result{Error: ErrSignTaskDisabled}, runInfo
+	}
+
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		data    BytesParam
+		from    AddressParam
+		mode    StringParam
+		chainID StringParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&data, From(VarExpr(t.Data, vars), NonemptyString(t.Data), Input(inputs, 0))), "data"),
+		errors.Wrap(ResolveParam(&from, From(VarExpr(t.From, vars), NonemptyString(t.From))), "from"),
+		errors.Wrap(ResolveParam(&mode, From(NonemptyString(t.Mode), "eip191")), "mode"),
+		errors.Wrap(ResolveParam(&chainID, From(VarExpr(t.EVMChainID, vars), NonemptyString(t.EVMChainID), "")), "evmChainID"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	var hash common.Hash
+	switch mode {
+	case "eip191":
+		hash = common.BytesToHash(accounts.TextHash(data))
+	case "raw":
+		if len(data) != common.HashLength {
+			return Result{Error: errors.Wrapf(ErrBadInput, "data: raw mode signs a %d byte digest, got %d bytes", common.HashLength, len(data))}, runInfo
+		}
+		hash = common.BytesToHash(data)
+	default:
+		return Result{Error: errors.Wrapf(ErrBadInput, "mode: unknown signing mode %q", mode)}, runInfo
+	}
+
+	chain, err := getChainByString(t.chainSet, string(chainID))
+	if err != nil {
+		return Result{Error: errors.Wrapf(err, "failed to get chain by id: %v", t.EVMChainID)}, retryableRunInfo()
+	}
+	address := common.Address(from)
+	if err = t.keyStore.CheckEnabled(address, chain.ID()); err != nil {
+		return Result{Error: errors.Wrap(err, "from")}, runInfo
+	}
+
+	signature, err := t.keyStore.SignHash(address, hash)
+	if err != nil {
+		lggr.Errorw("SignTask failed to sign", "address", address, "err", err)
+		return Result{Error: errors.Wrapf(ErrTaskRunFailed, "while signing: %v", err)}, runInfo
+	}
+	signature[64] += 27
+	return Result{Value: signature}, runInfo
+}
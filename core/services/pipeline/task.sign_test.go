@@ -0,0 +1,168 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	configtest "github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest/v2"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline/mocks"
+)
+
+func TestSignTask(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	keyStore := cltest.NewKeyStore(t, db, cfg.Database()).Eth()
+	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg, KeyStore: keyStore})
+	key, _ := cltest.MustInsertRandomKey(t, keyStore)
+	disabledKey, _ := cltest.MustInsertRandomKey(t, keyStore, false)
+	digest := crypto.Keccak256([]byte("attestation"))
+
+	tests := []struct {
+		name              string
+		data              string
+		from              string
+		mode              string
+		evmChainID        string
+		vars              pipeline.Vars
+		inputs            []pipeline.Result
+		wantHash          []byte
+		wantErrorCause    error
+		wantErrorContains string
+	}{
+		{
+			"eip191 (default)",
+			"", key.Address.Hex(), "", "",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: "hello"}},
+			accounts.TextHash([]byte("hello")),
+			nil, "",
+		},
+		{
+			"eip191 with vars",
+			"$(foo.bar)", "$(foo.from)", "eip191", testutils.FixtureChainID.String(),
+			pipeline.NewVarsFrom(map[string]interface{}{"foo": map[string]interface{}{"bar": []byte("hello"), "from": key.Address.Hex()}}),
+			nil,
+			accounts.TextHash([]byte("hello")),
+			nil, "",
+		},
+		{
+			"raw",
+			hexutil.Encode(digest), key.Address.Hex(), "raw", "",
+			pipeline.NewVarsFrom(nil),
+			nil,
+			digest,
+			nil, "",
+		},
+		{
+			"raw with wrong length",
+			"0x1234", key.Address.Hex(), "raw", "",
+			pipeline.NewVarsFrom(nil),
+			nil,
+			nil,
+			pipeline.ErrBadInput, "32 byte digest",
+		},
+		{
+			"unknown mode",
+			"hello", key.Address.Hex(), "personal", "",
+			pipeline.NewVarsFrom(nil),
+			nil,
+			nil,
+			pipeline.ErrBadInput, "unknown signing mode",
+		},
+		{
+			"missing from",
+			"hello", "", "", "",
+			pipeline.NewVarsFrom(nil),
+			nil,
+			nil,
+			pipeline.ErrParameterEmpty, "from",
+		},
+		{
+			"unknown key",
+			"hello", testutils.NewAddress().Hex(), "", "",
+			pipeline.NewVarsFrom(nil),
+			nil,
+			nil,
+			nil, "no eth key exists",
+		},
+		{
+			"disabled key",
+			"hello", disabledKey.Address.Hex(), "", "",
+			pipeline.NewVarsFrom(nil),
+			nil,
+			nil,
+			nil, "is disabled for chain",
+		},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			config := mocks.NewConfig(t)
+			config.On("SignTaskEnabled").Return(true)
+
+			task := pipeline.SignTask{
+				BaseTask:   pipeline.NewBaseTask(0, "sign", nil, nil, 0),
+				Data:       test.data,
+				From:       test.from,
+				Mode:       test.mode,
+				EVMChainID: test.evmChainID,
+			}
+			task.HelperSetDependencies(config, keyStore, cc)
+
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), test.vars, test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+
+			if test.wantErrorCause != nil || test.wantErrorContains != "" {
+				require.Error(t, result.Error)
+				require.Nil(t, result.Value)
+				if test.wantErrorCause != nil {
+					require.Equal(t, test.wantErrorCause, errors.Cause(result.Error))
+				}
+				require.Contains(t, result.Error.Error(), test.wantErrorContains)
+				return
+			}
+			require.NoError(t, result.Error)
+			signature := result.Value.([]byte)
+			require.Len(t, signature, 65)
+			assert.Contains(t, []byte{27, 28}, signature[64])
+
+			sig := common.CopyBytes(signature)
+			sig[64] -= 27
+			pub, err := crypto.SigToPub(test.wantHash, sig)
+			require.NoError(t, err)
+			assert.Equal(t, key.Address, crypto.PubkeyToAddress(*pub))
+		})
+	}
+
+	t.Run("disabled by config", func(t *testing.T) {
+		config := mocks.NewConfig(t)
+		config.On("SignTaskEnabled").Return(false)
+
+		task := pipeline.SignTask{
+			BaseTask: pipeline.NewBaseTask(0, "sign", nil, nil, 0),
+			Data:     "hello",
+			From:     key.Address.Hex(),
+		}
+		task.HelperSetDependencies(config, keyStore, cc)
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.ErrorIs(t, result.Error, pipeline.ErrSignTaskDisabled)
+	})
+}
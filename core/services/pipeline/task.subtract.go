@@ -0,0 +1,50 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// Return types:
+//
+//	*decimal.Decimal
+type SubtractTask struct {
+	BaseTask  `mapstructure:",squash"`
+	Input     string `json:"input"`
+	Minus     string `json:"minus"`
+	Precision string `json:"precision"`
+}
+
+var _ Task = (*SubtractTask)(nil)
+
+func (t *SubtractTask) Type() TaskType {
+	return TaskTypeSubtract
+}
+
+func (t *SubtractTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		a              DecimalParam
+		b              DecimalParam
+		maybePrecision MaybeInt32Param
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&a, From(VarExpr(t.Input, vars), NonemptyString(t.Input), Input(inputs, 0))), "input"),
+		errors.Wrap(ResolveParam(&b, From(VarExpr(t.Minus, vars), NonemptyString(t.Minus))), "minus"),
+		errors.Wrap(ResolveParam(&maybePrecision, From(VarExpr(t.Precision, vars), t.Precision)), "precision"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	value := a.Decimal().Sub(b.Decimal())
+	return Result{Value: roundToPrecision(value, maybePrecision)}, runInfo
+}
@@ -0,0 +1,125 @@
+package pipeline_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestSubtractTask_Happy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		input     interface{}
+		minus     string
+		precision string
+		expected  *decimal.Decimal
+	}{
+		{"string", "12345.67", "100", "", mustDecimal(t, "12245.67")},
+		{"string, negative", "12345.67", "-5", "", mustDecimal(t, "12350.67")},
+		{"string, large value", "12345.67", "1000000000000000000", "", mustDecimal(t, "-999999999999987654.33")},
+
+		{"int", int(2), "100", "", mustDecimal(t, "-98")},
+		{"int8", int8(2), "100", "", mustDecimal(t, "-98")},
+		{"int16", int16(2), "100", "", mustDecimal(t, "-98")},
+		{"int32", int32(2), "100", "", mustDecimal(t, "-98")},
+		{"int64", int64(2), "100", "", mustDecimal(t, "-98")},
+		{"uint", uint(2), "100", "", mustDecimal(t, "-98")},
+		{"uint8", uint8(2), "100", "", mustDecimal(t, "-98")},
+		{"uint16", uint16(2), "100", "", mustDecimal(t, "-98")},
+		{"uint32", uint32(2), "100", "", mustDecimal(t, "-98")},
+		{"uint64", uint64(2), "100", "", mustDecimal(t, "-98")},
+		{"float32", float32(1.5), "0.25", "", mustDecimal(t, "1.25")},
+		{"float64", float64(1.5), "0.25", "", mustDecimal(t, "1.25")},
+
+		{"precision", "1.23456", "0.1", "2", mustDecimal(t, "1.13")},
+		{"precision (negative)", "12345.67", "100", "-2", mustDecimal(t, "12200")},
+	}
+
+	for _, test := range tests {
+		assertOK := func(result pipeline.Result, runInfo pipeline.RunInfo) {
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			require.NoError(t, result.Error)
+			require.Equal(t, test.expected.String(), result.Value.(decimal.Decimal).String())
+		}
+		t.Run(test.name, func(t *testing.T) {
+			t.Run("without vars through job DAG", func(t *testing.T) {
+				vars := pipeline.NewVarsFrom(nil)
+				task := pipeline.SubtractTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0), Minus: test.minus, Precision: test.precision}
+				assertOK(task.Run(testutils.Context(t), logger.TestLogger(t), vars, []pipeline.Result{{Value: test.input}}))
+			})
+			t.Run("without vars through input param", func(t *testing.T) {
+				vars := pipeline.NewVarsFrom(nil)
+				task := pipeline.SubtractTask{
+					BaseTask:  pipeline.NewBaseTask(0, "task", nil, nil, 0),
+					Input:     fmt.Sprintf("%v", test.input),
+					Minus:     test.minus,
+					Precision: test.precision,
+				}
+				assertOK(task.Run(testutils.Context(t), logger.TestLogger(t), vars, []pipeline.Result{}))
+			})
+			t.Run("with vars", func(t *testing.T) {
+				vars := pipeline.NewVarsFrom(map[string]interface{}{
+					"foo":    map[string]interface{}{"bar": test.input},
+					"chain":  map[string]interface{}{"link": test.minus},
+					"sergey": map[string]interface{}{"steve": test.precision},
+				})
+				task := pipeline.SubtractTask{
+					BaseTask:  pipeline.NewBaseTask(0, "task", nil, nil, 0),
+					Input:     "$(foo.bar)",
+					Minus:     "$(chain.link)",
+					Precision: "$(sergey.steve)",
+				}
+				assertOK(task.Run(testutils.Context(t), logger.TestLogger(t), vars, []pipeline.Result{}))
+			})
+		})
+	}
+}
+
+func TestSubtractTask_Unhappy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		minus             string
+		input             string
+		inputs            []pipeline.Result
+		vars              pipeline.Vars
+		wantErrorCause    error
+		wantErrorContains string
+	}{
+		{"map as input from inputs", "100", "", []pipeline.Result{{Value: map[string]interface{}{"chain": "link"}}}, pipeline.NewVarsFrom(nil), pipeline.ErrBadInput, "input"},
+		{"slice as input from var", "100", "$(foo)", nil, pipeline.NewVarsFrom(map[string]interface{}{"foo": []interface{}{"chain", "link"}}), pipeline.ErrBadInput, "input"},
+		{"input as missing var", "100", "$(foo)", nil, pipeline.NewVarsFrom(nil), pipeline.ErrKeypathNotFound, "input"},
+		{"minus as missing var", "$(foo)", "", []pipeline.Result{{Value: "123"}}, pipeline.NewVarsFrom(nil), pipeline.ErrKeypathNotFound, "minus"},
+		{"missing minus", "", "", []pipeline.Result{{Value: "123"}}, pipeline.NewVarsFrom(nil), pipeline.ErrParameterEmpty, "minus"},
+		{"errored inputs", "100", "", []pipeline.Result{{Error: errors.New("uh oh")}}, pipeline.NewVarsFrom(nil), pipeline.ErrTooManyErrors, "task inputs"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.SubtractTask{
+				BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Input:    test.input,
+				Minus:    test.minus,
+			}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), test.vars, test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			require.Equal(t, test.wantErrorCause, errors.Cause(result.Error))
+			if test.wantErrorContains != "" {
+				require.Contains(t, result.Error.Error(), test.wantErrorContains)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package pipeline
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// TrimmedMeanTask averages its values after discarding trimPercent percent of them from each end,
+// so that a few outliers cannot skew the result. The indices of the discarded values are returned
+// by TaskRunResult.RejectedInputs.
+//
+// Return types:
+//
+//	*decimal.Decimal
+type TrimmedMeanTask struct {
+	BaseTask      `mapstructure:",squash"`
+	Values        string `json:"values"`
+	AllowedFaults string `json:"allowedFaults"`
+	TrimPercent   string `json:"trimPercent"`
+	Precision     string `json:"precision"`
+}
+
+var _ Task = (*TrimmedMeanTask)(nil)
+
+func (t *TrimmedMeanTask) Type() TaskType {
+	return TaskTypeTrimmedMean
+}
+
+func (t *TrimmedMeanTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	var (
+		maybeAllowedFaults MaybeUint64Param
+		maybePrecision     MaybeInt32Param
+		trimPercent        DecimalParam
+		valuesAndErrs      SliceParam
+		allowedFaults      int
+		faults             int
+	)
+	err := multierr.Combine(
+		errors.Wrap(ResolveParam(&maybeAllowedFaults, From(t.AllowedFaults)), "allowedFaults"),
+		errors.Wrap(ResolveParam(&maybePrecision, From(VarExpr(t.Precision, vars), t.Precision)), "precision"),
+		errors.Wrap(ResolveParam(&trimPercent, From(VarExpr(t.TrimPercent, vars), NonemptyString(t.TrimPercent))), "trimPercent"),
+		errors.Wrap(ResolveParam(&valuesAndErrs, From(VarExpr(t.Values, vars), JSONWithVarExprs(t.Values, vars, true), Inputs(inputs))), "values"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	if trimPercent.Decimal().IsNegative() || trimPercent.Decimal().GreaterThanOrEqual(decimal.NewFromInt(50)) {
+		return Result{Error: errors.Wrapf(ErrBadInput, "trimPercent: must be at least 0 and less than 50, got %s", trimPercent.Decimal())}, runInfo
+	}
+
+	if allowed, isSet := maybeAllowedFaults.Uint64(); isSet {
+		allowedFaults = int(allowed)
+	} else {
+		allowedFaults = len(valuesAndErrs) - 1
+	}
+
+	values, faults := valuesAndErrs.FilterErrors()
+	if faults > allowedFaults {
+		return Result{Error: errors.Wrapf(ErrTooManyErrors, "Number of faulty inputs %v to trimmedmean task > number allowed faults %v", faults, allowedFaults)}, runInfo
+	} else if len(values) == 0 {
+		return Result{Error: errors.Wrap(ErrWrongInputCardinality, "values")}, runInfo
+	}
+
+	decimalValues, indices, err := decimalValuesWithIndices(valuesAndErrs)
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "values: %v", err)}, runInfo
+	}
+
+	// Sort the positions rather than the values, to report the indices of the trimmed values
+	order := make([]int, len(decimalValues))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return decimalValues[order[i]].LessThan(decimalValues[order[j]])
+	})
+
+	// Since trimPercent < 50, at least one value always remains
+	trim := int(trimPercent.Decimal().Mul(decimal.NewFromInt(int64(len(order)))).Div(decimal.NewFromInt(100)).IntPart())
+	total := decimal.NewFromInt(0)
+	for pos, i := range order {
+		if pos < trim || pos >= len(order)-trim {
+			runInfo.RejectedInputs = append(runInfo.RejectedInputs, indices[i])
+			continue
+		}
+		total = total.Add(decimalValues[i])
+	}
+	sort.Ints(runInfo.RejectedInputs)
+	numValues := decimal.NewFromInt(int64(len(order) - 2*trim))
+
+	if precision, isSet := maybePrecision.Int32(); isSet {
+		return Result{Value: total.DivRound(numValues, precision)}, runInfo
+	}
+	// Note that decimal library defaults to rounding to 16 precision
+	// https://github.com/shopspring/decimal/blob/2568a29459476f824f35433dfbef158d6ad8618c/decimal.go#L44
+	return Result{Value: total.Div(numValues)}, runInfo
+}
+
+// decimalValuesWithIndices converts the non-error values of valuesAndErrs to decimals, along with
+// their indices in valuesAndErrs, so that rejected values can be reported by their input position.
+func decimalValuesWithIndices(valuesAndErrs SliceParam) (decimalValues []decimal.Decimal, indices []int, err error) {
+	for i, x := range valuesAndErrs {
+		if _, is := x.(error); is {
+			continue
+		}
+		var d DecimalParam
+		if err = d.UnmarshalPipelineParam(x); err != nil {
+			return nil, nil, err
+		}
+		decimalValues = append(decimalValues, d.Decimal())
+		indices = append(indices, i)
+	}
+	return decimalValues, indices, nil
+}
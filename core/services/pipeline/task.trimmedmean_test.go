@@ -0,0 +1,184 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestTrimmedMeanTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		inputs        []pipeline.Result
+		allowedFaults string
+		trimPercent   string
+		precision     string
+		want          pipeline.Result
+		wantRejected  []int
+	}{
+		{
+			"trims both ends",
+			[]pipeline.Result{{Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "2")}, {Value: mustDecimal(t, "3")}, {Value: mustDecimal(t, "4")}, {Value: mustDecimal(t, "100")}},
+			"",
+			"20",
+			"",
+			pipeline.Result{Value: mustDecimal(t, "3")},
+			[]int{0, 4},
+		},
+		{
+			"reports input positions",
+			[]pipeline.Result{{Value: mustDecimal(t, "10")}, {Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "2")}, {Value: mustDecimal(t, "3")}},
+			"",
+			"25",
+			"",
+			pipeline.Result{Value: mustDecimal(t, "2.5")},
+			[]int{0, 1},
+		},
+		{
+			"rounds the number of trimmed values down",
+			[]pipeline.Result{{Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "2")}, {Value: mustDecimal(t, "3")}, {Value: mustDecimal(t, "4")}, {Value: mustDecimal(t, "100")}},
+			"",
+			"10",
+			"",
+			pipeline.Result{Value: mustDecimal(t, "22")},
+			nil,
+		},
+		{
+			"zero trimPercent",
+			[]pipeline.Result{{Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "2")}, {Value: mustDecimal(t, "3")}},
+			"",
+			"0",
+			"",
+			pipeline.Result{Value: mustDecimal(t, "2")},
+			nil,
+		},
+		{
+			"one input",
+			[]pipeline.Result{{Value: mustDecimal(t, "1")}},
+			"0",
+			"49",
+			"",
+			pipeline.Result{Value: mustDecimal(t, "1")},
+			nil,
+		},
+		{
+			"zero inputs",
+			[]pipeline.Result{},
+			"0",
+			"20",
+			"",
+			pipeline.Result{Error: pipeline.ErrWrongInputCardinality},
+			nil,
+		},
+		{
+			"fewer errors than threshold",
+			[]pipeline.Result{{Error: errors.New("")}, {Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "2")}, {Value: mustDecimal(t, "3")}, {Value: mustDecimal(t, "1000")}},
+			"1",
+			"25",
+			"",
+			pipeline.Result{Value: mustDecimal(t, "2.5")},
+			[]int{1, 4},
+		},
+		{
+			"more errors than threshold",
+			[]pipeline.Result{{Error: errors.New("")}, {Error: errors.New("")}, {Value: mustDecimal(t, "3")}, {Value: mustDecimal(t, "4")}},
+			"1",
+			"20",
+			"",
+			pipeline.Result{Error: pipeline.ErrTooManyErrors},
+			nil,
+		},
+		{
+			"precision",
+			[]pipeline.Result{{Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "2")}, {Value: mustDecimal(t, "2")}, {Value: mustDecimal(t, "10")}},
+			"",
+			"20",
+			"2",
+			pipeline.Result{Value: mustDecimal(t, "1.67")},
+			[]int{0, 4},
+		},
+		{
+			"missing trimPercent",
+			[]pipeline.Result{{Value: mustDecimal(t, "1")}},
+			"",
+			"",
+			"",
+			pipeline.Result{Error: pipeline.ErrParameterEmpty},
+			nil,
+		},
+		{
+			"negative trimPercent",
+			[]pipeline.Result{{Value: mustDecimal(t, "1")}},
+			"",
+			"-1",
+			"",
+			pipeline.Result{Error: pipeline.ErrBadInput},
+			nil,
+		},
+		{
+			"trimPercent of 50",
+			[]pipeline.Result{{Value: mustDecimal(t, "1")}},
+			"",
+			"50",
+			"",
+			pipeline.Result{Error: pipeline.ErrBadInput},
+			nil,
+		},
+	}
+
+	for _, test := range tests {
+		assertResult := func(output pipeline.Result, runInfo pipeline.RunInfo) {
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.want.Error != nil {
+				require.Equal(t, test.want.Error, errors.Cause(output.Error))
+				require.Nil(t, output.Value)
+			} else {
+				require.NoError(t, output.Error)
+				require.Equal(t, test.want.Value.(*decimal.Decimal).String(), output.Value.(decimal.Decimal).String())
+			}
+			assert.Equal(t, test.wantRejected, runInfo.RejectedInputs)
+		}
+		t.Run(test.name, func(t *testing.T) {
+			t.Run("without vars", func(t *testing.T) {
+				task := pipeline.TrimmedMeanTask{
+					BaseTask:      pipeline.NewBaseTask(0, "task", nil, nil, 0),
+					AllowedFaults: test.allowedFaults,
+					TrimPercent:   test.trimPercent,
+					Precision:     test.precision,
+				}
+				assertResult(task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), test.inputs))
+			})
+			t.Run("with vars", func(t *testing.T) {
+				var inputs []interface{}
+				for _, input := range test.inputs {
+					if input.Error != nil {
+						inputs = append(inputs, input.Error)
+					} else {
+						inputs = append(inputs, input.Value)
+					}
+				}
+				vars := pipeline.NewVarsFrom(map[string]interface{}{
+					"foo": map[string]interface{}{"bar": inputs},
+				})
+				task := pipeline.TrimmedMeanTask{
+					BaseTask:      pipeline.NewBaseTask(0, "task", nil, nil, 0),
+					Values:        "$(foo.bar)",
+					AllowedFaults: test.allowedFaults,
+					TrimPercent:   test.trimPercent,
+					Precision:     test.precision,
+				}
+				assertResult(task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil))
+			})
+		})
+	}
+}
@@ -43,6 +43,7 @@ import (
 var _ relaytypes.Relayer = &Relayer{}
 
 type RelayerConfig interface {
+	pg.QConfig
 }
 
 type Relayer struct {
@@ -122,11 +123,20 @@ func (r *Relayer) NewMercuryProvider(rargs relaytypes.RelayArgs, pargs relaytype
 		return nil, errors.Wrap(err, "failed to get CSA key for mercury connection")
 	}
 
-	client, err := r.mercuryPool.Checkout(context.Background(), privKey, mercuryConfig.ServerPubKey, mercuryConfig.ServerURL())
-	if err != nil {
-		return nil, err
+	clients := make(map[string]wsrpc.Client)
+	for serverURL, serverPubKey := range mercuryConfig.GetServers() {
+		client, err := r.mercuryPool.Checkout(context.Background(), privKey, serverPubKey, serverURL)
+		if err != nil {
+			for _, c := range clients {
+				if cerr := c.Close(); cerr != nil {
+					r.lggr.Errorw("Failed to check in mercury client", "err", cerr)
+				}
+			}
+			return nil, err
+		}
+		clients[serverURL] = client
 	}
-	transmitter := mercury.NewTransmitter(r.lggr, configWatcher.ContractConfigTracker(), client, privKey.PublicKey, *relayConfig.FeedID)
+	transmitter := mercury.NewTransmitter(r.lggr, configWatcher.ContractConfigTracker(), clients, privKey.PublicKey, *relayConfig.FeedID, mercury.NewORM(r.db, r.lggr, r.cfg))
 
 	return NewMercuryProvider(configWatcher, transmitter, reportCodec, r.lggr), nil
 }
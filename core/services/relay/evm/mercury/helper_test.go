@@ -56,7 +56,7 @@ func SetupTH(t *testing.T, feedID common.Hash) TestHarness {
 	lggr := logger.TestLogger(t)
 	ctx := testutils.Context(t)
 	lorm := logpoller.NewORM(big.NewInt(1337), db, lggr, cfg)
-	lp := logpoller.NewLogPoller(lorm, ethClient, lggr, 100*time.Millisecond, 1, 2, 2, 1000)
+	lp := logpoller.NewLogPoller(lorm, ethClient, lggr, 100*time.Millisecond, false, 1, 2, 2, 1000)
 	eventBroadcaster := pgmocks.NewEventBroadcaster(t)
 	subscription := pgmocks.NewSubscription(t)
 	require.NoError(t, lp.Start(ctx))
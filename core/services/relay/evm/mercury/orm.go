@@ -0,0 +1,125 @@
+package mercury
+
+import (
+	"crypto/sha256"
+	"errors"
+
+	"github.com/lib/pq"
+	"github.com/smartcontractkit/sqlx"
+
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc/pb"
+)
+
+// ORM persists pending mercury transmit requests so they survive node restarts.
+// All functions are thread-safe.
+type ORM interface {
+	InsertTransmitRequest(serverURL string, feedID [32]byte, req *pb.TransmitRequest, reportCtx ocrtypes.ReportContext, qopts ...pg.QOpt) error
+	DeleteTransmitRequests(serverURL string, feedID [32]byte, reqs []*pb.TransmitRequest, qopts ...pg.QOpt) error
+	// GetTransmitRequests returns pending transmissions for the server and feed, latest epoch/round first.
+	GetTransmitRequests(serverURL string, feedID [32]byte, limit int, qopts ...pg.QOpt) ([]*Transmission, error)
+	// PruneTransmitRequests keeps only the latest maxSize transmissions (by epoch/round) for the server and feed.
+	PruneTransmitRequests(serverURL string, feedID [32]byte, maxSize int, qopts ...pg.QOpt) error
+}
+
+type orm struct {
+	q pg.Q
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.QConfig) ORM {
+	namedLogger := lggr.Named("MercuryORM")
+	q := pg.NewQ(db, namedLogger, cfg)
+	return &orm{
+		q: q,
+	}
+}
+
+func (o *orm) InsertTransmitRequest(serverURL string, feedID [32]byte, req *pb.TransmitRequest, reportCtx ocrtypes.ReportContext, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	err := q.ExecQ(`
+		INSERT INTO mercury_transmit_requests (server_url, feed_id, config_digest, epoch, round, extra_hash, payload, payload_hash)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (server_url, feed_id, config_digest, payload_hash) DO NOTHING
+	`, serverURL, feedID[:], reportCtx.ConfigDigest[:], reportCtx.Epoch, reportCtx.Round, reportCtx.ExtraHash[:], req.Payload, hashPayload(req.Payload))
+	return err
+}
+
+func (o *orm) DeleteTransmitRequests(serverURL string, feedID [32]byte, reqs []*pb.TransmitRequest, qopts ...pg.QOpt) error {
+	if len(reqs) == 0 {
+		return nil
+	}
+
+	var hashes pq.ByteaArray
+	for _, req := range reqs {
+		hashes = append(hashes, hashPayload(req.Payload))
+	}
+
+	q := o.q.WithOpts(qopts...)
+	err := q.ExecQ(`
+		DELETE FROM mercury_transmit_requests
+		WHERE server_url = $1 AND feed_id = $2 AND payload_hash = ANY($3)
+	`, serverURL, feedID[:], hashes)
+	return err
+}
+
+func (o *orm) GetTransmitRequests(serverURL string, feedID [32]byte, limit int, qopts ...pg.QOpt) ([]*Transmission, error) {
+	type transmitRequestRow struct {
+		Payload      []byte
+		ConfigDigest []byte
+		Epoch        uint32
+		Round        uint8
+		ExtraHash    []byte
+	}
+
+	var rows []transmitRequestRow
+	q := o.q.WithOpts(qopts...)
+	err := q.Select(&rows, `
+		SELECT payload, config_digest, epoch, round, extra_hash
+		FROM mercury_transmit_requests
+		WHERE server_url = $1 AND feed_id = $2
+		ORDER BY epoch DESC, round DESC
+		LIMIT $3
+	`, serverURL, feedID[:], limit)
+	if err != nil {
+		return nil, err
+	}
+
+	transmissions := make([]*Transmission, len(rows))
+	for i, row := range rows {
+		transmission := &Transmission{Req: &pb.TransmitRequest{Payload: row.Payload}, index: -1}
+		if len(row.ConfigDigest) != len(transmission.ReportCtx.ConfigDigest) || len(row.ExtraHash) != len(transmission.ReportCtx.ExtraHash) {
+			return nil, errors.New("invalid config digest or extra hash length")
+		}
+		copy(transmission.ReportCtx.ConfigDigest[:], row.ConfigDigest)
+		copy(transmission.ReportCtx.ExtraHash[:], row.ExtraHash)
+		transmission.ReportCtx.Epoch = row.Epoch
+		transmission.ReportCtx.Round = row.Round
+		transmissions[i] = transmission
+	}
+	return transmissions, nil
+}
+
+func (o *orm) PruneTransmitRequests(serverURL string, feedID [32]byte, maxSize int, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	// Prune the oldest requests by epoch and round.
+	return q.ExecQ(`
+		DELETE FROM mercury_transmit_requests
+		WHERE server_url = $1 AND feed_id = $2 AND (config_digest, payload_hash) NOT IN (
+			SELECT config_digest, payload_hash
+			FROM mercury_transmit_requests
+			WHERE server_url = $1 AND feed_id = $2
+			ORDER BY epoch DESC, round DESC
+			LIMIT $3
+		)
+	`, serverURL, feedID[:], maxSize)
+}
+
+func hashPayload(payload []byte) []byte {
+	checksum := sha256.Sum256(payload)
+	return checksum[:]
+}
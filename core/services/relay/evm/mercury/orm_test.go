@@ -0,0 +1,74 @@
+package mercury
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc/pb"
+)
+
+func newTestReportContext(epoch uint32, round uint8) ocrtypes.ReportContext {
+	return ocrtypes.ReportContext{
+		ReportTimestamp: ocrtypes.ReportTimestamp{
+			ConfigDigest: ocrtypes.ConfigDigest{1},
+			Epoch:        epoch,
+			Round:        round,
+		},
+		ExtraHash: [32]byte{2},
+	}
+}
+
+func TestORM(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	orm := NewORM(db, logger.TestLogger(t), pgtest.NewQConfig(true))
+	otherFeedID := [32]byte{42}
+
+	reqs := []*pb.TransmitRequest{
+		{Payload: []byte("report-1")},
+		{Payload: []byte("report-2")},
+		{Payload: []byte("report-3")},
+	}
+	for i, req := range reqs {
+		require.NoError(t, orm.InsertTransmitRequest(sURL, sampleFeedID, req, newTestReportContext(uint32(i+1), 1)))
+	}
+	// duplicates are ignored
+	require.NoError(t, orm.InsertTransmitRequest(sURL, sampleFeedID, reqs[0], newTestReportContext(1, 1)))
+	require.NoError(t, orm.InsertTransmitRequest(sURL, otherFeedID, reqs[0], newTestReportContext(1, 1)))
+
+	transmissions, err := orm.GetTransmitRequests(sURL, sampleFeedID, 10)
+	require.NoError(t, err)
+	require.Len(t, transmissions, 3)
+	// latest first
+	assert.Equal(t, reqs[2].Payload, transmissions[0].Req.Payload)
+	assert.Equal(t, newTestReportContext(3, 1), transmissions[0].ReportCtx)
+	assert.Equal(t, reqs[0].Payload, transmissions[2].Req.Payload)
+
+	require.NoError(t, orm.DeleteTransmitRequests(sURL, sampleFeedID, []*pb.TransmitRequest{reqs[1]}))
+	transmissions, err = orm.GetTransmitRequests(sURL, sampleFeedID, 10)
+	require.NoError(t, err)
+	require.Len(t, transmissions, 2)
+
+	require.NoError(t, orm.PruneTransmitRequests(sURL, sampleFeedID, 1))
+	transmissions, err = orm.GetTransmitRequests(sURL, sampleFeedID, 10)
+	require.NoError(t, err)
+	require.Len(t, transmissions, 1)
+	assert.Equal(t, reqs[2].Payload, transmissions[0].Req.Payload)
+
+	// other feeds are untouched
+	transmissions, err = orm.GetTransmitRequests(sURL, otherFeedID, 10)
+	require.NoError(t, err)
+	require.Len(t, transmissions, 1)
+
+	// other servers are untouched
+	require.NoError(t, orm.InsertTransmitRequest(sURL2, sampleFeedID, reqs[0], newTestReportContext(1, 1)))
+	require.NoError(t, orm.PruneTransmitRequests(sURL, sampleFeedID, 0))
+	transmissions, err = orm.GetTransmitRequests(sURL2, sampleFeedID, 10)
+	require.NoError(t, err)
+	require.Len(t, transmissions, 1)
+}
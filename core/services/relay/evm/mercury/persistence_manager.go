@@ -0,0 +1,154 @@
+package mercury
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc/pb"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+var (
+	flushDeletesFrequency = time.Second
+	pruneFrequency        = time.Hour
+)
+
+// PersistenceManager mirrors the contents of a feed's TransmitQueue into the
+// database. Deletes are batched and flushed in the background, and the table
+// is periodically pruned down to the maximum queue size.
+type PersistenceManager struct {
+	utils.StartStopOnce
+
+	lggr      logger.Logger
+	orm       ORM
+	serverURL string
+	feedID    [32]byte
+
+	stopCh utils.StopChan
+	wg     sync.WaitGroup
+
+	deleteMu    sync.Mutex
+	deleteQueue []*pb.TransmitRequest
+
+	maxTransmitQueueSize  int
+	flushDeletesFrequency time.Duration
+	pruneFrequency        time.Duration
+}
+
+func NewPersistenceManager(lggr logger.Logger, orm ORM, serverURL string, feedID [32]byte, maxTransmitQueueSize int, flushDeletesFrequency, pruneFrequency time.Duration) *PersistenceManager {
+	return &PersistenceManager{
+		lggr:                  lggr.Named("MercuryPersistenceManager"),
+		orm:                   orm,
+		serverURL:             serverURL,
+		feedID:                feedID,
+		stopCh:                make(utils.StopChan),
+		maxTransmitQueueSize:  maxTransmitQueueSize,
+		flushDeletesFrequency: flushDeletesFrequency,
+		pruneFrequency:        pruneFrequency,
+	}
+}
+
+func (pm *PersistenceManager) Start(ctx context.Context) error {
+	return pm.StartOnce("MercuryPersistenceManager", func() error {
+		pm.wg.Add(2)
+		go pm.runFlushDeletesLoop()
+		go pm.runPruneLoop()
+		return nil
+	})
+}
+
+func (pm *PersistenceManager) Close() error {
+	return pm.StopOnce("MercuryPersistenceManager", func() error {
+		close(pm.stopCh)
+		pm.wg.Wait()
+		return nil
+	})
+}
+
+func (pm *PersistenceManager) Insert(ctx context.Context, req *pb.TransmitRequest, reportCtx ocrtypes.ReportContext) error {
+	return pm.orm.InsertTransmitRequest(pm.serverURL, pm.feedID, req, reportCtx, pg.WithParentCtx(ctx))
+}
+
+func (pm *PersistenceManager) Delete(ctx context.Context, req *pb.TransmitRequest) error {
+	return pm.orm.DeleteTransmitRequests(pm.serverURL, pm.feedID, []*pb.TransmitRequest{req}, pg.WithParentCtx(ctx))
+}
+
+// AsyncDelete queues the request for deletion on the next flush.
+func (pm *PersistenceManager) AsyncDelete(req *pb.TransmitRequest) {
+	pm.deleteMu.Lock()
+	defer pm.deleteMu.Unlock()
+	pm.deleteQueue = append(pm.deleteQueue, req)
+}
+
+// Load returns transmissions persisted for the feed, latest epoch/round first.
+func (pm *PersistenceManager) Load(ctx context.Context) ([]*Transmission, error) {
+	return pm.orm.GetTransmitRequests(pm.serverURL, pm.feedID, pm.maxTransmitQueueSize, pg.WithParentCtx(ctx))
+}
+
+func (pm *PersistenceManager) runFlushDeletesLoop() {
+	defer pm.wg.Done()
+
+	ctx, cancel := pm.stopCh.Ctx(context.Background())
+	defer cancel()
+
+	ticker := time.NewTicker(utils.WithJitter(pm.flushDeletesFrequency))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			queuedReqs := pm.resetDeleteQueue()
+			if len(queuedReqs) == 0 {
+				continue
+			}
+			if err := pm.orm.DeleteTransmitRequests(pm.serverURL, pm.feedID, queuedReqs, pg.WithParentCtx(ctx)); err != nil {
+				pm.lggr.Errorw("Failed to delete queued transmit requests", "err", err)
+				pm.addToDeleteQueue(queuedReqs...)
+			} else {
+				pm.lggr.Debugw("Deleted queued transmit requests", "count", len(queuedReqs))
+			}
+		}
+	}
+}
+
+func (pm *PersistenceManager) runPruneLoop() {
+	defer pm.wg.Done()
+
+	ctx, cancel := pm.stopCh.Ctx(context.Background())
+	defer cancel()
+
+	ticker := time.NewTicker(utils.WithJitter(pm.pruneFrequency))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := pm.orm.PruneTransmitRequests(pm.serverURL, pm.feedID, pm.maxTransmitQueueSize, pg.WithParentCtx(ctx), pg.WithLongQueryTimeout()); err != nil {
+				pm.lggr.Errorw("Failed to prune transmit requests table", "err", err)
+			} else {
+				pm.lggr.Debugw("Pruned transmit requests table")
+			}
+		}
+	}
+}
+
+func (pm *PersistenceManager) addToDeleteQueue(reqs ...*pb.TransmitRequest) {
+	pm.deleteMu.Lock()
+	defer pm.deleteMu.Unlock()
+	pm.deleteQueue = append(pm.deleteQueue, reqs...)
+}
+
+func (pm *PersistenceManager) resetDeleteQueue() []*pb.TransmitRequest {
+	pm.deleteMu.Lock()
+	defer pm.deleteMu.Unlock()
+	queue := pm.deleteQueue
+	pm.deleteQueue = nil
+	return queue
+}
@@ -0,0 +1,51 @@
+package mercury
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc/pb"
+)
+
+func TestPersistenceManager(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	lggr := logger.TestLogger(t)
+	orm := NewORM(db, lggr, pgtest.NewQConfig(true))
+	pm := NewPersistenceManager(lggr, orm, sURL, sampleFeedID, 2, 10*time.Millisecond, time.Hour)
+
+	ctx := testutils.Context(t)
+	require.NoError(t, pm.Start(ctx))
+	t.Cleanup(func() {
+		assert.NoError(t, pm.Close())
+	})
+
+	reqs := []*pb.TransmitRequest{
+		{Payload: []byte("report-1")},
+		{Payload: []byte("report-2")},
+		{Payload: []byte("report-3")},
+	}
+	for i, req := range reqs {
+		require.NoError(t, pm.Insert(ctx, req, newTestReportContext(uint32(i+1), 1)))
+	}
+
+	// load is limited to the max queue size
+	transmissions, err := pm.Load(ctx)
+	require.NoError(t, err)
+	require.Len(t, transmissions, 2)
+	assert.Equal(t, reqs[2].Payload, transmissions[0].Req.Payload)
+
+	require.NoError(t, pm.Delete(ctx, reqs[2]))
+	pm.AsyncDelete(reqs[1])
+	require.Eventually(t, func() bool {
+		transmissions, err = pm.Load(ctx)
+		require.NoError(t, err)
+		return len(transmissions) == 1
+	}, testutils.WaitTimeout(t), 10*time.Millisecond)
+	assert.Equal(t, reqs[0].Payload, transmissions[0].Req.Payload)
+}
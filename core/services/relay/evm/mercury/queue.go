@@ -19,13 +19,17 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
+type asyncDeleter interface {
+	AsyncDelete(req *pb.TransmitRequest)
+}
+
 var _ services.ServiceCtx = (*TransmitQueue)(nil)
 
 var transmitQueueLoad = promauto.NewGaugeVec(prometheus.GaugeOpts{
 	Name: "mercury_transmit_queue_load",
 	Help: "Percent of transmit queue capacity used",
 },
-	[]string{"feedID", "capacity"},
+	[]string{"feedID", "serverURL", "capacity"},
 )
 
 // Prometheus' default interval is 15s, set this to under 7.5s to avoid
@@ -45,6 +49,9 @@ type TransmitQueue struct {
 	maxlen int
 	closed bool
 
+	// asyncDeleter removes evicted transmissions from persistent storage, can be nil
+	asyncDeleter asyncDeleter
+
 	// monitor loop
 	stopMonitor       func()
 	transmitQueueLoad prometheus.Gauge
@@ -62,14 +69,29 @@ type Transmission struct {
 
 // maxlen controls how many items will be stored in the queue
 // 0 means unlimited - be careful, this can cause memory leaks
-func NewTransmitQueue(lggr logger.Logger, feedID string, maxlen int) *TransmitQueue {
+func NewTransmitQueue(lggr logger.Logger, serverURL, feedID string, maxlen int, asyncDeleter asyncDeleter) *TransmitQueue {
 	pq := new(priorityQueue)
 	heap.Init(pq) // for completeness
 	mu := new(sync.RWMutex)
 	return &TransmitQueue{
-		utils.StartStopOnce{}, sync.Cond{L: mu}, lggr.Named("TransmitQueue"), mu, pq, maxlen, false, nil,
-		transmitQueueLoad.WithLabelValues(feedID, fmt.Sprintf("%d", maxlen)),
+		utils.StartStopOnce{}, sync.Cond{L: mu}, lggr.Named("TransmitQueue"), mu, pq, maxlen, false, asyncDeleter, nil,
+		transmitQueueLoad.WithLabelValues(feedID, serverURL, fmt.Sprintf("%d", maxlen)),
+	}
+}
+
+// Init populates the queue with transmissions persisted before a restart
+// It should be called before Start
+func (tq *TransmitQueue) Init(transmissions []*Transmission) {
+	tq.cond.L.Lock()
+	defer tq.cond.L.Unlock()
+
+	for _, t := range transmissions {
+		if tq.maxlen != 0 && tq.pq.Len() == tq.maxlen {
+			break
+		}
+		heap.Push(tq.pq, t)
 	}
+	tq.cond.Signal()
 }
 
 func (tq *TransmitQueue) Push(req *pb.TransmitRequest, reportCtx ocrtypes.ReportContext) (ok bool) {
@@ -83,7 +105,10 @@ func (tq *TransmitQueue) Push(req *pb.TransmitRequest, reportCtx ocrtypes.Report
 	if tq.maxlen != 0 && tq.pq.Len() == tq.maxlen {
 		// evict oldest entry to make room
 		tq.lggr.Criticalf("Transmit queue is full; dropping oldest transmission (reached max length of %d)", tq.maxlen)
-		heap.Remove(tq.pq, tq.pq.Len()-1)
+		removed := heap.Remove(tq.pq, tq.pq.Len()-1)
+		if transmission, ok := removed.(*Transmission); ok && tq.asyncDeleter != nil {
+			tq.asyncDeleter.AsyncDelete(transmission.Req)
+		}
 	}
 
 	heap.Push(tq.pq, &Transmission{req, reportCtx, -1})
@@ -44,25 +44,25 @@ var (
 		Name: "mercury_transmit_success_count",
 		Help: "Number of successful transmissions (duplicates are counted as success)",
 	},
-		[]string{"feedID"},
+		[]string{"feedID", "serverURL"},
 	)
 	transmitDuplicateCount = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "mercury_transmit_duplicate_count",
 		Help: "Number of transmissions where the server told us it was a duplicate",
 	},
-		[]string{"feedID"},
+		[]string{"feedID", "serverURL"},
 	)
 	transmitConnectionErrorCount = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "mercury_transmit_connection_error_count",
 		Help: "Number of errored transmissions that failed due to problem with the connection",
 	},
-		[]string{"feedID"},
+		[]string{"feedID", "serverURL"},
 	)
 	transmitServerErrorCount = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "mercury_transmit_server_error_count",
 		Help: "Number of errored transmissions that failed due to an error returned by the mercury server",
 	},
-		[]string{"feedID", "code"},
+		[]string{"feedID", "serverURL", "code"},
 	)
 )
 
@@ -80,16 +80,29 @@ var _ Transmitter = &mercuryTransmitter{}
 type mercuryTransmitter struct {
 	utils.StartStopOnce
 	lggr       logger.Logger
-	rpcClient  wsrpc.Client
 	cfgTracker ConfigTracker
 
+	servers map[string]*server
+
 	feedID      [32]byte
 	feedIDHex   string
 	fromAccount string
 
 	stopCh utils.StopChan
-	queue  *TransmitQueue
 	wg     sync.WaitGroup
+}
+
+// server holds the transmit queue and connection for a single mercury server.
+// Each server receives every report independently of the others.
+type server struct {
+	lggr logger.Logger
+
+	c  wsrpc.Client
+	pm *PersistenceManager
+	q  *TransmitQueue
+
+	feedIDHex string
+	url       string
 
 	transmitSuccessCount         prometheus.Counter
 	transmitDuplicateCount       prometheus.Counter
@@ -115,45 +128,90 @@ func getPayloadTypes() abi.Arguments {
 	})
 }
 
-func NewTransmitter(lggr logger.Logger, cfgTracker ConfigTracker, rpcClient wsrpc.Client, fromAccount ed25519.PublicKey, feedID [32]byte) *mercuryTransmitter {
+func NewTransmitter(lggr logger.Logger, cfgTracker ConfigTracker, clients map[string]wsrpc.Client, fromAccount ed25519.PublicKey, feedID [32]byte, orm ORM) *mercuryTransmitter {
 	feedIDHex := fmt.Sprintf("0x%x", feedID[:])
+	lggr = lggr.Named("MercuryTransmitter").With("feedID", feedIDHex)
+	servers := make(map[string]*server, len(clients))
+	for serverURL, client := range clients {
+		sLggr := lggr.With("serverURL", serverURL)
+		pm := NewPersistenceManager(sLggr, orm, serverURL, feedID, MaxTransmitQueueSize, flushDeletesFrequency, pruneFrequency)
+		servers[serverURL] = &server{
+			sLggr,
+			client,
+			pm,
+			// Named per server, so that each queue reports its health under its own key
+			NewTransmitQueue(sLggr, serverURL, feedIDHex, MaxTransmitQueueSize, pm),
+			feedIDHex,
+			serverURL,
+			transmitSuccessCount.WithLabelValues(feedIDHex, serverURL),
+			transmitDuplicateCount.WithLabelValues(feedIDHex, serverURL),
+			transmitConnectionErrorCount.WithLabelValues(feedIDHex, serverURL),
+		}
+	}
 	return &mercuryTransmitter{
 		utils.StartStopOnce{},
-		lggr.Named("MercuryTransmitter").With("feedID", feedIDHex),
-		rpcClient,
+		lggr,
 		cfgTracker,
+		servers,
 		feedID,
 		feedIDHex,
 		fmt.Sprintf("%x", fromAccount),
 		make(chan (struct{})),
-		NewTransmitQueue(lggr, feedIDHex, MaxTransmitQueueSize),
 		sync.WaitGroup{},
-		transmitSuccessCount.WithLabelValues(feedIDHex),
-		transmitDuplicateCount.WithLabelValues(feedIDHex),
-		transmitConnectionErrorCount.WithLabelValues(feedIDHex),
 	}
 }
 
 func (mt *mercuryTransmitter) Start(ctx context.Context) (err error) {
 	return mt.StartOnce("MercuryTransmitter", func() error {
-		if err := mt.rpcClient.Start(ctx); err != nil {
-			return err
-		}
-		if err := mt.queue.Start(ctx); err != nil {
-			return err
+		var started []*server
+		for _, s := range mt.servers {
+			if err := s.start(ctx); err != nil {
+				// Stop the servers that were already started
+				close(mt.stopCh)
+				for _, ss := range started {
+					err = errors.Join(err, ss.q.Close())
+				}
+				mt.wg.Wait()
+				for _, ss := range started {
+					err = errors.Join(err, ss.pm.Close(), ss.c.Close())
+				}
+				return err
+			}
+			started = append(started, s)
+			mt.wg.Add(1)
+			go s.runloop(mt.stopCh, &mt.wg)
 		}
-		mt.wg.Add(1)
-		go mt.runloop()
 		return nil
 	})
 }
 
+// start starts the connection, persistence manager and transmit queue of the
+// server. Whatever was started is closed again if a later step fails.
+func (s *server) start(ctx context.Context) error {
+	var ms services.MultiStart
+	if err := ms.Start(ctx, s.c, s.pm); err != nil {
+		return err
+	}
+	transmissions, err := s.pm.Load(ctx)
+	if err != nil {
+		return ms.CloseBecause(err)
+	}
+	s.lggr.Debugw("Loaded persisted transmissions", "count", len(transmissions))
+	s.q.Init(transmissions)
+	return ms.Start(ctx, s.q)
+}
+
 func (mt *mercuryTransmitter) Close() error {
-	return mt.StopOnce("MercuryTransmitter", func() error {
-		mt.queue.Close()
+	return mt.StopOnce("MercuryTransmitter", func() (err error) {
+		for _, s := range mt.servers {
+			err = errors.Join(err, s.q.Close())
+		}
 		close(mt.stopCh)
 		mt.wg.Wait()
-		return mt.rpcClient.Close()
+		for _, s := range mt.servers {
+			err = errors.Join(err, s.pm.Close(), s.c.Close())
+		}
+		return err
 	})
 }
 func (mt *mercuryTransmitter) Ready() error { return mt.StartStopOnce.Ready() }
@@ -161,13 +219,18 @@ func (mt *mercuryTransmitter) Name() string { return mt.lggr.Name() }
 
 func (mt *mercuryTransmitter) HealthReport() map[string]error {
 	report := map[string]error{mt.Name(): mt.StartStopOnce.Healthy()}
-	maps.Copy(report, mt.rpcClient.HealthReport())
-	maps.Copy(report, mt.queue.HealthReport())
+	for _, s := range mt.servers {
+		maps.Copy(report, s.c.HealthReport())
+		// Every server has a queue with the same name, so their reports are told apart by server.
+		for name, err := range s.q.HealthReport() {
+			report[fmt.Sprintf("%s(%s)", name, s.url)] = err
+		}
+	}
 	return report
 }
 
-func (mt *mercuryTransmitter) runloop() {
-	defer mt.wg.Done()
+func (s *server) runloop(stopCh utils.StopChan, wg *sync.WaitGroup) {
+	defer wg.Done()
 	// Exponential backoff with very short retry interval (since latency is a priority)
 	// 5ms, 10ms, 20ms, 40ms etc
 	b := backoff.Backoff{
@@ -176,26 +239,26 @@ func (mt *mercuryTransmitter) runloop() {
 		Factor: 2,
 		Jitter: true,
 	}
-	runloopCtx, cancel := mt.stopCh.Ctx(context.Background())
+	runloopCtx, cancel := stopCh.Ctx(context.Background())
 	defer cancel()
 	for {
-		t := mt.queue.BlockingPop()
+		t := s.q.BlockingPop()
 		if t == nil {
 			// queue was closed
 			return
 		}
 		ctx, cancel := context.WithTimeout(runloopCtx, utils.WithJitter(TransmitTimeout))
-		res, err := mt.rpcClient.Transmit(ctx, t.Req)
+		res, err := s.c.Transmit(ctx, t.Req)
 		cancel()
 		if runloopCtx.Err() != nil {
 			// runloop context is only canceled on transmitter close so we can
 			// exit the runloop here
 			return
 		} else if err != nil {
-			mt.transmitConnectionErrorCount.Inc()
-			mt.lggr.Errorw("Transmit report failed", "err", err, "reportCtx", t.ReportCtx)
-			if ok := mt.queue.Push(t.Req, t.ReportCtx); !ok {
-				mt.lggr.Error("Failed to push report to transmit queue; queue is closed")
+			s.transmitConnectionErrorCount.Inc()
+			s.lggr.Errorw("Transmit report failed", "err", err, "reportCtx", t.ReportCtx)
+			if ok := s.q.Push(t.Req, t.ReportCtx); !ok {
+				s.lggr.Error("Failed to push report to transmit queue; queue is closed")
 				return
 			}
 			// Wait a backoff duration before pulling the most recent transmission
@@ -203,24 +266,27 @@ func (mt *mercuryTransmitter) runloop() {
 			select {
 			case <-time.After(b.Duration()):
 				continue
-			case <-mt.stopCh:
+			case <-stopCh:
 				return
 			}
 		}
 
 		b.Reset()
+		// The mercury server either accepted or permanently rejected the
+		// report, so it no longer needs to be persisted
+		s.pm.AsyncDelete(t.Req)
 		if res.Error == "" {
-			mt.transmitSuccessCount.Inc()
-			mt.lggr.Tracew("Transmit report success", "req", t.Req, "response", res, "reportCtx", t.ReportCtx)
+			s.transmitSuccessCount.Inc()
+			s.lggr.Tracew("Transmit report success", "req", t.Req, "response", res, "reportCtx", t.ReportCtx)
 		} else {
 			// We don't need to retry here because the mercury server
 			// has confirmed it received the report. We only need to retry
 			// on networking/unknown errors
 			switch res.Code {
 			case DuplicateReport:
-				mt.transmitSuccessCount.Inc()
-				mt.transmitDuplicateCount.Inc()
-				mt.lggr.Tracew("Transmit report succeeded; duplicate report", "code", res.Code)
+				s.transmitSuccessCount.Inc()
+				s.transmitDuplicateCount.Inc()
+				s.lggr.Tracew("Transmit report succeeded; duplicate report", "code", res.Code)
 			default:
 				elems := map[string]interface{}{}
 				var validFrom int64
@@ -239,8 +305,8 @@ func (mt *mercuryTransmitter) runloop() {
 						unpackErr = errors.Join(unpackErr, err)
 					}
 				}
-				transmitServerErrorCount.WithLabelValues(mt.feedIDHex, fmt.Sprintf("%d", res.Code)).Inc()
-				mt.lggr.Errorw("Transmit report failed; mercury server returned error", "unpackErr", unpackErr, "validFromBlock", validFrom, "currentBlock", currentBlock, "response", res, "reportCtx", t.ReportCtx, "err", res.Error, "code", res.Code)
+				transmitServerErrorCount.WithLabelValues(s.feedIDHex, s.url, fmt.Sprintf("%d", res.Code)).Inc()
+				s.lggr.Errorw("Transmit report failed; mercury server returned error", "unpackErr", unpackErr, "validFromBlock", validFrom, "currentBlock", currentBlock, "response", res, "reportCtx", t.ReportCtx, "err", res.Error, "code", res.Code)
 			}
 		}
 	}
@@ -273,8 +339,24 @@ func (mt *mercuryTransmitter) Transmit(ctx context.Context, reportCtx ocrtypes.R
 
 	mt.lggr.Tracew("Transmit enqueue", "req", req, "report", report, "reportCtx", reportCtx, "signatures", signatures)
 
-	if ok := mt.queue.Push(req, reportCtx); !ok {
-		return errors.New("transmit queue is closed")
+	// Every server is attempted, so that a failing server does not prevent the others from receiving
+	// the report. It only fails if none of the servers accepted the report.
+	var merr error
+	var nSuccess int
+	for _, s := range mt.servers {
+		// Persistence only recovers the queue across restarts, so the report is still sent if it fails.
+		if err := s.pm.Insert(ctx, req, reportCtx); err != nil {
+			s.lggr.Errorw("Failed to persist report, sending it anyway", "err", err, "reportCtx", reportCtx)
+		}
+		if ok := s.q.Push(req, reportCtx); !ok {
+			s.lggr.Error("Failed to push report to transmit queue; queue is closed")
+			merr = errors.Join(merr, fmt.Errorf("transmit queue for %s is closed", s.url))
+			continue
+		}
+		nSuccess++
+	}
+	if nSuccess == 0 {
+		return merr
 	}
 	return nil
 }
@@ -289,31 +371,53 @@ func (mt *mercuryTransmitter) LatestConfigDigestAndEpoch(ctx context.Context) (c
 	panic("not needed for OCR3")
 }
 
+// FetchInitialMaxFinalizedBlockNumber queries all servers and returns the
+// highest block number reported by any of them. It only fails if none of the
+// servers could be queried successfully.
 func (mt *mercuryTransmitter) FetchInitialMaxFinalizedBlockNumber(ctx context.Context) (*int64, error) {
 	mt.lggr.Trace("FetchInitialMaxFinalizedBlockNumber")
+	var maxBlockNum *int64
+	var merr error
+	var nSuccess int
+	for _, s := range mt.servers {
+		blockNum, err := s.fetchLatestBlockNumber(ctx, mt.feedID)
+		if err != nil {
+			s.lggr.Errorw("FetchInitialMaxFinalizedBlockNumber failed", "err", err)
+			merr = errors.Join(merr, err)
+			continue
+		}
+		nSuccess++
+		if blockNum != nil && (maxBlockNum == nil || *blockNum > *maxBlockNum) {
+			maxBlockNum = blockNum
+		}
+	}
+	if nSuccess == 0 {
+		return nil, merr
+	}
+	return maxBlockNum, nil
+}
+
+func (s *server) fetchLatestBlockNumber(ctx context.Context, feedID [32]byte) (*int64, error) {
 	req := &pb.LatestReportRequest{
-		FeedId: mt.feedID[:],
+		FeedId: feedID[:],
 	}
-	resp, err := mt.rpcClient.LatestReport(ctx, req)
+	resp, err := s.c.LatestReport(ctx, req)
 	if err != nil {
-		mt.lggr.Errorw("FetchInitialMaxFinalizedBlockNumber failed", "err", err)
 		return nil, pkgerrors.Wrap(err, "FetchInitialMaxFinalizedBlockNumber failed to fetch LatestReport")
 	}
 	if resp == nil {
 		return nil, errors.New("FetchInitialMaxFinalizedBlockNumber expected LatestReport to return non-nil response")
 	}
 	if resp.Error != "" {
-		err = errors.New(resp.Error)
-		mt.lggr.Errorw("FetchInitialMaxFinalizedBlockNumber failed; mercury server returned error", "err", err)
-		return nil, err
+		return nil, fmt.Errorf("FetchInitialMaxFinalizedBlockNumber failed; mercury server returned error: %s", resp.Error)
 	}
 	if resp.Report == nil {
 		return nil, nil
-	} else if !bytes.Equal(resp.Report.FeedId, mt.feedID[:]) {
-		return nil, fmt.Errorf("FetchInitialMaxFinalizedBlockNumber failed; mismatched feed IDs, expected: 0x%x, got: 0x%x", mt.feedID, resp.Report.FeedId)
+	} else if !bytes.Equal(resp.Report.FeedId, feedID[:]) {
+		return nil, fmt.Errorf("FetchInitialMaxFinalizedBlockNumber failed; mismatched feed IDs, expected: 0x%x, got: 0x%x", feedID, resp.Report.FeedId)
 	}
 
-	mt.lggr.Debugw("FetchInitialMaxFinalizedBlockNumber success", "currentBlockNum", resp.Report.CurrentBlockNumber)
+	s.lggr.Debugw("FetchInitialMaxFinalizedBlockNumber success", "currentBlockNum", resp.Report.CurrentBlockNumber)
 
 	return &resp.Report.CurrentBlockNumber, nil
 }
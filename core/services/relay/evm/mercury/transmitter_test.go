@@ -2,6 +2,7 @@ package mercury
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -12,7 +13,10 @@ import (
 	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
 
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc"
 	mocks "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc/pb"
 )
@@ -27,10 +31,17 @@ func (m MockTracker) LatestConfigDetails(ctx context.Context) (changedInBlock ui
 
 var _ ConfigTracker = &MockTracker{}
 
+const (
+	sURL  = "wss://example.com/mercury"
+	sURL2 = "wss://mercuryserver.test"
+)
+
 func Test_MercuryTransmitter_Transmit(t *testing.T) {
 	t.Parallel()
 
 	lggr := logger.TestLogger(t)
+	db := pgtest.NewSqlxDB(t)
+	orm := NewORM(db, lggr, pgtest.NewQConfig(true))
 
 	t.Run("transmission successfully enqueued", func(t *testing.T) {
 		c := mocks.MockWSRPCClient{
@@ -43,17 +54,161 @@ func Test_MercuryTransmitter_Transmit(t *testing.T) {
 				return out, nil
 			},
 		}
-		mt := NewTransmitter(lggr, nil, c, sampleClientPubKey, sampleFeedID)
+		mt := NewTransmitter(lggr, nil, map[string]wsrpc.Client{sURL: c}, sampleClientPubKey, sampleFeedID, orm)
 		err := mt.Transmit(testutils.Context(t), sampleReportContext, sampleReport, sampleSigs)
 
 		require.NoError(t, err)
 	})
 }
 
+func Test_MercuryTransmitter_Transmit_MultipleServers(t *testing.T) {
+	t.Parallel()
+
+	lggr := logger.TestLogger(t)
+	db := pgtest.NewSqlxDB(t)
+	orm := NewORM(db, lggr, pgtest.NewQConfig(true))
+
+	clients := map[string]wsrpc.Client{
+		sURL:  mocks.MockWSRPCClient{},
+		sURL2: mocks.MockWSRPCClient{},
+	}
+	mt := NewTransmitter(lggr, nil, clients, sampleClientPubKey, sampleFeedID, orm)
+	require.NoError(t, mt.Transmit(testutils.Context(t), sampleReportContext, sampleReport, sampleSigs))
+
+	// each server has its own queue and persisted copy of the report
+	for serverURL, s := range mt.servers {
+		assert.False(t, s.q.IsEmpty())
+		transmissions, err := orm.GetTransmitRequests(serverURL, sampleFeedID, 10)
+		require.NoError(t, err)
+		require.Len(t, transmissions, 1)
+		assert.Equal(t, samplePayload, transmissions[0].Req.Payload)
+	}
+}
+
+func Test_MercuryTransmitter_Transmit_MultipleServers_OneFailing(t *testing.T) {
+	t.Parallel()
+
+	lggr := logger.TestLogger(t)
+	db := pgtest.NewSqlxDB(t)
+	orm := NewORM(db, lggr, pgtest.NewQConfig(true))
+
+	clients := map[string]wsrpc.Client{
+		sURL:  mocks.MockWSRPCClient{},
+		sURL2: mocks.MockWSRPCClient{},
+	}
+	mt := NewTransmitter(lggr, nil, clients, sampleClientPubKey, sampleFeedID, orm)
+	for _, s := range mt.servers {
+		require.NoError(t, s.q.Start(testutils.Context(t)))
+	}
+	require.NoError(t, mt.servers[sURL].q.Close())
+
+	// the report still reaches the working server
+	require.NoError(t, mt.Transmit(testutils.Context(t), sampleReportContext, sampleReport, sampleSigs))
+	assert.False(t, mt.servers[sURL2].q.IsEmpty())
+
+	// and only fails once no server accepted it
+	require.NoError(t, mt.servers[sURL2].q.Close())
+	err := mt.Transmit(testutils.Context(t), sampleReportContext, sampleReport, sampleSigs)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "transmit queue for "+sURL+" is closed")
+	assert.Contains(t, err.Error(), "transmit queue for "+sURL2+" is closed")
+}
+
+type failingInsertORM struct {
+	ORM
+}
+
+func (failingInsertORM) InsertTransmitRequest(string, [32]byte, *pb.TransmitRequest, ocrtypes.ReportContext, ...pg.QOpt) error {
+	return errors.New("insert failed")
+}
+
+func Test_MercuryTransmitter_Transmit_PersistFails(t *testing.T) {
+	t.Parallel()
+
+	lggr := logger.TestLogger(t)
+	mt := NewTransmitter(lggr, nil, map[string]wsrpc.Client{sURL: mocks.MockWSRPCClient{}}, sampleClientPubKey, sampleFeedID, failingInsertORM{})
+
+	// the report is still sent, it only won't survive a restart
+	require.NoError(t, mt.Transmit(testutils.Context(t), sampleReportContext, sampleReport, sampleSigs))
+	assert.False(t, mt.servers[sURL].q.IsEmpty())
+}
+
+type failingLoadORM struct {
+	ORM
+}
+
+func (failingLoadORM) GetTransmitRequests(string, [32]byte, int, ...pg.QOpt) ([]*Transmission, error) {
+	return nil, errors.New("load failed")
+}
+
+func Test_MercuryTransmitter_Start_LoadFails(t *testing.T) {
+	t.Parallel()
+
+	lggr := logger.TestLogger(t)
+	mt := NewTransmitter(lggr, nil, map[string]wsrpc.Client{sURL: mocks.MockWSRPCClient{}}, sampleClientPubKey, sampleFeedID, failingLoadORM{})
+	require.EqualError(t, mt.Start(testutils.Context(t)), "load failed")
+
+	// the persistence manager is not left running
+	assert.Error(t, mt.servers[sURL].pm.Ready())
+}
+
+// failingServerLoadORM fails to load the persisted transmissions of one server only
+type failingServerLoadORM struct {
+	ORM
+	serverURL string
+}
+
+func (o failingServerLoadORM) GetTransmitRequests(serverURL string, _ [32]byte, _ int, _ ...pg.QOpt) ([]*Transmission, error) {
+	if serverURL == o.serverURL {
+		return nil, errors.New("load failed")
+	}
+	return nil, nil
+}
+
+func Test_MercuryTransmitter_Start_OneServerFails(t *testing.T) {
+	t.Parallel()
+
+	lggr := logger.TestLogger(t)
+	clients := map[string]wsrpc.Client{
+		sURL:  mocks.MockWSRPCClient{},
+		sURL2: mocks.MockWSRPCClient{},
+	}
+	mt := NewTransmitter(lggr, nil, clients, sampleClientPubKey, sampleFeedID, failingServerLoadORM{serverURL: sURL2})
+	require.EqualError(t, mt.Start(testutils.Context(t)), "load failed")
+
+	// no server is left running
+	for _, s := range mt.servers {
+		assert.Error(t, s.pm.Ready())
+		assert.Error(t, s.q.StartStopOnce.Ready())
+	}
+}
+
+func Test_MercuryTransmitter_HealthReport(t *testing.T) {
+	t.Parallel()
+
+	lggr := logger.TestLogger(t)
+	clients := map[string]wsrpc.Client{
+		sURL:  mocks.MockWSRPCClient{},
+		sURL2: mocks.MockWSRPCClient{},
+	}
+	mt := NewTransmitter(lggr, nil, clients, sampleClientPubKey, sampleFeedID, failingServerLoadORM{})
+
+	// every server's queue reports its own health
+	report := mt.HealthReport()
+	assert.Len(t, report, 3)
+	assert.Contains(t, report, mt.Name())
+	for _, s := range mt.servers {
+		assert.Equal(t, "MercuryTransmitter.TransmitQueue", s.q.Name())
+		assert.Contains(t, report, fmt.Sprintf("%s(%s)", s.q.Name(), s.url))
+	}
+}
+
 func Test_MercuryTransmitter_FetchInitialMaxFinalizedBlockNumber(t *testing.T) {
 	t.Parallel()
 
 	lggr := logger.TestLogger(t)
+	db := pgtest.NewSqlxDB(t)
+	orm := NewORM(db, lggr, pgtest.NewQConfig(true))
 
 	t.Run("successful query", func(t *testing.T) {
 		c := mocks.MockWSRPCClient{
@@ -67,7 +222,7 @@ func Test_MercuryTransmitter_FetchInitialMaxFinalizedBlockNumber(t *testing.T) {
 				return out, nil
 			},
 		}
-		mt := NewTransmitter(lggr, nil, c, sampleClientPubKey, sampleFeedID)
+		mt := NewTransmitter(lggr, nil, map[string]wsrpc.Client{sURL: c}, sampleClientPubKey, sampleFeedID, orm)
 		bn, err := mt.FetchInitialMaxFinalizedBlockNumber(testutils.Context(t))
 		require.NoError(t, err)
 
@@ -82,7 +237,7 @@ func Test_MercuryTransmitter_FetchInitialMaxFinalizedBlockNumber(t *testing.T) {
 				return out, nil
 			},
 		}
-		mt := NewTransmitter(lggr, nil, c, sampleClientPubKey, sampleFeedID)
+		mt := NewTransmitter(lggr, nil, map[string]wsrpc.Client{sURL: c}, sampleClientPubKey, sampleFeedID, orm)
 		bn, err := mt.FetchInitialMaxFinalizedBlockNumber(testutils.Context(t))
 		require.NoError(t, err)
 
@@ -94,11 +249,39 @@ func Test_MercuryTransmitter_FetchInitialMaxFinalizedBlockNumber(t *testing.T) {
 				return nil, errors.New("something exploded")
 			},
 		}
-		mt := NewTransmitter(lggr, nil, c, sampleClientPubKey, sampleFeedID)
+		mt := NewTransmitter(lggr, nil, map[string]wsrpc.Client{sURL: c}, sampleClientPubKey, sampleFeedID, orm)
 		_, err := mt.FetchInitialMaxFinalizedBlockNumber(testutils.Context(t))
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "something exploded")
 	})
+	t.Run("multiple servers, one failing", func(t *testing.T) {
+		newClient := func(blockNum int64) wsrpc.Client {
+			return mocks.MockWSRPCClient{
+				LatestReportF: func(ctx context.Context, in *pb.LatestReportRequest) (out *pb.LatestReportResponse, err error) {
+					out = new(pb.LatestReportResponse)
+					out.Report = new(pb.Report)
+					out.Report.FeedId = sampleFeedID[:]
+					out.Report.CurrentBlockNumber = blockNum
+					return out, nil
+				},
+			}
+		}
+		clients := map[string]wsrpc.Client{
+			sURL:  newClient(42),
+			sURL2: newClient(43),
+			"wss://failing.test": mocks.MockWSRPCClient{
+				LatestReportF: func(ctx context.Context, in *pb.LatestReportRequest) (out *pb.LatestReportResponse, err error) {
+					return nil, errors.New("something exploded")
+				},
+			},
+		}
+		mt := NewTransmitter(lggr, nil, clients, sampleClientPubKey, sampleFeedID, orm)
+		bn, err := mt.FetchInitialMaxFinalizedBlockNumber(testutils.Context(t))
+		require.NoError(t, err)
+
+		require.NotNil(t, bn)
+		assert.Equal(t, 43, int(*bn))
+	})
 	t.Run("return feed ID is wrong", func(t *testing.T) {
 		c := mocks.MockWSRPCClient{
 			LatestReportF: func(ctx context.Context, in *pb.LatestReportRequest) (out *pb.LatestReportResponse, err error) {
@@ -111,7 +294,7 @@ func Test_MercuryTransmitter_FetchInitialMaxFinalizedBlockNumber(t *testing.T) {
 				return out, nil
 			},
 		}
-		mt := NewTransmitter(lggr, nil, c, sampleClientPubKey, sampleFeedID)
+		mt := NewTransmitter(lggr, nil, map[string]wsrpc.Client{sURL: c}, sampleClientPubKey, sampleFeedID, orm)
 		_, err := mt.FetchInitialMaxFinalizedBlockNumber(testutils.Context(t))
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "FetchInitialMaxFinalizedBlockNumber failed; mismatched feed IDs, expected: 0x1c916b4aa7e57ca7b68ae1bf45653f56b656fd3aa335ef7fae696b663f1b8472, got: 0x")
@@ -202,7 +202,7 @@ func (w *client) Close() error {
 }
 
 func (w *client) Name() string {
-	return "EVM.Mercury.WSRPCClient"
+	return fmt.Sprintf("EVM.Mercury.WSRPCClient(%s)", w.serverURL)
 }
 
 func (w *client) HealthReport() map[string]error {
@@ -40,6 +40,8 @@ type Metadata struct {
 	Confirmed bool
 	// Signature contains the original user signature.
 	Signature []byte
+	// Version is the data version of the record.
+	Version uint64
 }
 
 //go:generate mockery --quiet --name Storage --output ./mocks/ --case=underscore
@@ -110,6 +112,7 @@ func (s *storage) Get(ctx context.Context, key *Key) (*Record, *Metadata, error)
 	metadata := &Metadata{
 		Confirmed: row.Confirmed,
 		Signature: make([]byte, len(row.Signature)),
+		Version:   row.Version,
 	}
 	copy(metadata.Signature, row.Signature)
 
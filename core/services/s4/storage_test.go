@@ -180,6 +180,7 @@ func TestStorage_PutAndGet(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, false, metadata.Confirmed)
 	assert.Equal(t, signature, metadata.Signature)
+	assert.Equal(t, key.Version, metadata.Version)
 	assert.Equal(t, record.Expiration, rec.Expiration)
 	assert.Equal(t, record.Payload, rec.Payload)
 }
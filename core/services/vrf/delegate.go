@@ -17,6 +17,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/log"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
 	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/aggregator_v3_interface"
 	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/batch_vrf_coordinator_v2"
 	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/solidity_vrf_coordinator_interface"
@@ -31,13 +32,14 @@ import (
 )
 
 type Delegate struct {
-	q       pg.Q
-	pr      pipeline.Runner
-	porm    pipeline.ORM
-	ks      keystore.Master
-	cc      evm.ChainSet
-	lggr    logger.Logger
-	mailMon *utils.MailboxMonitor
+	q           pg.Q
+	pr          pipeline.Runner
+	porm        pipeline.ORM
+	pendingReqs PendingRequestsORM
+	ks          keystore.Master
+	cc          evm.ChainSet
+	lggr        logger.Logger
+	mailMon     *utils.MailboxMonitor
 }
 
 type GethKeyStore interface {
@@ -66,13 +68,14 @@ func NewDelegate(
 	cfg pg.QConfig,
 	mailMon *utils.MailboxMonitor) *Delegate {
 	return &Delegate{
-		q:       pg.NewQ(db, lggr, cfg),
-		ks:      ks,
-		pr:      pr,
-		porm:    porm,
-		cc:      chainSet,
-		lggr:    lggr,
-		mailMon: mailMon,
+		q:           pg.NewQ(db, lggr, cfg),
+		ks:          ks,
+		pr:          pr,
+		porm:        porm,
+		pendingReqs: NewPendingRequestsORM(db, lggr, cfg),
+		cc:          chainSet,
+		lggr:        lggr,
+		mailMon:     mailMon,
 	}
 }
 
@@ -80,10 +83,27 @@ func (d *Delegate) JobType() job.Type {
 	return job.VRF
 }
 
-func (d *Delegate) BeforeJobCreated(spec job.Job)                {}
-func (d *Delegate) AfterJobCreated(spec job.Job)                 {}
-func (d *Delegate) BeforeJobDeleted(spec job.Job)                {}
-func (d *Delegate) OnDeleteJob(spec job.Job, q pg.Queryer) error { return nil }
+func (d *Delegate) BeforeJobCreated(spec job.Job) {}
+func (d *Delegate) AfterJobCreated(spec job.Job)  {}
+func (d *Delegate) BeforeJobDeleted(spec job.Job) {}
+
+// OnDeleteJob unregisters the log poller filter of VRF v2 jobs using the log poller.
+func (d *Delegate) OnDeleteJob(jb job.Job, q pg.Queryer) error {
+	if jb.VRFSpec == nil || !jb.VRFSpec.LogPollerEnabled {
+		return nil
+	}
+	chain, err := d.cc.Get(jb.VRFSpec.EVMChainID.ToInt())
+	if err != nil {
+		// If the chain doesn't exist anymore, there is no filter to clean up.
+		d.lggr.Errorw("Failed to get chain of VRF job, not unregistering its log poller filter", "err", err, "jobID", jb.ID)
+		return nil
+	}
+	if chain.LogPoller() == logpoller.LogPollerDisabled {
+		return nil
+	}
+	filter := logPollerFilterName(jb.ID, jb.VRFSpec.CoordinatorAddress.Address())
+	return errors.Wrapf(chain.LogPoller().UnregisterFilter(filter, q), "failed to unregister filter %s", filter)
+}
 
 // ServicesForSpec satisfies the job.Delegate interface.
 func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.ServiceCtx, error) {
@@ -154,6 +174,10 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.ServiceCtx, error) {
 				return nil, err
 			}
 
+			if jb.VRFSpec.LogPollerEnabled && chain.LogPoller() == logpoller.LogPollerDisabled {
+				return nil, errors.New("logPollerEnabled is set on the job, but the log poller is disabled on the node, please set Feature.LogPoller = true")
+			}
+
 			linkEthFeedAddress, err := coordinatorV2.LINKETHFEED(nil)
 			if err != nil {
 				return nil, errors.Wrap(err, "LINKETHFEED")
@@ -170,7 +194,9 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.ServiceCtx, error) {
 				chain.Client(),
 				chain.ID(),
 				chain.LogBroadcaster(),
+				chain.LogPoller(),
 				d.q,
+				d.pendingReqs,
 				coordinatorV2,
 				batchCoordinatorV2,
 				vrfOwner,
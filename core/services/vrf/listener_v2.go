@@ -3,6 +3,7 @@ package vrf
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"math"
 	"math/big"
@@ -14,18 +15,21 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/pkg/errors"
 	heaps "github.com/theodesp/go-heaps"
 	"github.com/theodesp/go-heaps/pairing"
 	"go.uber.org/multierr"
 	"golang.org/x/exp/slices"
+	"gopkg.in/guregu/null.v4"
 
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/assets"
 	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
 	httypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/log"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/aggregator_v3_interface"
@@ -84,7 +88,9 @@ func newListenerV2(
 	ethClient evmclient.Client,
 	chainID *big.Int,
 	logBroadcaster log.Broadcaster,
+	logPoller logpoller.LogPoller,
 	q pg.Q,
+	pendingReqs PendingRequestsORM,
 	coordinator vrf_coordinator_v2.VRFCoordinatorV2Interface,
 	batchCoordinator batch_vrf_coordinator_v2.BatchVRFCoordinatorV2Interface,
 	vrfOwner vrf_owner.VRFOwnerInterface,
@@ -100,6 +106,14 @@ func newListenerV2(
 	headBroadcaster httypes.HeadBroadcasterRegistry,
 	deduper *logDeduper,
 ) *listenerV2 {
+	var consumer logConsumer = logBroadcaster
+	if job.VRFSpec.LogPollerEnabled {
+		consumer = logPollerConsumer{
+			jobID:        job.ID,
+			pendingReqs:  pendingReqs,
+			parseRequest: coordinator.ParseRandomWordsRequested,
+		}
+	}
 	return &listenerV2{
 		cfg:                cfg,
 		feeCfg:             feeCfg,
@@ -107,6 +121,8 @@ func newListenerV2(
 		ethClient:          ethClient,
 		chainID:            chainID,
 		logBroadcaster:     logBroadcaster,
+		logPoller:          logPoller,
+		consumer:           consumer,
 		txm:                txm,
 		mailMon:            mailMon,
 		coordinator:        coordinator,
@@ -115,6 +131,7 @@ func newListenerV2(
 		pipelineRunner:     pipelineRunner,
 		job:                job,
 		q:                  q,
+		pendingReqs:        pendingReqs,
 		gethks:             gethks,
 		reqLogs:            reqLogs,
 		chStop:             make(chan struct{}),
@@ -138,6 +155,8 @@ type pendingRequest struct {
 	// used for exponential backoff when retrying
 	attempts int
 	lastTry  time.Time
+	// lastError is why the last attempt to fulfill the request failed
+	lastError string
 }
 
 type vrfPipelineResult struct {
@@ -160,6 +179,8 @@ type listenerV2 struct {
 	ethClient      evmclient.Client
 	chainID        *big.Int
 	logBroadcaster log.Broadcaster
+	logPoller      logpoller.LogPoller
+	consumer       logConsumer // records the handled request logs, for either the log broadcaster or the log poller
 	txm            txmgr.TxManager
 	mailMon        *utils.MailboxMonitor
 
@@ -173,12 +194,15 @@ type listenerV2 struct {
 	gethks         keystore.Eth
 	reqLogs        *utils.Mailbox[log.Broadcast]
 	chStop         utils.StopChan
-	// We can keep these pending logs in memory because we
-	// only mark them confirmed once we send a corresponding fulfillment transaction.
-	// So on node restart in the middle of processing, the lb will resend them.
-	reqsMu   sync.Mutex // Both the log listener and the request handler write to reqs
-	reqs     []pendingRequest
-	reqAdded func() // A simple debug helper
+	// Pending requests are kept in memory, and persisted in pendingReqs so that
+	// they are reloaded on restart without having to replay the request logs.
+	reqsMu      sync.Mutex // Both the log listener and the request handler write to reqs
+	reqs        []pendingRequest
+	reqAdded    func() // A simple debug helper
+	pendingReqs PendingRequestsORM
+	// reqErrs holds why requests could not be fulfilled in the current run of the
+	// request handler, by request ID. It is only accessed by the request handler.
+	reqErrs map[string]string
 
 	// Data structures for reorg attack protection
 	// We want a map so we can do an O(1) count update every fulfillment log we get.
@@ -228,22 +252,39 @@ func (lsn *listenerV2) Start(ctx context.Context) error {
 
 		spec := job.LoadEnvConfigVarsVRF(lsn.cfg, *lsn.job.VRFSpec)
 
-		unsubscribeLogs := lsn.logBroadcaster.Register(lsn, log.ListenerOpts{
-			Contract: lsn.coordinator.Address(),
-			ParseLog: lsn.coordinator.ParseLog,
-			LogsWithTopics: map[common.Hash][][]log.Topic{
-				vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested{}.Topic(): {
-					{
-						log.Topic(spec.PublicKey.MustHash()),
+		if err = lsn.loadPendingRequests(); err != nil {
+			lsn.l.Errorw("Failed to load pending requests, relying on their logs being delivered again", "err", err)
+		}
+
+		unsubscribeLogs := func() {}
+		if spec.LogPollerEnabled {
+			var startBlock int64
+			if startBlock, err = lsn.registerLogPollerFilter(ctx, spec.RequestTimeout); err != nil {
+				return err
+			}
+			// Log poller gathers request logs, and delivers them to the log listener.
+			lsn.wg.Add(1)
+			go func() {
+				lsn.runLogPoller(spec.PollPeriod, spec.PublicKey.MustHash(), spec.RequestTimeout, startBlock, spec.MinIncomingConfirmations, lsn.wg)
+			}()
+		} else {
+			unsubscribeLogs = lsn.logBroadcaster.Register(lsn, log.ListenerOpts{
+				Contract: lsn.coordinator.Address(),
+				ParseLog: lsn.coordinator.ParseLog,
+				LogsWithTopics: map[common.Hash][][]log.Topic{
+					vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested{}.Topic(): {
+						{
+							log.Topic(spec.PublicKey.MustHash()),
+						},
 					},
 				},
-			},
-			// Specify a min incoming confirmations of 1 so that we can receive a request log
-			// right away. We set the real number of confirmations on a per-request basis in
-			// the getConfirmedAt method.
-			MinIncomingConfirmations: 1,
-			ReplayStartedCallback:    lsn.ReplayStartedCallback,
-		})
+				// Specify a min incoming confirmations of 1 so that we can receive a request log
+				// right away. We set the real number of confirmations on a per-request basis in
+				// the getConfirmedAt method.
+				MinIncomingConfirmations: 1,
+				ReplayStartedCallback:    lsn.ReplayStartedCallback,
+			})
+		}
 
 		latestHead, unsubscribeHeadBroadcaster := lsn.headBroadcaster.Subscribe(lsn)
 		if latestHead != nil {
@@ -373,15 +414,20 @@ func (lsn *listenerV2) processPendingVRFRequests(ctx context.Context) {
 	confirmed := lsn.getAndRemoveConfirmedLogsBySub(lsn.getLatestHead())
 	processed := make(map[string]struct{})
 	start := time.Now()
+	lsn.reqErrs = make(map[string]string)
 
 	// Add any unprocessed requests back to lsn.reqs after request processing is complete.
 	defer func() {
 		var toKeep []pendingRequest
+		var done []*big.Int
 		for _, subReqs := range confirmed {
 			for _, req := range subReqs {
 				if _, ok := processed[req.req.RequestId.String()]; !ok {
 					req.attempts++
 					req.lastTry = time.Now().UTC()
+					if reqErr, ok := lsn.reqErrs[req.req.RequestId.String()]; ok {
+						req.lastError = reqErr
+					}
 					toKeep = append(toKeep, req)
 					if lsn.job.VRFSpec.BackoffInitialDelay != 0 {
 						lsn.l.Infow("Request failed, next retry will be delayed.",
@@ -397,9 +443,11 @@ func (lsn *listenerV2) processPendingVRFRequests(ctx context.Context) {
 					}
 				} else {
 					lsn.markLogAsConsumed(req.lb)
+					done = append(done, req.req.RequestId)
 				}
 			}
 		}
+		lsn.persistProcessedRequests(toKeep, done)
 		// There could be logs accumulated to this slice while request processor is running,
 		// so we merged the new ones with the ones that need to be requeued.
 		lsn.reqsMu.Lock()
@@ -445,6 +493,7 @@ func (lsn *listenerV2) processPendingVRFRequests(ctx context.Context) {
 			} else {
 				// Most likely this is an RPC error, so we re-try later.
 				l.Errorw("Unable to read subscription balance", "err", err)
+				lsn.setReqsError(reqs, "unable to read subscription balance: %v", err)
 				continue
 			}
 		} else {
@@ -529,6 +578,7 @@ func (lsn *listenerV2) processRequestsPerSubBatch(
 		lsn.q, startBalance, lsn.chainID.Uint64(), subID)
 	if err != nil {
 		lsn.l.Errorw("Couldn't get reserved LINK for subscription", "sub", reqs[0].req.SubId, "err", err)
+		lsn.setReqsError(reqs, "couldn't get reserved LINK for subscription: %v", err)
 		return processed
 	}
 
@@ -542,6 +592,7 @@ func (lsn *listenerV2) processRequestsPerSubBatch(
 	})
 	if err != nil {
 		lsn.l.Errorw("Couldn't get config from coordinator", "err", err)
+		lsn.setReqsError(reqs, "couldn't get config from coordinator: %v", err)
 		return processed
 	}
 
@@ -631,11 +682,13 @@ func (lsn *listenerV2) processRequestsPerSubBatch(
 			fromAddress, err := lsn.gethks.GetRoundRobinAddress(lsn.chainID, fromAddresses...)
 			if err != nil {
 				l.Errorw("Couldn't get next from address", "err", err)
+				lsn.setReqError(p.req.req.RequestId, "couldn't get next from address: %v", err)
 				continue
 			}
 			ll = ll.With("fromAddress", fromAddress)
 
 			if p.err != nil {
+				lsn.setReqError(p.req.req.RequestId, "simulation failed: %v", p.err)
 				if errors.Is(p.err, errBlockhashNotInStore{}) {
 					// Running the blockhash store feeder in backwards mode will be required to
 					// resolve this.
@@ -647,6 +700,7 @@ func (lsn *listenerV2) processRequestsPerSubBatch(
 						etx, err := lsn.enqueueForceFulfillment(ctx, p, fromAddress)
 						if err != nil {
 							ll.Errorw("Error enqueuing force-fulfillment, re-queueing request", "err", err)
+							lsn.setReqError(p.req.req.RequestId, "error enqueuing force-fulfillment: %v", err)
 							continue
 						}
 						ll.Infow("Successfully enqueued force-fulfillment", "ethTxID", etx.ID)
@@ -661,6 +715,7 @@ func (lsn *listenerV2) processRequestsPerSubBatch(
 
 					if startBalanceNoReserveLink.Cmp(p.juelsNeeded) < 0 && errors.Is(p.err, errPossiblyInsufficientFunds{}) {
 						ll.Infow("Insufficient link balance to fulfill a request based on estimate, breaking", "err", p.err)
+						lsn.setReqError(p.req.req.RequestId, "insufficient subscription balance: %s juels needed based on estimate, %s available", p.juelsNeeded, startBalanceNoReserveLink)
 						outOfBalance = true
 
 						// break out of this inner loop to process the currently constructed batch
@@ -687,6 +742,7 @@ func (lsn *listenerV2) processRequestsPerSubBatch(
 				// Break out of the loop now and process what we are able to process
 				// in the constructed batches.
 				ll.Infow("Insufficient link balance to fulfill a request, breaking")
+				lsn.setReqError(p.req.req.RequestId, "insufficient subscription balance: %s juels needed, %s available", p.maxLink, startBalanceNoReserveLink)
 				break
 			}
 
@@ -740,7 +796,7 @@ func (lsn *listenerV2) enqueueForceFulfillment(
 
 	// fulfill the request through the VRF owner
 	err = lsn.q.Transaction(func(tx pg.Queryer) error {
-		if err = lsn.logBroadcaster.MarkConsumed(p.req.lb, pg.WithQueryer(tx)); err != nil {
+		if err = lsn.consumer.MarkConsumed(p.req.lb, pg.WithQueryer(tx)); err != nil {
 			return err
 		}
 
@@ -828,6 +884,7 @@ func (lsn *listenerV2) processRequestsPerSub(
 		lsn.q, startBalance, chainId.Uint64(), subID)
 	if err != nil {
 		lsn.l.Errorw("Couldn't get reserved LINK for subscription", "sub", reqs[0].req.SubId, "err", err)
+		lsn.setReqsError(reqs, "couldn't get reserved LINK for subscription: %v", err)
 		return processed
 	}
 
@@ -900,11 +957,13 @@ func (lsn *listenerV2) processRequestsPerSub(
 			fromAddress, err := lsn.gethks.GetRoundRobinAddress(lsn.chainID, fromAddresses...)
 			if err != nil {
 				l.Errorw("Couldn't get next from address", "err", err)
+				lsn.setReqError(p.req.req.RequestId, "couldn't get next from address: %v", err)
 				continue
 			}
 			ll = ll.With("fromAddress", fromAddress)
 
 			if p.err != nil {
+				lsn.setReqError(p.req.req.RequestId, "simulation failed: %v", p.err)
 				if errors.Is(p.err, errBlockhashNotInStore{}) {
 					// Running the blockhash store feeder in backwards mode will be required to
 					// resolve this.
@@ -917,6 +976,7 @@ func (lsn *listenerV2) processRequestsPerSub(
 						etx, err2 := lsn.enqueueForceFulfillment(ctx, p, fromAddress)
 						if err2 != nil {
 							ll.Errorw("Error enqueuing force-fulfillment, re-queueing request", "err", err2)
+							lsn.setReqError(p.req.req.RequestId, "error enqueuing force-fulfillment: %v", err2)
 							continue
 						}
 						ll.Infow("Enqueued force-fulfillment", "ethTxID", etx.ID)
@@ -931,6 +991,7 @@ func (lsn *listenerV2) processRequestsPerSub(
 
 					if startBalanceNoReserveLink.Cmp(p.juelsNeeded) < 0 {
 						ll.Infow("Insufficient link balance to fulfill a request based on estimate, returning", "err", p.err)
+						lsn.setReqError(p.req.req.RequestId, "insufficient subscription balance: %s juels needed based on estimate, %s available", p.juelsNeeded, startBalanceNoReserveLink)
 						return processed
 					}
 
@@ -952,6 +1013,7 @@ func (lsn *listenerV2) processRequestsPerSub(
 			if startBalanceNoReserveLink.Cmp(p.maxLink) < 0 {
 				// Insufficient funds, have to wait for a user top up. Leave it unprocessed for now
 				ll.Infow("Insufficient link balance to fulfill a request, returning")
+				lsn.setReqError(p.req.req.RequestId, "insufficient subscription balance: %s juels needed, %s available", p.maxLink, startBalanceNoReserveLink)
 				return processed
 			}
 
@@ -961,7 +1023,7 @@ func (lsn *listenerV2) processRequestsPerSub(
 				if err = lsn.pipelineRunner.InsertFinishedRun(&p.run, true, pg.WithQueryer(tx)); err != nil {
 					return err
 				}
-				if err = lsn.logBroadcaster.MarkConsumed(p.req.lb, pg.WithQueryer(tx)); err != nil {
+				if err = lsn.consumer.MarkConsumed(p.req.lb, pg.WithQueryer(tx)); err != nil {
 					return err
 				}
 
@@ -990,6 +1052,7 @@ func (lsn *listenerV2) processRequestsPerSub(
 			})
 			if err != nil {
 				ll.Errorw("Error enqueuing fulfillment, requeuing request", "err", err)
+				lsn.setReqError(p.req.req.RequestId, "error enqueuing fulfillment: %v", err)
 				continue
 			}
 			ll.Infow("Enqueued fulfillment", "ethTxID", transaction.GetID())
@@ -1316,7 +1379,7 @@ func (lsn *listenerV2) getConfirmedAt(req *vrf_coordinator_v2.VRFCoordinatorV2Ra
 func (lsn *listenerV2) handleLog(lb log.Broadcast, minConfs uint32) {
 	if v, ok := lb.DecodedLog().(*vrf_coordinator_v2.VRFCoordinatorV2RandomWordsFulfilled); ok {
 		lsn.l.Debugw("Received fulfilled log", "reqID", v.RequestId, "success", v.Success)
-		consumed, err := lsn.logBroadcaster.WasAlreadyConsumed(lb)
+		consumed, err := lsn.consumer.WasAlreadyConsumed(lb)
 		if err != nil {
 			lsn.l.Errorw("Could not determine if log was already consumed", "err", err, "txHash", lb.RawLog().TxHash)
 			return
@@ -1337,7 +1400,7 @@ func (lsn *listenerV2) handleLog(lb log.Broadcast, minConfs uint32) {
 	req, err := lsn.coordinator.ParseRandomWordsRequested(lb.RawLog())
 	if err != nil {
 		lsn.l.Errorw("Failed to parse log", "err", err, "txHash", lb.RawLog().TxHash)
-		consumed, err := lsn.logBroadcaster.WasAlreadyConsumed(lb)
+		consumed, err := lsn.consumer.WasAlreadyConsumed(lb)
 		if err != nil {
 			lsn.l.Errorw("Could not determine if log was already consumed", "err", err, "txHash", lb.RawLog().TxHash)
 			return
@@ -1350,6 +1413,9 @@ func (lsn *listenerV2) handleLog(lb log.Broadcast, minConfs uint32) {
 
 	confirmedAt := lsn.getConfirmedAt(req, minConfs)
 	lsn.l.Infow("VRFListenerV2: Received log request", "reqID", req.RequestId, "confirmedAt", confirmedAt, "subID", req.SubId, "sender", req.Sender)
+	if err = lsn.persistRequest(req, confirmedAt); err != nil {
+		lsn.l.Errorw("Failed to persist pending request", "err", err, "reqID", req.RequestId, "txHash", req.Raw.TxHash)
+	}
 	lsn.reqsMu.Lock()
 	lsn.reqs = append(lsn.reqs, pendingRequest{
 		confirmedAtBlock: confirmedAt,
@@ -1361,11 +1427,124 @@ func (lsn *listenerV2) handleLog(lb log.Broadcast, minConfs uint32) {
 	lsn.reqsMu.Unlock()
 }
 
+// logConsumer records which request logs have been handled, so that they are not handled again.
+type logConsumer interface {
+	WasAlreadyConsumed(lb log.Broadcast, qopts ...pg.QOpt) (bool, error)
+	MarkConsumed(lb log.Broadcast, qopts ...pg.QOpt) error
+	MarkManyConsumed(lbs []log.Broadcast, qopts ...pg.QOpt) error
+}
+
 func (lsn *listenerV2) markLogAsConsumed(lb log.Broadcast) {
-	err := lsn.logBroadcaster.MarkConsumed(lb)
+	err := lsn.consumer.MarkConsumed(lb)
 	lsn.l.ErrorIf(err, fmt.Sprintf("Unable to mark log %v as consumed", lb.String()))
 }
 
+// jobBroadcast is the broadcast of a request log that was not delivered by the log broadcaster,
+// i.e. reloaded from the db or fetched from the log poller. It reports the job ID so that the log
+// can still be marked as consumed once fulfilled.
+type jobBroadcast struct {
+	log.Broadcast
+	jobID int32
+}
+
+func (b jobBroadcast) JobID() int32 {
+	return b.jobID
+}
+
+// loadPendingRequests reloads the requests that were pending when the job was last stopped.
+func (lsn *listenerV2) loadPendingRequests() error {
+	saved, err := lsn.pendingReqs.FindPendingRequestsByJobID(lsn.job.ID)
+	if err != nil {
+		return err
+	}
+	var reqs []pendingRequest
+	for _, s := range saved {
+		var rawLog types.Log
+		if err = json.Unmarshal(s.RawLog, &rawLog); err != nil {
+			lsn.l.Errorw("Failed to unmarshal pending request log", "err", err, "reqID", s.RequestID.String())
+			continue
+		}
+		req, err := lsn.coordinator.ParseRandomWordsRequested(rawLog)
+		if err != nil {
+			lsn.l.Errorw("Failed to parse pending request log", "err", err, "reqID", s.RequestID.String())
+			continue
+		}
+		// Don't add the request twice when the log broadcaster delivers its log again.
+		lsn.deduper.shouldDeliver(rawLog)
+		reqs = append(reqs, pendingRequest{
+			confirmedAtBlock: s.ConfirmedAtBlock,
+			req:              req,
+			lb:               jobBroadcast{log.NewLogBroadcast(rawLog, *lsn.chainID, req), lsn.job.ID},
+			utcTimestamp:     s.CreatedAt.UTC(),
+			attempts:         s.Attempts,
+			lastTry:          s.LastTryAt.Time,
+			lastError:        s.LastError.String,
+		})
+	}
+	lsn.reqsMu.Lock()
+	lsn.reqs = append(lsn.reqs, reqs...)
+	lsn.reqsMu.Unlock()
+	lsn.l.Infow("Loaded pending requests", "count", len(reqs))
+	return nil
+}
+
+func (lsn *listenerV2) persistRequest(req *vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested, confirmedAt uint64) error {
+	rawLog, err := json.Marshal(req.Raw)
+	if err != nil {
+		return errors.Wrap(err, "marshal request log")
+	}
+	return lsn.pendingReqs.UpsertPendingRequest(&PendingRequestV2{
+		JobID:              lsn.job.ID,
+		EVMChainID:         *utils.NewBig(lsn.chainID),
+		RequestID:          *utils.NewBig(req.RequestId),
+		SubID:              req.SubId,
+		ConfirmedAtBlock:   confirmedAt,
+		RequestTxHash:      req.Raw.TxHash,
+		RequestBlockNumber: req.Raw.BlockNumber,
+		RawLog:             rawLog,
+	})
+}
+
+// persistProcessedRequests saves the attempts of the requests that failed to be fulfilled,
+// and deletes the requests that were fulfilled. The fulfilled requests of jobs using the log poller
+// are kept instead, as they were marked consumed, until they are pruned.
+func (lsn *listenerV2) persistProcessedRequests(failed []pendingRequest, fulfilled []*big.Int) {
+	if len(failed) > 0 {
+		reqs := make([]PendingRequestV2, len(failed))
+		for i, req := range failed {
+			reqs[i] = PendingRequestV2{
+				JobID:     lsn.job.ID,
+				RequestID: *utils.NewBig(req.req.RequestId),
+				Attempts:  req.attempts,
+				LastTryAt: null.TimeFrom(req.lastTry),
+				LastError: null.NewString(req.lastError, req.lastError != ""),
+			}
+		}
+		if err := lsn.pendingReqs.UpdatePendingRequestsAttempts(reqs); err != nil {
+			lsn.l.Errorw("Failed to update attempts of pending requests", "err", err)
+		}
+	}
+	if len(fulfilled) > 0 && !lsn.job.VRFSpec.LogPollerEnabled {
+		if err := lsn.pendingReqs.DeletePendingRequests(lsn.job.ID, fulfilled); err != nil {
+			lsn.l.Errorw("Failed to delete fulfilled pending requests", "err", err)
+		}
+	}
+}
+
+// setReqError records why the request could not be fulfilled in the current run of the request handler.
+func (lsn *listenerV2) setReqError(reqID *big.Int, format string, args ...interface{}) {
+	if lsn.reqErrs == nil {
+		lsn.reqErrs = make(map[string]string)
+	}
+	lsn.reqErrs[reqID.String()] = fmt.Sprintf(format, args...)
+}
+
+func (lsn *listenerV2) setReqsError(reqs []pendingRequest, format string, args ...interface{}) {
+	for _, req := range reqs {
+		lsn.setReqError(req.req.RequestId, format, args...)
+	}
+}
+
 // Close complies with job.Service
 func (lsn *listenerV2) Close() error {
 	return lsn.StopOnce("VRFListenerV2", func() error {
@@ -0,0 +1,202 @@
+package vrf
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/log"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/vrf_coordinator_v2"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+)
+
+// consumedRequestsPruneInterval is how often the consumed requests older than the request timeout
+// are deleted.
+const consumedRequestsPruneInterval = time.Hour
+
+// blockTimeSampleSize is the number of blocks the average block time is measured over, when turning
+// the request timeout into the block the log poller backfills the request logs from.
+const blockTimeSampleSize = 100
+
+// logPollerFilterName returns the name of the log poller filter of a VRF v2 job using the log poller.
+func logPollerFilterName(jobID int32, coordinator common.Address) string {
+	return logpoller.FilterName("VRFListenerV2", fmt.Sprint(jobID), coordinator.String())
+}
+
+// logPollerConsumer is the logConsumer of jobs getting their request logs from the log poller.
+// Consumed requests are marked in their pending requests rows, in the same transaction as their
+// fulfillment is enqueued, so that their logs are skipped when polled again after a restart.
+// Logs which are not request logs are never consumed.
+type logPollerConsumer struct {
+	jobID        int32
+	pendingReqs  PendingRequestsORM
+	parseRequest func(types.Log) (*vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested, error)
+}
+
+func (c logPollerConsumer) WasAlreadyConsumed(lb log.Broadcast, qopts ...pg.QOpt) (bool, error) {
+	req, err := c.parseRequest(lb.RawLog())
+	if err != nil {
+		return false, nil
+	}
+	return c.pendingReqs.IsPendingRequestConsumed(c.jobID, req.RequestId, qopts...)
+}
+
+func (c logPollerConsumer) MarkConsumed(lb log.Broadcast, qopts ...pg.QOpt) error {
+	return c.MarkManyConsumed([]log.Broadcast{lb}, qopts...)
+}
+
+func (c logPollerConsumer) MarkManyConsumed(lbs []log.Broadcast, qopts ...pg.QOpt) error {
+	var reqIDs []*big.Int
+	for _, lb := range lbs {
+		if req, err := c.parseRequest(lb.RawLog()); err == nil {
+			reqIDs = append(reqIDs, req.RequestId)
+		}
+	}
+	return c.pendingReqs.MarkPendingRequestsConsumed(c.jobID, reqIDs, qopts...)
+}
+
+// registerLogPollerFilter registers the log poller filter of the job, and returns the block its
+// request logs are backfilled from.
+func (lsn *listenerV2) registerLogPollerFilter(ctx context.Context, requestTimeout time.Duration) (int64, error) {
+	// Requests sent while the job was not running are backfilled, as far back as the request timeout.
+	startBlock, err := lsn.logPollerStartBlock(ctx, requestTimeout)
+	if err != nil {
+		lsn.l.Errorw("Failed to find the block the request timeout starts at, only polling new request logs", "err", err)
+	}
+	err = lsn.logPoller.RegisterFilter(logpoller.Filter{
+		Name:      logPollerFilterName(lsn.job.ID, lsn.coordinator.Address()),
+		EventSigs: []common.Hash{vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested{}.Topic()},
+		Addresses: []common.Address{lsn.coordinator.Address()},
+		// Requests older than the request timeout are not fulfilled.
+		Retention:  requestTimeout,
+		StartBlock: startBlock,
+	})
+	return startBlock, errors.Wrap(err, "register log poller filter")
+}
+
+// logPollerStartBlock estimates the first block mined within the request timeout, from the average
+// block time of the latest blocks.
+func (lsn *listenerV2) logPollerStartBlock(ctx context.Context, requestTimeout time.Duration) (int64, error) {
+	latest, err := lsn.ethClient.HeadByNumber(ctx, nil)
+	if err != nil {
+		return 0, errors.Wrap(err, "get latest head")
+	}
+	if latest == nil || latest.Number <= 1 {
+		return 1, nil
+	}
+	sampleSize := int64(blockTimeSampleSize)
+	if latest.Number-1 < sampleSize {
+		sampleSize = latest.Number - 1
+	}
+	earlier, err := lsn.ethClient.HeadByNumber(ctx, big.NewInt(latest.Number-sampleSize))
+	if err != nil {
+		return 0, errors.Wrapf(err, "get head %d", latest.Number-sampleSize)
+	}
+	if earlier == nil || !latest.Timestamp.After(earlier.Timestamp) {
+		return 0, errors.Errorf("cannot measure the block time between heads %d and %d", latest.Number-sampleSize, latest.Number)
+	}
+	blockTime := latest.Timestamp.Sub(earlier.Timestamp) / time.Duration(sampleSize)
+	start := latest.Number - int64(requestTimeout/blockTime)
+	if start < 1 {
+		start = 1
+	}
+	return start, nil
+}
+
+// runLogPoller periodically fetches the request logs of the job's key in the blocks confirmed since
+// the previous poll from the log poller, and delivers them to the log listener.
+func (lsn *listenerV2) runLogPoller(pollPeriod time.Duration, keyHash common.Hash, requestTimeout time.Duration, startBlock int64, minConfs uint32, wg *sync.WaitGroup) {
+	defer wg.Done()
+	tick := time.NewTicker(pollPeriod)
+	defer tick.Stop()
+	prune := time.NewTicker(consumedRequestsPruneInterval)
+	defer prune.Stop()
+	lsn.pruneConsumedRequests(requestTimeout)
+	from := lsn.logPollerFrom(startBlock)
+	for {
+		select {
+		case <-lsn.chStop:
+			return
+		case <-tick.C:
+			var err error
+			if from, err = lsn.pollLogs(keyHash, from, minConfs); err != nil {
+				lsn.l.Errorw("Failed to poll request logs", "err", err)
+			}
+		case <-prune.C:
+			lsn.pruneConsumedRequests(requestTimeout)
+		}
+	}
+}
+
+// logPollerFrom returns the block the first poll of the log poller starts from: the block of the
+// newest request saved before a restart, so that only the blocks processed since then are polled
+// again. There is no need to look further back than the block the request timeout starts at, since
+// older requests are not fulfilled.
+func (lsn *listenerV2) logPollerFrom(startBlock int64) int64 {
+	latest, err := lsn.pendingReqs.FindLatestRequestBlockNumber(lsn.job.ID)
+	if err != nil {
+		lsn.l.Errorw("Failed to load the block of the newest saved request, polling from the request timeout", "err", err)
+		return startBlock
+	}
+	if latest.Valid && latest.Int64 > startBlock {
+		return latest.Int64
+	}
+	return startBlock
+}
+
+// pruneConsumedRequests deletes the consumed requests older than the request timeout, whose logs
+// are not polled again.
+func (lsn *listenerV2) pruneConsumedRequests(requestTimeout time.Duration) {
+	if err := lsn.pendingReqs.DeleteConsumedRequests(lsn.job.ID, time.Now().Add(-requestTimeout)); err != nil {
+		lsn.l.Errorw("Failed to delete consumed requests", "err", err)
+	}
+}
+
+// pollLogs delivers the request logs of the given key from the given block up to the latest block
+// with minConfs confirmations, and returns the block the next poll starts from. Only confirmed blocks
+// are polled, so that a block is not polled again once processed.
+//
+// RandomWordsRequested does not index the request ID, so requests can't be matched with their
+// RandomWordsFulfilled logs by the log poller, e.g. with IndexedLogsWithSigsExcluding. As with the
+// log broadcaster, requests are checked on-chain before being fulfilled instead.
+func (lsn *listenerV2) pollLogs(keyHash common.Hash, from int64, minConfs uint32) (int64, error) {
+	latest, err := lsn.logPoller.LatestBlock()
+	if err != nil {
+		return from, errors.Wrap(err, "get latest block")
+	}
+	to := latest - int64(minConfs)
+	if to < from {
+		return from, nil
+	}
+	lpLogs, err := lsn.logPoller.IndexedLogsByBlockRange(
+		from,
+		to,
+		vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested{}.Topic(),
+		lsn.coordinator.Address(),
+		1,
+		[]common.Hash{keyHash},
+	)
+	if err != nil {
+		return from, errors.Wrap(err, "get request logs")
+	}
+
+	for _, lpLog := range lpLogs {
+		lb := jobBroadcast{log.NewLogBroadcast(lpLog.ToGethLog(), *lsn.chainID, nil), lsn.job.ID}
+		// Requests consumed before a restart are polled again, but must not be fulfilled twice.
+		consumed, err := lsn.consumer.WasAlreadyConsumed(lb)
+		if err != nil {
+			return from, errors.Wrap(err, "check if request log was consumed")
+		}
+		if !consumed {
+			lsn.HandleLog(lb)
+		}
+	}
+	return to + 1, nil
+}
@@ -0,0 +1,267 @@
+package vrf
+
+import (
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/log"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	lpmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller/mocks"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/vrf_coordinator_v2"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	vrf_mocks "github.com/smartcontractkit/chainlink/v2/core/services/vrf/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// memPendingRequestsORM keeps the pending requests of a job in memory, for the methods used when
+// polling the log poller.
+type memPendingRequestsORM struct {
+	PendingRequestsORM
+	mu   sync.Mutex
+	reqs map[string]*PendingRequestV2
+}
+
+func newMemPendingRequestsORM() *memPendingRequestsORM {
+	return &memPendingRequestsORM{reqs: make(map[string]*PendingRequestV2)}
+}
+
+func (o *memPendingRequestsORM) UpsertPendingRequest(req *PendingRequestV2, _ ...pg.QOpt) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	saved := *req
+	if prev, ok := o.reqs[req.RequestID.String()]; ok {
+		saved.ConsumedAt = prev.ConsumedAt
+	}
+	o.reqs[req.RequestID.String()] = &saved
+	return nil
+}
+
+func (o *memPendingRequestsORM) MarkPendingRequestsConsumed(_ int32, requestIDs []*big.Int, _ ...pg.QOpt) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for _, id := range requestIDs {
+		if req, ok := o.reqs[id.String()]; ok && !req.ConsumedAt.Valid {
+			req.ConsumedAt = null.TimeFrom(time.Now())
+		}
+	}
+	return nil
+}
+
+func (o *memPendingRequestsORM) IsPendingRequestConsumed(_ int32, requestID *big.Int, _ ...pg.QOpt) (bool, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	req, ok := o.reqs[requestID.String()]
+	return ok && req.ConsumedAt.Valid, nil
+}
+
+func (o *memPendingRequestsORM) FindLatestRequestBlockNumber(int32, ...pg.QOpt) (latest null.Int, err error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for _, req := range o.reqs {
+		if !latest.Valid || int64(req.RequestBlockNumber) > latest.Int64 {
+			latest = null.IntFrom(int64(req.RequestBlockNumber))
+		}
+	}
+	return
+}
+
+// newLogPollerListener returns a listener of a job using the log poller. The request ID of each
+// request log is its block number.
+func newLogPollerListener(t *testing.T, coordinatorAddress common.Address, lp logpoller.LogPoller, pendingReqs PendingRequestsORM) *listenerV2 {
+	coordinator := vrf_mocks.NewVRFCoordinatorV2Interface(t)
+	coordinator.On("Address").Return(coordinatorAddress).Maybe()
+	parseRequest := func(l types.Log) (*vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested, error) {
+		return &vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested{
+			RequestId: new(big.Int).SetUint64(l.BlockNumber),
+			Raw:       l,
+		}, nil
+	}
+	coordinator.On("ParseRandomWordsRequested", mock.Anything).Return(parseRequest, nil).Maybe()
+	jb := job.Job{ID: 1, VRFSpec: &job.VRFSpec{LogPollerEnabled: true}}
+	return &listenerV2{
+		l:           logger.Sugared(logger.TestLogger(t)),
+		job:         jb,
+		chainID:     big.NewInt(1),
+		coordinator: coordinator,
+		logPoller:   lp,
+		consumer:    logPollerConsumer{jobID: jb.ID, pendingReqs: pendingReqs, parseRequest: parseRequest},
+		pendingReqs: pendingReqs,
+		reqLogs:     utils.NewHighCapacityMailbox[log.Broadcast](),
+		deduper:     newLogDeduper(100),
+	}
+}
+
+func TestListener_PollLogs(t *testing.T) {
+	coordinatorAddress := testutils.NewAddress()
+	lp := lpmocks.NewLogPoller(t)
+	lsn := newLogPollerListener(t, coordinatorAddress, lp, newMemPendingRequestsORM())
+
+	keyHash := common.HexToHash("0x1")
+	newLog := func(blockNumber, logIndex int64) logpoller.Log {
+		return logpoller.Log{
+			LogIndex:    logIndex,
+			BlockHash:   common.BigToHash(big.NewInt(blockNumber)),
+			BlockNumber: blockNumber,
+			Topics:      [][]byte{vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested{}.Topic().Bytes(), keyHash.Bytes()},
+			Address:     coordinatorAddress,
+		}
+	}
+	expectLogs := func(latest, from, to int64, lpLogs ...logpoller.Log) {
+		lp.On("LatestBlock").Return(latest, nil).Once()
+		lp.On("IndexedLogsByBlockRange", from, to, vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested{}.Topic(), coordinatorAddress,
+			1, []common.Hash{keyHash}).Return(lpLogs, nil).Once()
+	}
+	retrieveBlockNumbers := func() (blockNumbers []uint64) {
+		for _, lb := range lsn.reqLogs.RetrieveAll() {
+			assert.Equal(t, int32(1), lb.JobID())
+			blockNumbers = append(blockNumbers, lb.RawLog().BlockNumber)
+		}
+		return
+	}
+
+	// Only the blocks with 3 confirmations are polled.
+	expectLogs(15, 5, 12, newLog(10, 0), newLog(10, 1), newLog(11, 0))
+	next, err := lsn.pollLogs(keyHash, 5, 3)
+	require.NoError(t, err)
+	assert.Equal(t, int64(13), next)
+	assert.Equal(t, []uint64{10, 10, 11}, retrieveBlockNumbers())
+
+	t.Run("polls the blocks confirmed since the previous poll", func(t *testing.T) {
+		expectLogs(17, 13, 14, newLog(14, 0))
+		next, err := lsn.pollLogs(keyHash, 13, 3)
+		require.NoError(t, err)
+		assert.Equal(t, int64(15), next)
+		assert.Equal(t, []uint64{14}, retrieveBlockNumbers())
+	})
+
+	t.Run("keeps its position when no new block is confirmed", func(t *testing.T) {
+		lp.On("LatestBlock").Return(int64(17), nil).Once()
+		next, err := lsn.pollLogs(keyHash, 15, 3)
+		require.NoError(t, err)
+		assert.Equal(t, int64(15), next)
+		assert.Empty(t, retrieveBlockNumbers())
+	})
+
+	t.Run("keeps its position on error", func(t *testing.T) {
+		lp.On("LatestBlock").Return(int64(0), errors.New("no blocks")).Once()
+		next, err := lsn.pollLogs(keyHash, 15, 3)
+		require.Error(t, err)
+		assert.Equal(t, int64(15), next)
+	})
+}
+
+func TestListener_PollLogs_Restart(t *testing.T) {
+	coordinatorAddress := testutils.NewAddress()
+	keyHash := common.HexToHash("0x1")
+	lp := lpmocks.NewLogPoller(t)
+	pendingReqs := newMemPendingRequestsORM()
+	newLog := func(blockNumber int64) logpoller.Log {
+		return logpoller.Log{
+			BlockHash:   common.BigToHash(big.NewInt(blockNumber)),
+			BlockNumber: blockNumber,
+			Topics:      [][]byte{vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested{}.Topic().Bytes(), keyHash.Bytes()},
+			Address:     coordinatorAddress,
+		}
+	}
+	expectLogs := func(from, to int64, lpLogs ...logpoller.Log) {
+		lp.On("LatestBlock").Return(to, nil).Once()
+		lp.On("IndexedLogsByBlockRange", from, to, vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested{}.Topic(), coordinatorAddress,
+			1, []common.Hash{keyHash}).Return(lpLogs, nil).Once()
+	}
+
+	// Before the restart, request 10 is received, and its fulfillment is enqueued, but not confirmed yet.
+	lsn := newLogPollerListener(t, coordinatorAddress, lp, pendingReqs)
+	start := lsn.logPollerFrom(5)
+	assert.Equal(t, int64(5), start)
+	expectLogs(start, 12, newLog(10))
+	_, err := lsn.pollLogs(keyHash, start, 0)
+	require.NoError(t, err)
+	lbs := lsn.reqLogs.RetrieveAll()
+	require.Len(t, lbs, 1)
+	req, err := lsn.coordinator.ParseRandomWordsRequested(lbs[0].RawLog())
+	require.NoError(t, err)
+	require.NoError(t, lsn.persistRequest(req, 10))
+	require.NoError(t, lsn.consumer.MarkConsumed(lbs[0]))
+
+	// After the restart, polling resumes from the block of request 10, which is skipped since it was consumed.
+	restarted := newLogPollerListener(t, coordinatorAddress, lp, pendingReqs)
+	start = restarted.logPollerFrom(5)
+	assert.Equal(t, int64(10), start)
+	expectLogs(start, 14, newLog(10), newLog(11))
+	_, err = restarted.pollLogs(keyHash, start, 0)
+	require.NoError(t, err)
+	lbs = restarted.reqLogs.RetrieveAll()
+	require.Len(t, lbs, 1)
+	assert.Equal(t, uint64(11), lbs[0].RawLog().BlockNumber)
+}
+
+func TestListener_RegisterLogPollerFilter(t *testing.T) {
+	coordinatorAddress := testutils.NewAddress()
+	now := time.Now().Truncate(time.Second)
+	expectHeads := func(ec *evmclimocks.Client, latest, earlier int64) {
+		ec.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(&evmtypes.Head{Number: latest, Timestamp: now}, nil).Once()
+		// blocks are mined every 2 seconds
+		ec.On("HeadByNumber", mock.Anything, big.NewInt(earlier)).
+			Return(&evmtypes.Head{Number: earlier, Timestamp: now.Add(-2 * time.Second * time.Duration(latest-earlier))}, nil).Once()
+	}
+	expectFilter := func(lp *lpmocks.LogPoller, startBlock int64) {
+		lp.On("RegisterFilter", logpoller.Filter{
+			Name:       logPollerFilterName(1, coordinatorAddress),
+			EventSigs:  []common.Hash{vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested{}.Topic()},
+			Addresses:  []common.Address{coordinatorAddress},
+			Retention:  time.Hour,
+			StartBlock: startBlock,
+		}).Return(nil).Once()
+	}
+
+	t.Run("backfills the requests sent within the request timeout", func(t *testing.T) {
+		lp := lpmocks.NewLogPoller(t)
+		ec := evmclimocks.NewClient(t)
+		lsn := newLogPollerListener(t, coordinatorAddress, lp, newMemPendingRequestsORM())
+		lsn.ethClient = ec
+		expectHeads(ec, 10000, 10000-blockTimeSampleSize)
+		expectFilter(lp, 10000-1800)
+		startBlock, err := lsn.registerLogPollerFilter(testutils.Context(t), time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, int64(10000-1800), startBlock)
+	})
+
+	t.Run("backfills from the first block of a young chain", func(t *testing.T) {
+		lp := lpmocks.NewLogPoller(t)
+		ec := evmclimocks.NewClient(t)
+		lsn := newLogPollerListener(t, coordinatorAddress, lp, newMemPendingRequestsORM())
+		lsn.ethClient = ec
+		expectHeads(ec, 50, 1)
+		expectFilter(lp, 1)
+		startBlock, err := lsn.registerLogPollerFilter(testutils.Context(t), time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), startBlock)
+	})
+
+	t.Run("only polls new requests when the block time is unknown", func(t *testing.T) {
+		lp := lpmocks.NewLogPoller(t)
+		ec := evmclimocks.NewClient(t)
+		lsn := newLogPollerListener(t, coordinatorAddress, lp, newMemPendingRequestsORM())
+		lsn.ethClient = ec
+		ec.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(nil, errors.New("connection refused")).Once()
+		expectFilter(lp, 0)
+		startBlock, err := lsn.registerLogPollerFilter(testutils.Context(t), time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), startBlock)
+	})
+}
@@ -144,7 +144,7 @@ func (lsn *listenerV2) processBatch(
 			return errors.Wrap(err, "inserting finished pipeline runs")
 		}
 
-		if err = lsn.logBroadcaster.MarkManyConsumed(batch.lbs, pg.WithQueryer(tx)); err != nil {
+		if err = lsn.consumer.MarkManyConsumed(batch.lbs, pg.WithQueryer(tx)); err != nil {
 			return errors.Wrap(err, "mark logs consumed")
 		}
 
@@ -173,6 +173,9 @@ func (lsn *listenerV2) processBatch(
 	})
 	if err != nil {
 		ll.Errorw("Error enqueuing batch fulfillments, requeuing requests", "err", err)
+		for _, reqID := range batch.reqIDs {
+			lsn.setReqError(reqID, "error enqueuing batch fulfillment: %v", err)
+		}
 		return
 	}
 	ll.Infow("Enqueued fulfillment", "ethTxID", ethTX.GetID())
@@ -206,7 +209,7 @@ func (lsn *listenerV2) getUnconsumed(l logger.Logger, reqs []pendingRequest) (un
 
 		// This check to see if the log was consumed needs to be in the same
 		// goroutine as the mark consumed to avoid processing duplicates.
-		consumed, err := lsn.logBroadcaster.WasAlreadyConsumed(req.lb)
+		consumed, err := lsn.consumer.WasAlreadyConsumed(req.lb)
 		if err != nil {
 			// Do not process for now, retry on next iteration.
 			l.Errorw("Could not determine if log was already consumed",
@@ -0,0 +1,165 @@
+package vrf
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/sqlx"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg/datatypes"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// PendingRequestV2 is a VRF v2 request that a job has received but not fulfilled yet.
+type PendingRequestV2 struct {
+	ID                 int64
+	JobID              int32
+	EVMChainID         utils.Big
+	RequestID          utils.Big
+	SubID              uint64
+	ConfirmedAtBlock   uint64
+	RequestTxHash      common.Hash
+	RequestBlockNumber uint64
+	// RawLog is the JSON encoded RandomWordsRequested log of the request.
+	RawLog    datatypes.JSON
+	Attempts  int
+	LastTryAt null.Time
+	// LastError is the reason why the last attempt to fulfill the request failed.
+	LastError null.String
+	// ConsumedAt is when a request of a job using the log poller was fulfilled or dropped. Such
+	// requests are kept until they are older than the request timeout, so that their logs are not
+	// handled again when polled again.
+	ConsumedAt null.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// PendingRequestsORM persists the pending requests of VRF v2 jobs, so that they survive restarts
+// and can be inspected by operators.
+type PendingRequestsORM interface {
+	UpsertPendingRequest(req *PendingRequestV2, qopts ...pg.QOpt) error
+	UpdatePendingRequestsAttempts(reqs []PendingRequestV2, qopts ...pg.QOpt) error
+	DeletePendingRequests(jobID int32, requestIDs []*big.Int, qopts ...pg.QOpt) error
+	FindPendingRequestsByJobID(jobID int32, qopts ...pg.QOpt) ([]PendingRequestV2, error)
+	FindPendingRequests(offset, limit int) ([]PendingRequestV2, int, error)
+	MarkPendingRequestsConsumed(jobID int32, requestIDs []*big.Int, qopts ...pg.QOpt) error
+	IsPendingRequestConsumed(jobID int32, requestID *big.Int, qopts ...pg.QOpt) (bool, error)
+	DeleteConsumedRequests(jobID int32, before time.Time, qopts ...pg.QOpt) error
+	FindLatestRequestBlockNumber(jobID int32, qopts ...pg.QOpt) (null.Int, error)
+}
+
+type pendingRequestsORM struct {
+	q pg.Q
+}
+
+var _ PendingRequestsORM = (*pendingRequestsORM)(nil)
+
+func NewPendingRequestsORM(db *sqlx.DB, lggr logger.Logger, cfg pg.QConfig) PendingRequestsORM {
+	return &pendingRequestsORM{pg.NewQ(db, lggr, cfg)}
+}
+
+// UpsertPendingRequest saves a newly received request. If the request was already saved, e.g. because
+// its log was re-delivered after a reorg, its log and confirmations are updated but its attempts are kept.
+func (o *pendingRequestsORM) UpsertPendingRequest(req *PendingRequestV2, qopts ...pg.QOpt) error {
+	sql := `INSERT INTO vrf_v2_pending_requests (job_id, evm_chain_id, request_id, sub_id, confirmed_at_block, request_tx_hash, request_block_number, raw_log, created_at, updated_at)
+VALUES (:job_id, :evm_chain_id, :request_id, :sub_id, :confirmed_at_block, :request_tx_hash, :request_block_number, :raw_log, NOW(), NOW())
+ON CONFLICT (job_id, request_id) DO UPDATE SET
+	confirmed_at_block = EXCLUDED.confirmed_at_block,
+	request_tx_hash = EXCLUDED.request_tx_hash,
+	request_block_number = EXCLUDED.request_block_number,
+	raw_log = EXCLUDED.raw_log,
+	updated_at = NOW()
+RETURNING *`
+	err := o.q.WithOpts(qopts...).GetNamed(sql, req, req)
+	return errors.Wrap(err, "UpsertPendingRequest failed")
+}
+
+// UpdatePendingRequestsAttempts saves the attempts, last try and last error of the given requests.
+func (o *pendingRequestsORM) UpdatePendingRequestsAttempts(reqs []PendingRequestV2, qopts ...pg.QOpt) error {
+	if len(reqs) == 0 {
+		return nil
+	}
+	return o.q.WithOpts(qopts...).Transaction(func(tx pg.Queryer) error {
+		for _, req := range reqs {
+			_, err := tx.Exec(`UPDATE vrf_v2_pending_requests SET attempts = $3, last_try_at = $4, last_error = $5, updated_at = NOW()
+WHERE job_id = $1 AND request_id = $2`, req.JobID, req.RequestID, req.Attempts, req.LastTryAt, req.LastError)
+			if err != nil {
+				return errors.Wrapf(err, "failed to update pending request %s", req.RequestID.String())
+			}
+		}
+		return nil
+	})
+}
+
+// DeletePendingRequests deletes the given requests of a job, once they have been fulfilled or dropped.
+func (o *pendingRequestsORM) DeletePendingRequests(jobID int32, requestIDs []*big.Int, qopts ...pg.QOpt) error {
+	if len(requestIDs) == 0 {
+		return nil
+	}
+	_, err := o.q.WithOpts(qopts...).Exec(`DELETE FROM vrf_v2_pending_requests WHERE job_id = $1 AND request_id = ANY($2::numeric[])`, jobID, requestIDsArray(requestIDs))
+	return errors.Wrap(err, "DeletePendingRequests failed")
+}
+
+// MarkPendingRequestsConsumed records that the given requests of a job using the log poller were
+// fulfilled or dropped. A request keeps the time it was first consumed at.
+func (o *pendingRequestsORM) MarkPendingRequestsConsumed(jobID int32, requestIDs []*big.Int, qopts ...pg.QOpt) error {
+	if len(requestIDs) == 0 {
+		return nil
+	}
+	_, err := o.q.WithOpts(qopts...).Exec(`UPDATE vrf_v2_pending_requests SET consumed_at = NOW(), updated_at = NOW()
+WHERE job_id = $1 AND request_id = ANY($2::numeric[]) AND consumed_at IS NULL`, jobID, requestIDsArray(requestIDs))
+	return errors.Wrap(err, "MarkPendingRequestsConsumed failed")
+}
+
+// IsPendingRequestConsumed returns whether the given request of a job was consumed.
+func (o *pendingRequestsORM) IsPendingRequestConsumed(jobID int32, requestID *big.Int, qopts ...pg.QOpt) (consumed bool, err error) {
+	err = o.q.WithOpts(qopts...).Get(&consumed, `SELECT EXISTS (
+	SELECT 1 FROM vrf_v2_pending_requests WHERE job_id = $1 AND request_id = $2 AND consumed_at IS NOT NULL
+)`, jobID, utils.NewBig(requestID))
+	return consumed, errors.Wrap(err, "IsPendingRequestConsumed failed")
+}
+
+// DeleteConsumedRequests deletes the consumed requests of a job which were received before the given time.
+func (o *pendingRequestsORM) DeleteConsumedRequests(jobID int32, before time.Time, qopts ...pg.QOpt) error {
+	_, err := o.q.WithOpts(qopts...).Exec(`DELETE FROM vrf_v2_pending_requests WHERE job_id = $1 AND consumed_at IS NOT NULL AND created_at < $2`, jobID, before)
+	return errors.Wrap(err, "DeleteConsumedRequests failed")
+}
+
+// FindLatestRequestBlockNumber returns the block number of the newest request of a job, pending or
+// consumed, if any. Jobs using the log poller have processed the request logs up to that block.
+func (o *pendingRequestsORM) FindLatestRequestBlockNumber(jobID int32, qopts ...pg.QOpt) (blockNumber null.Int, err error) {
+	err = o.q.WithOpts(qopts...).Get(&blockNumber, `SELECT max(request_block_number) FROM vrf_v2_pending_requests WHERE job_id = $1`, jobID)
+	return blockNumber, errors.Wrap(err, "FindLatestRequestBlockNumber failed")
+}
+
+// FindPendingRequestsByJobID returns all the pending requests of a job, oldest first.
+func (o *pendingRequestsORM) FindPendingRequestsByJobID(jobID int32, qopts ...pg.QOpt) (reqs []PendingRequestV2, err error) {
+	err = o.q.WithOpts(qopts...).Select(&reqs, `SELECT * FROM vrf_v2_pending_requests WHERE job_id = $1 AND consumed_at IS NULL ORDER BY created_at, id`, jobID)
+	return reqs, errors.Wrap(err, "FindPendingRequestsByJobID failed")
+}
+
+// FindPendingRequests returns a page of the pending requests of all jobs, oldest first, and the total count.
+func (o *pendingRequestsORM) FindPendingRequests(offset, limit int) (reqs []PendingRequestV2, count int, err error) {
+	err = o.q.Transaction(func(tx pg.Queryer) error {
+		if err = tx.Get(&count, `SELECT count(*) FROM vrf_v2_pending_requests WHERE consumed_at IS NULL`); err != nil {
+			return errors.Wrap(err, "failed to count pending requests")
+		}
+		return errors.Wrap(tx.Select(&reqs, `SELECT * FROM vrf_v2_pending_requests WHERE consumed_at IS NULL ORDER BY created_at, id LIMIT $1 OFFSET $2`, limit, offset), "failed to load pending requests")
+	}, pg.OptReadOnlyTx())
+	return
+}
+
+func requestIDsArray(requestIDs []*big.Int) interface{} {
+	ids := make([]string, len(requestIDs))
+	for i, id := range requestIDs {
+		ids[i] = id.String()
+	}
+	return pq.Array(ids)
+}
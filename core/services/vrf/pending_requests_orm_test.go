@@ -0,0 +1,124 @@
+package vrf_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/vrf"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+func TestPendingRequestsORM(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	orm := vrf.NewPendingRequestsORM(db, logger.TestLogger(t), pgtest.NewQConfig(true))
+	jb, _ := cltest.MustInsertWebhookSpec(t, db)
+	chainID := utils.NewBig(testutils.FixtureChainID)
+
+	newReq := func(reqID int64) *vrf.PendingRequestV2 {
+		return &vrf.PendingRequestV2{
+			JobID:              jb.ID,
+			EVMChainID:         *chainID,
+			RequestID:          *utils.NewBigI(reqID),
+			SubID:              7,
+			ConfirmedAtBlock:   110,
+			RequestTxHash:      testutils.Random32Byte(),
+			RequestBlockNumber: 100,
+			RawLog:             []byte(`{"blockNumber":"0x64"}`),
+		}
+	}
+
+	req1, req2 := newReq(1), newReq(2)
+	require.NoError(t, orm.UpsertPendingRequest(req1))
+	require.NoError(t, orm.UpsertPendingRequest(req2))
+	assert.NotZero(t, req1.ID)
+	assert.Zero(t, req1.Attempts)
+
+	lastTry := time.Now().UTC().Truncate(time.Second)
+	require.NoError(t, orm.UpdatePendingRequestsAttempts([]vrf.PendingRequestV2{{
+		JobID:     jb.ID,
+		RequestID: req1.RequestID,
+		Attempts:  2,
+		LastTryAt: null.TimeFrom(lastTry),
+		LastError: null.StringFrom("insufficient subscription balance"),
+	}}))
+
+	t.Run("upserting a saved request keeps its attempts", func(t *testing.T) {
+		redelivered := newReq(1)
+		redelivered.ConfirmedAtBlock = 120
+		require.NoError(t, orm.UpsertPendingRequest(redelivered))
+		assert.Equal(t, req1.ID, redelivered.ID)
+		assert.Equal(t, uint64(120), redelivered.ConfirmedAtBlock)
+		assert.Equal(t, 2, redelivered.Attempts)
+	})
+
+	reqs, err := orm.FindPendingRequestsByJobID(jb.ID)
+	require.NoError(t, err)
+	require.Len(t, reqs, 2)
+	assert.Equal(t, req1.RequestID, reqs[0].RequestID)
+	assert.Equal(t, uint64(7), reqs[0].SubID)
+	assert.Equal(t, req1.RequestTxHash, reqs[0].RequestTxHash)
+	assert.Equal(t, 2, reqs[0].Attempts)
+	assert.True(t, lastTry.Equal(reqs[0].LastTryAt.Time))
+	assert.Equal(t, "insufficient subscription balance", reqs[0].LastError.String)
+	assert.Equal(t, req2.RequestID, reqs[1].RequestID)
+	assert.False(t, reqs[1].LastError.Valid)
+
+	page, count, err := orm.FindPendingRequests(1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	require.Len(t, page, 1)
+	assert.Equal(t, req2.ID, page[0].ID)
+
+	require.NoError(t, orm.DeletePendingRequests(jb.ID, []*big.Int{big.NewInt(1), big.NewInt(3)}))
+	reqs, err = orm.FindPendingRequestsByJobID(jb.ID)
+	require.NoError(t, err)
+	require.Len(t, reqs, 1)
+	assert.Equal(t, req2.RequestID, reqs[0].RequestID)
+
+	t.Run("consumed requests", func(t *testing.T) {
+		req3 := newReq(3)
+		req3.RequestBlockNumber = 120
+		require.NoError(t, orm.UpsertPendingRequest(req3))
+		latest, err := orm.FindLatestRequestBlockNumber(jb.ID)
+		require.NoError(t, err)
+		assert.Equal(t, null.IntFrom(120), latest)
+
+		consumed, err := orm.IsPendingRequestConsumed(jb.ID, big.NewInt(3))
+		require.NoError(t, err)
+		assert.False(t, consumed)
+		require.NoError(t, orm.MarkPendingRequestsConsumed(jb.ID, []*big.Int{big.NewInt(3)}))
+		consumed, err = orm.IsPendingRequestConsumed(jb.ID, big.NewInt(3))
+		require.NoError(t, err)
+		assert.True(t, consumed)
+
+		// Consumed requests are not pending anymore, and are not reloaded.
+		reqs, err := orm.FindPendingRequestsByJobID(jb.ID)
+		require.NoError(t, err)
+		require.Len(t, reqs, 1)
+		assert.Equal(t, req2.RequestID, reqs[0].RequestID)
+		_, count, err := orm.FindPendingRequests(0, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+
+		require.NoError(t, orm.DeleteConsumedRequests(jb.ID, time.Now().Add(-time.Hour)))
+		consumed, err = orm.IsPendingRequestConsumed(jb.ID, big.NewInt(3))
+		require.NoError(t, err)
+		assert.True(t, consumed)
+		require.NoError(t, orm.DeleteConsumedRequests(jb.ID, time.Now().Add(time.Hour)))
+		consumed, err = orm.IsPendingRequestConsumed(jb.ID, big.NewInt(3))
+		require.NoError(t, err)
+		assert.False(t, consumed)
+		latest, err = orm.FindLatestRequestBlockNumber(jb.ID)
+		require.NoError(t, err)
+		assert.Equal(t, null.IntFrom(100), latest)
+	})
+}
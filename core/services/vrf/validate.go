@@ -81,13 +81,14 @@ func ValidatedVRFSpec(tomlString string) (job.Job, error) {
 		return jb, fmt.Errorf("gasLanePrice must be positive, given: %s", spec.GasLanePrice.String())
 	}
 
-	var foundVRFTask bool
+	var foundVRFTask, foundVRFV2Task bool
 	for _, t := range jb.Pipeline.Tasks {
 		if t.Type() == pipeline.TaskTypeVRF || t.Type() == pipeline.TaskTypeVRFV2 {
 			foundVRFTask = true
 		}
 
 		if t.Type() == pipeline.TaskTypeVRFV2 {
+			foundVRFV2Task = true
 			if len(spec.FromAddresses) == 0 {
 				return jb, errors.Wrap(ErrKeyNotSet, "fromAddreses needs to have a non-zero length")
 			}
@@ -96,6 +97,9 @@ func ValidatedVRFSpec(tomlString string) (job.Job, error) {
 	if !foundVRFTask {
 		return jb, errors.Wrapf(ErrKeyNotSet, "invalid pipeline, expected a vrf task")
 	}
+	if spec.LogPollerEnabled && !foundVRFV2Task {
+		return jb, errors.New("logPollerEnabled is only supported by VRF v2 jobs")
+	}
 
 	jb.VRFSpec = &spec
 
@@ -0,0 +1,367 @@
+package sessions
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// oidcRequestTimeout bounds the round trips to the issuer made for a single login
+const oidcRequestTimeout = 30 * time.Second
+
+// oidcMetadataTTL is how long the issuer's discovery document and keys are cached for. The keys
+// are fetched again before then if a token is signed by an unknown key, e.g. after a key rotation.
+const oidcMetadataTTL = 15 * time.Minute
+
+// Authenticator validates a user's credentials against an external identity
+// provider and returns the role that the user is granted by it.
+//
+//go:generate mockery --quiet --name Authenticator --output ./mocks/ --case=underscore
+type Authenticator interface {
+	Authenticate(email, password string) (UserRole, error)
+}
+
+// OIDCConfig is the subset of the WebServer.OIDC config used by the OIDC authenticator
+type OIDCConfig interface {
+	IssuerURL() *url.URL
+	ClientID() string
+	ClientSecret() string
+	GroupsClaim() string
+	AdminGroup() string
+	EditGroup() string
+	RunGroup() string
+	ViewGroup() string
+}
+
+type oidcAuthenticator struct {
+	cfg        OIDCConfig
+	httpClient *http.Client
+	lggr       logger.Logger
+
+	metadataMu sync.Mutex
+	metadata   *oidcMetadata
+}
+
+// oidcMetadata is the discovery document and keys of the issuer, as fetched at fetchedAt
+type oidcMetadata struct {
+	discovery oidcDiscovery
+	keys      []jsonWebKey
+	fetchedAt time.Time
+}
+
+var _ Authenticator = (*oidcAuthenticator)(nil)
+
+// NewOIDCAuthenticator returns an Authenticator which exchanges the user's
+// credentials for an ID token at the OIDC issuer using the resource owner
+// password grant, and maps the groups claim of that token to a UserRole.
+func NewOIDCAuthenticator(cfg OIDCConfig, httpClient *http.Client, lggr logger.Logger) Authenticator {
+	return &oidcAuthenticator{
+		cfg:        cfg,
+		httpClient: httpClient,
+		lggr:       lggr.Named("OIDCAuthenticator"),
+	}
+}
+
+type oidcDiscovery struct {
+	Issuer        string `json:"issuer"`
+	TokenEndpoint string `json:"token_endpoint"`
+	JWKSURI       string `json:"jwks_uri"`
+}
+
+type oidcTokenResponse struct {
+	IDToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// Authenticate returns the most privileged role mapped to one of the user's groups
+func (a *oidcAuthenticator) Authenticate(email, password string) (UserRole, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), oidcRequestTimeout)
+	defer cancel()
+
+	metadata, err := a.getMetadata(ctx, false)
+	if err != nil {
+		return "", err
+	}
+
+	idToken, err := a.exchangePassword(ctx, metadata.discovery.TokenEndpoint, email, password)
+	if err != nil {
+		return "", err
+	}
+
+	claims, err := a.verifyIDToken(ctx, idToken)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid ID token")
+	}
+
+	if tokenEmail, _ := claims["email"].(string); !strings.EqualFold(tokenEmail, email) {
+		return "", errors.Errorf("ID token was issued for %q", tokenEmail)
+	}
+	// Users are matched by email, so it must be one the issuer verified belongs to the user
+	if verified, _ := claims["email_verified"].(bool); !verified {
+		return "", errors.Errorf("email %q is not verified by the issuer", email)
+	}
+
+	groups := groupsFromClaim(claims[a.cfg.GroupsClaim()])
+	role, err := a.roleFor(groups)
+	if err != nil {
+		return "", err
+	}
+	a.lggr.Debugw("Authenticated user against OIDC issuer", "user", email, "groups", groups, "role", role)
+	return role, nil
+}
+
+func (a *oidcAuthenticator) exchangePassword(ctx context.Context, tokenEndpoint, email, password string) (string, error) {
+	form := url.Values{
+		"grant_type": {"password"},
+		"client_id":  {a.cfg.ClientID()},
+		"username":   {email},
+		"password":   {password},
+		"scope":      {"openid email profile"},
+	}
+	if secret := a.cfg.ClientSecret(); secret != "" {
+		form.Set("client_secret", secret)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	res, err := a.httpClient.Do(req)
+	if err != nil {
+		return "", errors.Wrap(err, "token request failed")
+	}
+	defer res.Body.Close()
+
+	var token oidcTokenResponse
+	if err = json.NewDecoder(io.LimitReader(res.Body, 1<<20)).Decode(&token); err != nil {
+		return "", errors.Wrapf(err, "failed to decode token response with status %d", res.StatusCode)
+	}
+	if token.Error != "" {
+		return "", errors.Errorf("issuer rejected credentials: %s: %s", token.Error, token.ErrorDescription)
+	}
+	if res.StatusCode != http.StatusOK || token.IDToken == "" {
+		return "", errors.Errorf("issuer returned no ID token, status %d", res.StatusCode)
+	}
+	return token.IDToken, nil
+}
+
+// getMetadata returns the cached discovery document and keys of the issuer, fetching them again
+// if they expired or refresh is set.
+func (a *oidcAuthenticator) getMetadata(ctx context.Context, refresh bool) (*oidcMetadata, error) {
+	a.metadataMu.Lock()
+	defer a.metadataMu.Unlock()
+	if !refresh && a.metadata != nil && time.Since(a.metadata.fetchedAt) < oidcMetadataTTL {
+		return a.metadata, nil
+	}
+
+	var discovery oidcDiscovery
+	discoveryURL := a.issuer() + "/.well-known/openid-configuration"
+	if err := a.getJSON(ctx, discoveryURL, &discovery); err != nil {
+		return nil, errors.Wrap(err, "failed to fetch OIDC discovery document")
+	}
+	if strings.TrimSuffix(discovery.Issuer, "/") != a.issuer() {
+		return nil, errors.Errorf("OIDC discovery document is for issuer %q", discovery.Issuer)
+	}
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := a.getJSON(ctx, discovery.JWKSURI, &jwks); err != nil {
+		return nil, errors.Wrap(err, "failed to fetch JWKS")
+	}
+	a.metadata = &oidcMetadata{discovery: discovery, keys: jwks.Keys, fetchedAt: time.Now()}
+	return a.metadata, nil
+}
+
+func (a *oidcAuthenticator) verifyIDToken(ctx context.Context, idToken string) (jwt.MapClaims, error) {
+	findKey := func(keys []jsonWebKey, kid, alg string) (jsonWebKey, bool) {
+		for _, k := range keys {
+			if (kid == "" || k.Kid == kid) && k.canVerify(alg) {
+				return k, true
+			}
+		}
+		return jsonWebKey{}, false
+	}
+	keyFunc := func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		alg := t.Method.Alg()
+		metadata, err := a.getMetadata(ctx, false)
+		if err != nil {
+			return nil, err
+		}
+		k, ok := findKey(metadata.keys, kid, alg)
+		if !ok {
+			// The issuer may have rotated its keys since they were cached
+			if metadata, err = a.getMetadata(ctx, true); err != nil {
+				return nil, err
+			}
+			if k, ok = findKey(metadata.keys, kid, alg); !ok {
+				return nil, errors.Errorf("no %s key found with kid %q", alg, kid)
+			}
+		}
+		return k.publicKey()
+	}
+	parser := jwt.NewParser(jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384"}))
+	claims := jwt.MapClaims{}
+	if _, err := parser.ParseWithClaims(idToken, claims, keyFunc); err != nil {
+		return nil, err
+	}
+	if iss, _ := claims["iss"].(string); strings.TrimSuffix(iss, "/") != a.issuer() {
+		return nil, errors.New("issuer mismatch")
+	}
+	if !claims.VerifyAudience(a.cfg.ClientID(), true) {
+		return nil, errors.New("audience mismatch")
+	}
+	return claims, nil
+}
+
+// issuer returns the configured issuer URL, without trailing slash
+func (a *oidcAuthenticator) issuer() string {
+	return strings.TrimSuffix(a.cfg.IssuerURL().String(), "/")
+}
+
+func (a *oidcAuthenticator) roleFor(groups []string) (UserRole, error) {
+	// ordered from most to least privileged
+	mappings := []struct {
+		group string
+		role  UserRole
+	}{
+		{a.cfg.AdminGroup(), UserRoleAdmin},
+		{a.cfg.EditGroup(), UserRoleEdit},
+		{a.cfg.RunGroup(), UserRoleRun},
+		{a.cfg.ViewGroup(), UserRoleView},
+	}
+	for _, m := range mappings {
+		if m.group == "" {
+			continue
+		}
+		for _, g := range groups {
+			if g == m.group {
+				return m.role, nil
+			}
+		}
+	}
+	return "", errors.New("user is not a member of any group mapped to a role")
+}
+
+func (a *oidcAuthenticator) getJSON(ctx context.Context, u string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	res, err := a.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return errors.Errorf("unexpected status %d from %s", res.StatusCode, u)
+	}
+	return json.NewDecoder(io.LimitReader(res.Body, 1<<20)).Decode(v)
+}
+
+// groupsFromClaim accepts either a list of groups or a single group
+func groupsFromClaim(claim interface{}) (groups []string) {
+	switch v := claim.(type) {
+	case string:
+		groups = append(groups, v)
+	case []interface{}:
+		for _, g := range v {
+			if s, ok := g.(string); ok {
+				groups = append(groups, s)
+			}
+		}
+	}
+	return
+}
+
+// canVerify returns true if the key can verify signatures made with the given JWS algorithm.
+func (k jsonWebKey) canVerify(alg string) bool {
+	if k.Use != "" && k.Use != "sig" {
+		return false
+	}
+	if k.Alg != "" && k.Alg != alg {
+		return false
+	}
+	switch {
+	case strings.HasPrefix(alg, "RS"):
+		return k.Kty == "RSA"
+	case alg == "ES256":
+		return k.Kty == "EC" && k.Crv == "P-256"
+	case alg == "ES384":
+		return k.Kty == "EC" && k.Crv == "P-384"
+	default:
+		return false
+	}
+}
+
+func (k jsonWebKey) publicKey() (interface{}, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBase64BigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBase64BigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		default:
+			return nil, errors.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBase64BigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBase64BigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, errors.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+func decodeBase64BigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid key encoding")
+	}
+	return new(big.Int).SetBytes(b), nil
+}
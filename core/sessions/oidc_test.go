@@ -0,0 +1,243 @@
+package sessions_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+type testOIDCConfig struct {
+	issuerURL *url.URL
+}
+
+func (c testOIDCConfig) IssuerURL() *url.URL  { return c.issuerURL }
+func (c testOIDCConfig) ClientID() string     { return "chainlink-node" }
+func (c testOIDCConfig) ClientSecret() string { return "client-secret" }
+func (c testOIDCConfig) GroupsClaim() string  { return "groups" }
+func (c testOIDCConfig) AdminGroup() string   { return "cl-admins" }
+func (c testOIDCConfig) EditGroup() string    { return "" }
+func (c testOIDCConfig) RunGroup() string     { return "cl-runners" }
+func (c testOIDCConfig) ViewGroup() string    { return "cl-viewers" }
+
+// newTestIssuer serves discovery, JWKS and a password grant token endpoint which accepts
+// "password" for any user and issues an ID token with the claims returned by claims.
+func newTestIssuer(t *testing.T, claims func(srvURL string, username string) jwt.MapClaims) *url.URL {
+	return newTestIssuerWithKeys(t, claims, "test-key", nil)
+}
+
+// newTestIssuerWithKeys is like newTestIssuer, but signs tokens with the given kid, which may be empty,
+// and lists otherKeys before the signing key in the JWKS.
+func newTestIssuerWithKeys(t *testing.T, claims func(srvURL string, username string) jwt.MapClaims, kid string, otherKeys []map[string]string) *url.URL {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	var srvURL string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]string{
+			"issuer":         srvURL,
+			"token_endpoint": srvURL + "/token",
+			"jwks_uri":       srvURL + "/jwks",
+		}))
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": append(otherKeys, map[string]string{
+				"kty": "RSA",
+				"kid": kid,
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}),
+		}))
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "password", r.PostForm.Get("grant_type"))
+		assert.Equal(t, "chainlink-node", r.PostForm.Get("client_id"))
+		assert.Equal(t, "client-secret", r.PostForm.Get("client_secret"))
+		if r.PostForm.Get("password") != "password" {
+			w.WriteHeader(http.StatusUnauthorized)
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant", "error_description": "Invalid user credentials"}))
+			return
+		}
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims(srvURL, r.PostForm.Get("username")))
+		if kid != "" {
+			token.Header["kid"] = kid
+		}
+		signed, err := token.SignedString(key)
+		require.NoError(t, err)
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]string{"id_token": signed}))
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	srvURL = srv.URL
+
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	return u
+}
+
+func TestOIDCAuthenticator_Authenticate(t *testing.T) {
+	t.Parallel()
+
+	groupsByUser := map[string][]string{
+		"admin@example.com":  {"cl-viewers", "cl-admins"},
+		"runner@example.com": {"other", "cl-runners"},
+		"nobody@example.com": {"other"},
+	}
+	issuer := newTestIssuer(t, func(srvURL string, username string) jwt.MapClaims {
+		aud := "chainlink-node"
+		if username == "wrongaud@example.com" {
+			aud = "another-client"
+		}
+		iss := srvURL
+		if username == "wrongiss@example.com" {
+			iss = "https://another-issuer.example.com"
+		}
+		return jwt.MapClaims{
+			"iss":            iss,
+			"aud":            aud,
+			"exp":            time.Now().Add(time.Minute).Unix(),
+			"email":          username,
+			"email_verified": username != "unverified@example.com",
+			"groups":         groupsByUser[username],
+		}
+	})
+	authenticator := sessions.NewOIDCAuthenticator(testOIDCConfig{issuerURL: issuer}, http.DefaultClient, logger.TestLogger(t))
+
+	role, err := authenticator.Authenticate("admin@example.com", "password")
+	require.NoError(t, err)
+	assert.Equal(t, sessions.UserRoleAdmin, role)
+
+	role, err = authenticator.Authenticate("runner@example.com", "password")
+	require.NoError(t, err)
+	assert.Equal(t, sessions.UserRoleRun, role)
+
+	_, err = authenticator.Authenticate("nobody@example.com", "password")
+	assert.EqualError(t, err, "user is not a member of any group mapped to a role")
+
+	_, err = authenticator.Authenticate("admin@example.com", "wrong")
+	assert.EqualError(t, err, "issuer rejected credentials: invalid_grant: Invalid user credentials")
+
+	_, err = authenticator.Authenticate("wrongaud@example.com", "password")
+	assert.EqualError(t, err, "invalid ID token: audience mismatch")
+
+	_, err = authenticator.Authenticate("wrongiss@example.com", "password")
+	assert.EqualError(t, err, "invalid ID token: issuer mismatch")
+
+	_, err = authenticator.Authenticate("unverified@example.com", "password")
+	assert.EqualError(t, err, `email "unverified@example.com" is not verified by the issuer`)
+}
+
+func TestOIDCAuthenticator_Authenticate_MixedKeyTypes(t *testing.T) {
+	t.Parallel()
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherKeys := []map[string]string{
+		{
+			"kty": "EC",
+			"crv": "P-256",
+			"x":   base64.RawURLEncoding.EncodeToString(ecKey.X.Bytes()),
+			"y":   base64.RawURLEncoding.EncodeToString(ecKey.Y.Bytes()),
+		},
+		{"kty": "RSA", "alg": "RS512", "n": "AQAB", "e": "AQAB"},
+		{"kty": "RSA", "use": "enc", "n": "AQAB", "e": "AQAB"},
+	}
+	// the token has no kid, so the key is picked by its type and algorithm
+	issuer := newTestIssuerWithKeys(t, func(srvURL string, username string) jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":            srvURL,
+			"aud":            "chainlink-node",
+			"exp":            time.Now().Add(time.Minute).Unix(),
+			"email":          username,
+			"email_verified": true,
+			"groups":         []string{"cl-viewers"},
+		}
+	}, "", otherKeys)
+	authenticator := sessions.NewOIDCAuthenticator(testOIDCConfig{issuerURL: issuer}, http.DefaultClient, logger.TestLogger(t))
+
+	role, err := authenticator.Authenticate("viewer@example.com", "password")
+	require.NoError(t, err)
+	assert.Equal(t, sessions.UserRoleView, role)
+}
+
+// countingTransport counts the requests made by path
+type countingTransport struct {
+	mu    sync.Mutex
+	paths map[string]int
+}
+
+func (c *countingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	c.mu.Lock()
+	c.paths[r.URL.Path]++
+	c.mu.Unlock()
+	return http.DefaultTransport.RoundTrip(r)
+}
+
+func (c *countingTransport) count(path string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.paths[path]
+}
+
+func TestOIDCAuthenticator_Authenticate_CachesMetadata(t *testing.T) {
+	t.Parallel()
+
+	issuer := newTestIssuer(t, func(srvURL string, username string) jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":            srvURL,
+			"aud":            "chainlink-node",
+			"exp":            time.Now().Add(time.Minute).Unix(),
+			"email":          username,
+			"email_verified": true,
+			"groups":         []string{"cl-admins"},
+		}
+	})
+	transport := &countingTransport{paths: map[string]int{}}
+	authenticator := sessions.NewOIDCAuthenticator(testOIDCConfig{issuerURL: issuer}, &http.Client{Transport: transport}, logger.TestLogger(t))
+
+	for i := 0; i < 3; i++ {
+		_, err := authenticator.Authenticate("admin@example.com", "password")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 3, transport.count("/token"))
+	assert.Equal(t, 1, transport.count("/.well-known/openid-configuration"))
+	assert.Equal(t, 1, transport.count("/jwks"))
+}
+
+func TestOIDCAuthenticator_Authenticate_EmailMismatch(t *testing.T) {
+	t.Parallel()
+
+	issuer := newTestIssuer(t, func(srvURL string, username string) jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":            srvURL,
+			"aud":            "chainlink-node",
+			"exp":            time.Now().Add(time.Minute).Unix(),
+			"email":          "someone-else@example.com",
+			"email_verified": true,
+			"groups":         []string{"cl-admins"},
+		}
+	})
+	authenticator := sessions.NewOIDCAuthenticator(testOIDCConfig{issuerURL: issuer}, http.DefaultClient, logger.TestLogger(t))
+
+	_, err := authenticator.Authenticate("admin@example.com", "password")
+	assert.EqualError(t, err, `ID token was issued for "someone-else@example.com"`)
+}
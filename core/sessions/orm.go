@@ -2,6 +2,7 @@ package sessions
 
 import (
 	"crypto/subtle"
+	"database/sql"
 	"encoding/json"
 	"strings"
 	"time"
@@ -47,17 +48,29 @@ type orm struct {
 	sessionDuration time.Duration
 	lggr            logger.Logger
 	auditLogger     audit.AuditLogger
+	authenticator   Authenticator
+	allowLocalLogin bool
 }
 
 var _ ORM = (*orm)(nil)
 
 func NewORM(db *sqlx.DB, sd time.Duration, lggr logger.Logger, cfg pg.QConfig, auditLogger audit.AuditLogger) ORM {
+	return NewORMWithAuthenticator(db, sd, lggr, cfg, auditLogger, nil, true)
+}
+
+// NewORMWithAuthenticator returns an ORM which, when authenticator is non-nil, validates login
+// credentials against it instead of the local users table. If allowLocalLogin is set, users
+// with a local password keep logging in with it (and WebAuthn), so that a break-glass admin
+// remains usable when the external identity provider is unavailable.
+func NewORMWithAuthenticator(db *sqlx.DB, sd time.Duration, lggr logger.Logger, cfg pg.QConfig, auditLogger audit.AuditLogger, authenticator Authenticator, allowLocalLogin bool) ORM {
 	namedLogger := lggr.Named("SessionsORM")
 	return &orm{
 		q:               pg.NewQ(db, namedLogger, cfg),
 		sessionDuration: sd,
 		lggr:            lggr.Named("SessionsORM"),
 		auditLogger:     auditLogger,
+		authenticator:   authenticator,
+		allowLocalLogin: allowLocalLogin,
 	}
 }
 
@@ -163,8 +176,14 @@ func (o *orm) GetUserWebAuthn(email string) ([]WebAuthn, error) {
 
 // CreateSession will check the password in the SessionRequest against
 // the hashed API User password in the db. Also will check WebAuthn if it's
-// enabled for that user.
+// enabled for that user. If an external Authenticator is configured, the
+// credentials are checked against it instead, unless local logins are allowed
+// and the user has a local password.
 func (o *orm) CreateSession(sr SessionRequest) (string, error) {
+	if o.authenticator != nil && !(o.allowLocalLogin && o.hasLocalPassword(sr.Email)) {
+		return o.createExternalSession(sr)
+	}
+
 	user, err := o.FindUser(sr.Email)
 	if err != nil {
 		return "", err
@@ -252,6 +271,58 @@ func (o *orm) CreateSession(sr SessionRequest) (string, error) {
 	return session.ID, nil
 }
 
+// hasLocalPassword reports whether the user exists with a local password. Users created
+// by external authentication have none.
+func (o *orm) hasLocalPassword(email string) bool {
+	user, err := o.findUser(email)
+	return err == nil && user.HashedPassword != ""
+}
+
+// createExternalSession checks the credentials in the SessionRequest against the external
+// Authenticator, then creates the user or updates its role to the one granted by the identity
+// provider. Existing sessions are purged when the role changes, same as UpdateRole.
+func (o *orm) createExternalSession(sr SessionRequest) (string, error) {
+	email := strings.ToLower(sr.Email)
+	lggr := o.lggr.With("user", email)
+
+	role, err := o.authenticator.Authenticate(email, sr.Password)
+	if err != nil {
+		lggr.Infow("External authentication failed", "err", err)
+		o.auditLogger.Audit(audit.AuthLoginFailedExternal, map[string]interface{}{"email": sr.Email, "error": err})
+		return "", errors.New("Invalid credentials")
+	}
+
+	session := NewSession()
+	err = o.q.Transaction(func(tx pg.Queryer) error {
+		var previousRole UserRole
+		err := tx.Get(&previousRole, "SELECT role FROM users WHERE lower(email) = lower($1) FOR UPDATE", email)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return errors.Wrap(err, "failed to load user")
+		}
+		if previousRole != role {
+			if _, err = tx.Exec("DELETE FROM sessions WHERE lower(email) = lower($1)", email); err != nil {
+				return errors.Wrap(err, "failed to purge user sessions")
+			}
+		}
+		// Externally authenticated users have no local password. The local password of an existing user is
+		// cleared, so that local login can't be used for it again once the identity provider took it over.
+		_, err = tx.Exec(`INSERT INTO users (email, hashed_password, role, created_at, updated_at) VALUES ($1, '', $2, now(), now())
+ON CONFLICT (email) DO UPDATE SET role = EXCLUDED.role, hashed_password = '', updated_at = now()`, email, role)
+		if err != nil {
+			return errors.Wrap(err, "failed to upsert user")
+		}
+		_, err = tx.Exec("INSERT INTO sessions (id, email, last_used, created_at) VALUES ($1, $2, now(), now())", session.ID, email)
+		return errors.Wrap(err, "failed to create session")
+	})
+	if err != nil {
+		return "", err
+	}
+
+	lggr.Infow("User passed external authentication. Creating Session", "role", role)
+	o.auditLogger.Audit(audit.AuthLoginSuccessExternal, map[string]interface{}{"email": sr.Email, "role": role})
+	return session.ID, nil
+}
+
 const constantTimeEmailLength = 256
 
 func constantTimeEmailCompare(left, right string) bool {
@@ -2,6 +2,8 @@ package sessions_test
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -18,6 +20,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
@@ -184,6 +187,107 @@ func TestORM_CreateSession(t *testing.T) {
 	}
 }
 
+func TestORM_CreateSession_ExternalAuthenticator(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	authenticator := mocks.NewAuthenticator(t)
+	orm := sessions.NewORMWithAuthenticator(db, time.Minute, logger.TestLogger(t), pgtest.NewQConfig(true), &audit.AuditLoggerService{}, authenticator, false)
+
+	const email = "operator@directory.org"
+	const password = "directory-password"
+
+	t.Run("rejected credentials", func(t *testing.T) {
+		authenticator.On("Authenticate", email, "wrong").Return(sessions.UserRole(""), errors.New("invalid_grant")).Once()
+
+		sessionID, err := orm.CreateSession(sessions.SessionRequest{Email: email, Password: "wrong"})
+		require.EqualError(t, err, "Invalid credentials")
+		assert.Empty(t, sessionID)
+		_, err = orm.FindUser(email)
+		require.Error(t, err)
+	})
+
+	t.Run("creates the user with the granted role", func(t *testing.T) {
+		authenticator.On("Authenticate", email, password).Return(sessions.UserRoleRun, nil).Once()
+
+		sessionID, err := orm.CreateSession(sessions.SessionRequest{Email: "Operator@Directory.org", Password: password})
+		require.NoError(t, err)
+		user, err := orm.AuthorizedUserWithSession(sessionID)
+		require.NoError(t, err)
+		assert.Equal(t, email, user.Email)
+		assert.Equal(t, sessions.UserRoleRun, user.Role)
+		assert.Empty(t, user.HashedPassword)
+	})
+
+	t.Run("updates the role and purges sessions when group membership changes", func(t *testing.T) {
+		authenticator.On("Authenticate", email, password).Return(sessions.UserRoleRun, nil).Once()
+		oldSessionID, err := orm.CreateSession(sessions.SessionRequest{Email: email, Password: password})
+		require.NoError(t, err)
+
+		authenticator.On("Authenticate", email, password).Return(sessions.UserRoleAdmin, nil).Once()
+		sessionID, err := orm.CreateSession(sessions.SessionRequest{Email: email, Password: password})
+		require.NoError(t, err)
+
+		user, err := orm.AuthorizedUserWithSession(sessionID)
+		require.NoError(t, err)
+		assert.Equal(t, sessions.UserRoleAdmin, user.Role)
+		_, err = orm.AuthorizedUserWithSession(oldSessionID)
+		require.Error(t, err)
+	})
+
+	t.Run("clears the local password of a user taken over by the identity provider", func(t *testing.T) {
+		local := cltest.MustRandomUser(t)
+		require.NoError(t, orm.CreateUser(&local))
+
+		authenticator.On("Authenticate", local.Email, password).Return(sessions.UserRoleView, nil).Once()
+		_, err := orm.CreateSession(sessions.SessionRequest{Email: local.Email, Password: password})
+		require.NoError(t, err)
+
+		user, err := orm.FindUser(local.Email)
+		require.NoError(t, err)
+		assert.Empty(t, user.HashedPassword)
+	})
+}
+
+func TestORM_CreateSession_ExternalAuthenticator_LocalLogin(t *testing.T) {
+	t.Parallel()
+
+	const externalEmail = "operator@directory.org"
+
+	for _, allowLocalLogin := range []bool{true, false} {
+		allowLocalLogin := allowLocalLogin
+		t.Run(fmt.Sprintf("allowLocalLogin=%t", allowLocalLogin), func(t *testing.T) {
+			t.Parallel()
+
+			db := pgtest.NewSqlxDB(t)
+			authenticator := mocks.NewAuthenticator(t)
+			orm := sessions.NewORMWithAuthenticator(db, time.Minute, logger.TestLogger(t), pgtest.NewQConfig(true), &audit.AuditLoggerService{}, authenticator, allowLocalLogin)
+
+			local := cltest.MustRandomUser(t)
+			require.NoError(t, orm.CreateUser(&local))
+
+			if !allowLocalLogin {
+				authenticator.On("Authenticate", local.Email, cltest.Password).Return(sessions.UserRole(""), errors.New("unknown user")).Once()
+			}
+			sessionID, err := orm.CreateSession(sessions.SessionRequest{Email: local.Email, Password: cltest.Password})
+			if allowLocalLogin {
+				require.NoError(t, err)
+				assert.NotEmpty(t, sessionID)
+			} else {
+				require.EqualError(t, err, "Invalid credentials")
+			}
+
+			// Users created by external logins have no local password and always go to the authenticator
+			authenticator.On("Authenticate", externalEmail, "password").Return(sessions.UserRoleView, nil).Twice()
+			for i := 0; i < 2; i++ {
+				sessionID, err = orm.CreateSession(sessions.SessionRequest{Email: externalEmail, Password: "password"})
+				require.NoError(t, err)
+				assert.NotEmpty(t, sessionID)
+			}
+		})
+	}
+}
+
 func TestORM_WebAuthn(t *testing.T) {
 	t.Parallel()
 
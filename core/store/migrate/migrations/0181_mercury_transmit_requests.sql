@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE mercury_transmit_requests (
+	feed_id BYTEA NOT NULL CHECK (octet_length(feed_id) = 32),
+	config_digest BYTEA NOT NULL CHECK (octet_length(config_digest) = 32),
+	epoch BIGINT NOT NULL,
+	round INT NOT NULL,
+	extra_hash BYTEA NOT NULL CHECK (octet_length(extra_hash) = 32),
+	payload BYTEA NOT NULL,
+	payload_hash BYTEA NOT NULL CHECK (octet_length(payload_hash) = 32),
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	PRIMARY KEY (feed_id, config_digest, payload_hash)
+);
+
+CREATE INDEX idx_mercury_transmit_requests_feed_id_epoch_round ON mercury_transmit_requests (feed_id, epoch DESC, round DESC);
+
+-- +goose Down
+DROP TABLE mercury_transmit_requests;
@@ -0,0 +1,18 @@
+-- +goose Up
+-- Requests persisted before multi-server support can't be attributed to a server
+DELETE FROM mercury_transmit_requests;
+ALTER TABLE mercury_transmit_requests
+	ADD COLUMN server_url TEXT NOT NULL,
+	DROP CONSTRAINT mercury_transmit_requests_pkey,
+	ADD PRIMARY KEY (server_url, feed_id, config_digest, payload_hash);
+DROP INDEX idx_mercury_transmit_requests_feed_id_epoch_round;
+CREATE INDEX idx_mercury_transmit_requests_server_url_feed_id_epoch_round ON mercury_transmit_requests (server_url, feed_id, epoch DESC, round DESC);
+
+-- +goose Down
+DELETE FROM mercury_transmit_requests;
+DROP INDEX idx_mercury_transmit_requests_server_url_feed_id_epoch_round;
+ALTER TABLE mercury_transmit_requests
+	DROP CONSTRAINT mercury_transmit_requests_pkey,
+	DROP COLUMN server_url,
+	ADD PRIMARY KEY (feed_id, config_digest, payload_hash);
+CREATE INDEX idx_mercury_transmit_requests_feed_id_epoch_round ON mercury_transmit_requests (feed_id, epoch DESC, round DESC);
@@ -0,0 +1,6 @@
+-- +goose Up
+-- start_block is set while the logs of a filter still need to be backfilled from that block, and cleared once done.
+ALTER TABLE evm_log_poller_filters ADD COLUMN start_block BIGINT;
+
+-- +goose Down
+ALTER TABLE evm_log_poller_filters DROP COLUMN start_block;
@@ -0,0 +1,11 @@
+-- +goose Up
+ALTER TABLE cron_specs
+    ADD COLUMN timezone TEXT NOT NULL DEFAULT '',
+    ADD COLUMN overlap_policy TEXT NOT NULL DEFAULT '',
+    ADD COLUMN catch_up_window BIGINT NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE cron_specs
+    DROP COLUMN timezone,
+    DROP COLUMN overlap_policy,
+    DROP COLUMN catch_up_window;
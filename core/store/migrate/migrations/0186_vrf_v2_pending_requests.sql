@@ -0,0 +1,22 @@
+-- +goose Up
+CREATE TABLE vrf_v2_pending_requests (
+    id BIGSERIAL PRIMARY KEY,
+    job_id INTEGER NOT NULL REFERENCES jobs (id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    evm_chain_id NUMERIC(78, 0) NOT NULL,
+    request_id NUMERIC(78, 0) NOT NULL,
+    sub_id NUMERIC(20, 0) NOT NULL,
+    confirmed_at_block BIGINT NOT NULL,
+    request_tx_hash BYTEA NOT NULL CHECK (octet_length(request_tx_hash) = 32),
+    request_block_number BIGINT NOT NULL,
+    -- raw_log is the RandomWordsRequested log, from which the request is rebuilt on restart.
+    raw_log JSONB NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_try_at TIMESTAMPTZ,
+    last_error TEXT,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    UNIQUE (job_id, request_id)
+);
+
+-- +goose Down
+DROP TABLE vrf_v2_pending_requests;
@@ -0,0 +1,8 @@
+-- +goose Up
+ALTER TABLE vrf_specs
+    ADD COLUMN "log_poller_enabled" BOOLEAN
+    DEFAULT FALSE
+    NOT NULL;
+
+-- +goose Down
+ALTER TABLE vrf_specs DROP COLUMN "log_poller_enabled";
@@ -0,0 +1,21 @@
+-- +goose Up
+ALTER TABLE blockhash_store_specs
+    ADD COLUMN coordinators JSONB DEFAULT '[]' NOT NULL,
+    ADD COLUMN batch_blockhash_store_address bytea DEFAULT NULL,
+    ADD COLUMN store_blockhashes_batch_size integer DEFAULT 0 NOT NULL,
+    ADD CONSTRAINT batch_blockhash_store_address_len_chk CHECK (octet_length(batch_blockhash_store_address) = 20),
+    DROP CONSTRAINT at_least_one_coordinator_chk,
+    ADD CONSTRAINT at_least_one_coordinator_chk CHECK (
+        coordinator_v1_address IS NOT NULL
+        OR coordinator_v2_address IS NOT NULL
+        OR jsonb_array_length(coordinators) > 0);
+
+-- +goose Down
+ALTER TABLE blockhash_store_specs
+    DROP CONSTRAINT at_least_one_coordinator_chk,
+    DROP CONSTRAINT batch_blockhash_store_address_len_chk,
+    DROP COLUMN coordinators,
+    DROP COLUMN batch_blockhash_store_address,
+    DROP COLUMN store_blockhashes_batch_size,
+    -- Jobs watching only coordinators of the list are left as they are.
+    ADD CONSTRAINT at_least_one_coordinator_chk CHECK (coordinator_v1_address IS NOT NULL OR coordinator_v2_address IS NOT NULL) NOT VALID;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE flux_monitor_specs ADD COLUMN deviation_tiers JSONB DEFAULT '[]' NOT NULL;
+
+-- +goose Down
+ALTER TABLE flux_monitor_specs DROP COLUMN deviation_tiers;
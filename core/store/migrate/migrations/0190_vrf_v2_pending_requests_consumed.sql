@@ -0,0 +1,7 @@
+-- +goose Up
+-- Requests of jobs using the log poller are kept once consumed, so that their logs are not
+-- handled again when polled again after a restart.
+ALTER TABLE vrf_v2_pending_requests ADD COLUMN consumed_at TIMESTAMPTZ;
+
+-- +goose Down
+ALTER TABLE vrf_v2_pending_requests DROP COLUMN consumed_at;
@@ -0,0 +1,17 @@
+-- +goose Up
+-- The scheduled time of the latest run fired by each cron job, from which missed runs are caught up on.
+CREATE TABLE cron_last_scheduled_runs (
+    cron_spec_id INT PRIMARY KEY REFERENCES cron_specs (id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    scheduled_at TIMESTAMPTZ NOT NULL
+);
+
+-- Jobs that already ran resume catching up after their latest run
+INSERT INTO cron_last_scheduled_runs (cron_spec_id, scheduled_at)
+SELECT jobs.cron_spec_id, max(pipeline_runs.created_at)
+FROM jobs
+JOIN pipeline_runs ON pipeline_runs.pipeline_spec_id = jobs.pipeline_spec_id
+WHERE jobs.cron_spec_id IS NOT NULL
+GROUP BY jobs.cron_spec_id;
+
+-- +goose Down
+DROP TABLE cron_last_scheduled_runs;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE flux_monitor_specs ADD COLUMN heartbeat_schedule TEXT DEFAULT '' NOT NULL;
+
+-- +goose Down
+ALTER TABLE flux_monitor_specs DROP COLUMN heartbeat_schedule;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE blockhash_store_specs ADD COLUMN trusted_blockhash_stores JSONB DEFAULT '[]' NOT NULL;
+
+-- +goose Down
+ALTER TABLE blockhash_store_specs DROP COLUMN trusted_blockhash_stores;
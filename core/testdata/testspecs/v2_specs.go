@@ -255,6 +255,7 @@ type VRFSpecParams struct {
 	BackoffInitialDelay           time.Duration
 	BackoffMaxDelay               time.Duration
 	GasLanePrice                  *assets.Wei
+	LogPollerEnabled              bool
 }
 
 type VRFSpec struct {
@@ -378,6 +379,7 @@ chunkSize = %d
 backoffInitialDelay = "%s"
 backoffMaxDelay = "%s"
 gasLanePrice = "%s"
+logPollerEnabled = %v
 observationSource = """
 %s
 """
@@ -386,7 +388,7 @@ observationSource = """
 		jobID, name, coordinatorAddress, batchCoordinatorAddress,
 		params.BatchFulfillmentEnabled, strconv.FormatFloat(batchFulfillmentGasMultiplier, 'f', 2, 64),
 		vrfOwnerAddress, confirmations, params.RequestedConfsDelay, requestTimeout.String(), publicKey, chunkSize,
-		params.BackoffInitialDelay.String(), params.BackoffMaxDelay.String(), gasLanePrice.String(), observationSource)
+		params.BackoffInitialDelay.String(), params.BackoffMaxDelay.String(), gasLanePrice.String(), params.LogPollerEnabled, observationSource)
 	if len(params.FromAddresses) != 0 {
 		var addresses []string
 		for _, address := range params.FromAddresses {
@@ -410,6 +412,7 @@ observationSource = """
 		BackoffInitialDelay:      params.BackoffInitialDelay,
 		BackoffMaxDelay:          params.BackoffMaxDelay,
 		VRFOwnerAddress:          vrfOwnerAddress,
+		LogPollerEnabled:         params.LogPollerEnabled,
 	}, toml: toml}
 }
 
@@ -3,7 +3,9 @@ package web
 import (
 	"database/sql"
 	"net/http"
+	"strconv"
 
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 
@@ -47,3 +49,44 @@ func (tc *TransactionsController) Show(c *gin.Context) {
 
 	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(*ethTxAttempt), "transaction")
 }
+
+// Cancel cancels an unstarted or unconfirmed transaction. Unconfirmed
+// transactions are replaced by a zero value transfer to self with a bumped fee.
+// Example:
+//
+//	"<application>/transactions/evm/:id/cancel"
+func (tc *TransactionsController) Cancel(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	etx, err := tc.App.TxmStorageService().FindTxWithAttempts(id)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Transaction not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	chain, err := tc.App.GetChains().EVM.Get(etx.ChainID)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	etx, err = chain.TxManager().Cancel(c.Request.Context(), id)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, errors.Errorf("failed to cancel transaction: %v", err))
+		return
+	}
+
+	tc.App.GetAuditLogger().Audit(audit.EthTransactionCancelled, map[string]interface{}{
+		"ethTX": etx,
+	})
+
+	jsonAPIResponse(c, presenters.NewEthTxResource(etx), "transaction")
+}
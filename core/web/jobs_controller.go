@@ -157,6 +157,58 @@ func (jc *JobsController) Delete(c *gin.Context) {
 	jsonAPIResponseWithStatus(c, nil, "job", http.StatusNoContent)
 }
 
+// Pause stops the services of a job without deleting it.
+// Example:
+// "POST <application>/jobs/:ID/pause"
+func (jc *JobsController) Pause(c *gin.Context) {
+	jc.setPaused(c, true)
+}
+
+// Resume starts the services of a paused job.
+// Example:
+// "POST <application>/jobs/:ID/resume"
+func (jc *JobsController) Resume(c *gin.Context) {
+	jc.setPaused(c, false)
+}
+
+func (jc *JobsController) setPaused(c *gin.Context, paused bool) {
+	j := job.Job{}
+	err := j.SetID(c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	spawner := jc.App.JobSpawner()
+	event := audit.JobResumed
+	if paused {
+		err = spawner.PauseJob(j.ID, pg.WithParentCtx(c.Request.Context()))
+		event = audit.JobPaused
+	} else {
+		err = spawner.ResumeJob(j.ID, pg.WithParentCtx(c.Request.Context()))
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("JobSpec not found"))
+		return
+	}
+	if errors.Is(err, job.ErrJobAlreadyPaused) || errors.Is(err, job.ErrJobNotPaused) {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jc.App.GetAuditLogger().Audit(event, map[string]interface{}{"id": j.ID})
+
+	jb, err := jc.App.JobORM().FindJobTx(j.ID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
+}
+
 // UpdateJobRequest represents a request to update a job with new toml and start a job (V2).
 type UpdateJobRequest struct {
 	TOML string `json:"toml"`
@@ -473,6 +473,57 @@ func TestJobsController_Show_NonExistentID(t *testing.T) {
 	cltest.AssertServerResponse(t, response, http.StatusNotFound)
 }
 
+func TestJobsController_PauseResume(t *testing.T) {
+	app, client, _, _, _, jobID := setupJobSpecsControllerTestsWithJobs(t)
+
+	response, cleanup := client.Post(fmt.Sprintf("/v2/jobs/%v/pause", jobID), nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	resource := presenters.JobResource{}
+	err := web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource)
+	require.NoError(t, err)
+	assert.True(t, resource.Paused)
+	assert.NotContains(t, app.JobSpawner().ActiveJobs(), jobID)
+
+	response, cleanup = client.Post(fmt.Sprintf("/v2/jobs/%v/resume", jobID), nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	resource = presenters.JobResource{}
+	err = web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource)
+	require.NoError(t, err)
+	assert.False(t, resource.Paused)
+	assert.Contains(t, app.JobSpawner().ActiveJobs(), jobID)
+}
+
+func TestJobsController_PauseResume_Conflict(t *testing.T) {
+	_, client, _, _, _, jobID := setupJobSpecsControllerTestsWithJobs(t)
+
+	response, cleanup := client.Post(fmt.Sprintf("/v2/jobs/%v/resume", jobID), nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusConflict)
+	assert.Contains(t, string(cltest.ParseResponseBody(t, response)), "job is not paused")
+
+	response, cleanup = client.Post(fmt.Sprintf("/v2/jobs/%v/pause", jobID), nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	response, cleanup = client.Post(fmt.Sprintf("/v2/jobs/%v/pause", jobID), nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusConflict)
+	assert.Contains(t, string(cltest.ParseResponseBody(t, response)), "job is already paused")
+}
+
+func TestJobsController_Pause_NonExistentID(t *testing.T) {
+	_, client, _, _, _, _ := setupJobSpecsControllerTestsWithJobs(t)
+
+	response, cleanup := client.Post("/v2/jobs/999999999/pause", nil)
+	t.Cleanup(cleanup)
+
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+}
+
 func TestJobsController_Update_HappyPath(t *testing.T) {
 	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
 		c.OCR.Enabled = ptr(true)
@@ -72,20 +72,22 @@ func NewDirectRequestSpec(spec *job.DirectRequestSpec) *DirectRequestSpec {
 
 // FluxMonitorSpec defines the spec details of a FluxMonitor Job
 type FluxMonitorSpec struct {
-	ContractAddress     ethkey.EIP55Address `json:"contractAddress"`
-	Threshold           float32             `json:"threshold"`
-	AbsoluteThreshold   float32             `json:"absoluteThreshold"`
-	PollTimerPeriod     string              `json:"pollTimerPeriod"`
-	PollTimerDisabled   bool                `json:"pollTimerDisabled"`
-	IdleTimerPeriod     string              `json:"idleTimerPeriod"`
-	IdleTimerDisabled   bool                `json:"idleTimerDisabled"`
-	DrumbeatEnabled     bool                `json:"drumbeatEnabled"`
-	DrumbeatSchedule    *string             `json:"drumbeatSchedule"`
-	DrumbeatRandomDelay *string             `json:"drumbeatRandomDelay"`
-	MinPayment          *assets.Link        `json:"minPayment"`
-	CreatedAt           time.Time           `json:"createdAt"`
-	UpdatedAt           time.Time           `json:"updatedAt"`
-	EVMChainID          *utils.Big          `json:"evmChainID"`
+	ContractAddress     ethkey.EIP55Address           `json:"contractAddress"`
+	Threshold           float32                       `json:"threshold"`
+	AbsoluteThreshold   float32                       `json:"absoluteThreshold"`
+	DeviationTiers      job.FluxMonitorDeviationTiers `json:"deviationTiers"`
+	PollTimerPeriod     string                        `json:"pollTimerPeriod"`
+	PollTimerDisabled   bool                          `json:"pollTimerDisabled"`
+	IdleTimerPeriod     string                        `json:"idleTimerPeriod"`
+	IdleTimerDisabled   bool                          `json:"idleTimerDisabled"`
+	DrumbeatEnabled     bool                          `json:"drumbeatEnabled"`
+	DrumbeatSchedule    *string                       `json:"drumbeatSchedule"`
+	DrumbeatRandomDelay *string                       `json:"drumbeatRandomDelay"`
+	HeartbeatSchedule   *string                       `json:"heartbeatSchedule"`
+	MinPayment          *assets.Link                  `json:"minPayment"`
+	CreatedAt           time.Time                     `json:"createdAt"`
+	UpdatedAt           time.Time                     `json:"updatedAt"`
+	EVMChainID          *utils.Big                    `json:"evmChainID"`
 }
 
 // NewFluxMonitorSpec initializes a new DirectFluxMonitorSpec from a
@@ -100,10 +102,15 @@ func NewFluxMonitorSpec(spec *job.FluxMonitorSpec) *FluxMonitorSpec {
 		drumbeatRandomDelay := spec.DrumbeatRandomDelay.String()
 		drumbeatRandomDelayPtr = &drumbeatRandomDelay
 	}
+	var heartbeatSchedulePtr *string
+	if spec.HeartbeatSchedule != "" {
+		heartbeatSchedulePtr = &spec.HeartbeatSchedule
+	}
 	return &FluxMonitorSpec{
 		ContractAddress:     spec.ContractAddress,
 		Threshold:           float32(spec.Threshold),
 		AbsoluteThreshold:   float32(spec.AbsoluteThreshold),
+		DeviationTiers:      spec.DeviationTiers,
 		PollTimerPeriod:     spec.PollTimerPeriod.String(),
 		PollTimerDisabled:   spec.PollTimerDisabled,
 		IdleTimerPeriod:     spec.IdleTimerPeriod.String(),
@@ -111,6 +118,7 @@ func NewFluxMonitorSpec(spec *job.FluxMonitorSpec) *FluxMonitorSpec {
 		DrumbeatEnabled:     spec.DrumbeatEnabled,
 		DrumbeatSchedule:    drumbeatSchedulePtr,
 		DrumbeatRandomDelay: drumbeatRandomDelayPtr,
+		HeartbeatSchedule:   heartbeatSchedulePtr,
 		MinPayment:          spec.MinPayment,
 		CreatedAt:           spec.CreatedAt,
 		UpdatedAt:           spec.UpdatedAt,
@@ -269,17 +277,23 @@ func NewWebhookSpec(spec *job.WebhookSpec) *WebhookSpec {
 
 // CronSpec defines the spec details of a Cron Job
 type CronSpec struct {
-	CronSchedule string    `json:"schedule" tom:"schedule"`
-	CreatedAt    time.Time `json:"createdAt"`
-	UpdatedAt    time.Time `json:"updatedAt"`
+	CronSchedule  string                `json:"schedule" tom:"schedule"`
+	Timezone      string                `json:"timezone"`
+	OverlapPolicy job.CronOverlapPolicy `json:"overlapPolicy"`
+	CatchUpWindow models.Duration       `json:"catchUpWindow"`
+	CreatedAt     time.Time             `json:"createdAt"`
+	UpdatedAt     time.Time             `json:"updatedAt"`
 }
 
 // NewCronSpec generates a new CronSpec from a job.CronSpec
 func NewCronSpec(spec *job.CronSpec) *CronSpec {
 	return &CronSpec{
-		CronSchedule: spec.CronSchedule,
-		CreatedAt:    spec.CreatedAt,
-		UpdatedAt:    spec.UpdatedAt,
+		CronSchedule:  spec.CronSchedule,
+		Timezone:      spec.Timezone,
+		OverlapPolicy: spec.OverlapPolicy,
+		CatchUpWindow: models.MustMakeDuration(spec.CatchUpWindow),
+		CreatedAt:     spec.CreatedAt,
+		UpdatedAt:     spec.UpdatedAt,
 	}
 }
 
@@ -301,6 +315,7 @@ type VRFSpec struct {
 	BackoffMaxDelay               models.Duration       `json:"backoffMaxDelay"`
 	GasLanePrice                  *assets.Wei           `json:"gasLanePrice"`
 	VRFOwnerAddress               *ethkey.EIP55Address  `json:"vrfOwnerAddress"`
+	LogPollerEnabled              bool                  `json:"logPollerEnabled"`
 }
 
 func NewVRFSpec(spec *job.VRFSpec) *VRFSpec {
@@ -320,36 +335,45 @@ func NewVRFSpec(spec *job.VRFSpec) *VRFSpec {
 		BackoffInitialDelay:      models.MustMakeDuration(spec.BackoffInitialDelay),
 		BackoffMaxDelay:          models.MustMakeDuration(spec.BackoffMaxDelay),
 		GasLanePrice:             spec.GasLanePrice,
+		LogPollerEnabled:         spec.LogPollerEnabled,
 	}
 }
 
 // BlockhashStoreSpec defines the job parameters for a blockhash store feeder job.
 type BlockhashStoreSpec struct {
-	CoordinatorV1Address  *ethkey.EIP55Address  `json:"coordinatorV1Address"`
-	CoordinatorV2Address  *ethkey.EIP55Address  `json:"coordinatorV2Address"`
-	WaitBlocks            int32                 `json:"waitBlocks"`
-	LookbackBlocks        int32                 `json:"lookbackBlocks"`
-	BlockhashStoreAddress ethkey.EIP55Address   `json:"blockhashStoreAddress"`
-	PollPeriod            time.Duration         `json:"pollPeriod"`
-	RunTimeout            time.Duration         `json:"runTimeout"`
-	EVMChainID            *utils.Big            `json:"evmChainID"`
-	FromAddresses         []ethkey.EIP55Address `json:"fromAddresses"`
-	CreatedAt             time.Time             `json:"createdAt"`
-	UpdatedAt             time.Time             `json:"updatedAt"`
+	CoordinatorV1Address       *ethkey.EIP55Address           `json:"coordinatorV1Address"`
+	CoordinatorV2Address       *ethkey.EIP55Address           `json:"coordinatorV2Address"`
+	Coordinators               job.BlockhashStoreCoordinators `json:"coordinators"`
+	WaitBlocks                 int32                          `json:"waitBlocks"`
+	LookbackBlocks             int32                          `json:"lookbackBlocks"`
+	BlockhashStoreAddress      ethkey.EIP55Address            `json:"blockhashStoreAddress"`
+	BatchBlockhashStoreAddress *ethkey.EIP55Address           `json:"batchBlockhashStoreAddress"`
+	StoreBlockhashesBatchSize  uint16                         `json:"storeBlockhashesBatchSize"`
+	TrustedBlockhashStores     job.BlockhashStoreTargets      `json:"trustedBlockhashStores"`
+	PollPeriod                 time.Duration                  `json:"pollPeriod"`
+	RunTimeout                 time.Duration                  `json:"runTimeout"`
+	EVMChainID                 *utils.Big                     `json:"evmChainID"`
+	FromAddresses              []ethkey.EIP55Address          `json:"fromAddresses"`
+	CreatedAt                  time.Time                      `json:"createdAt"`
+	UpdatedAt                  time.Time                      `json:"updatedAt"`
 }
 
 // NewBlockhashStoreSpec creates a new BlockhashStoreSpec for the given parameters.
 func NewBlockhashStoreSpec(spec *job.BlockhashStoreSpec) *BlockhashStoreSpec {
 	return &BlockhashStoreSpec{
-		CoordinatorV1Address:  spec.CoordinatorV1Address,
-		CoordinatorV2Address:  spec.CoordinatorV2Address,
-		WaitBlocks:            spec.WaitBlocks,
-		LookbackBlocks:        spec.LookbackBlocks,
-		BlockhashStoreAddress: spec.BlockhashStoreAddress,
-		PollPeriod:            spec.PollPeriod,
-		RunTimeout:            spec.RunTimeout,
-		EVMChainID:            spec.EVMChainID,
-		FromAddresses:         spec.FromAddresses,
+		CoordinatorV1Address:       spec.CoordinatorV1Address,
+		CoordinatorV2Address:       spec.CoordinatorV2Address,
+		Coordinators:               spec.Coordinators,
+		WaitBlocks:                 spec.WaitBlocks,
+		LookbackBlocks:             spec.LookbackBlocks,
+		BlockhashStoreAddress:      spec.BlockhashStoreAddress,
+		BatchBlockhashStoreAddress: spec.BatchBlockhashStoreAddress,
+		StoreBlockhashesBatchSize:  spec.StoreBlockhashesBatchSize,
+		TrustedBlockhashStores:     spec.TrustedBlockhashStores,
+		PollPeriod:                 spec.PollPeriod,
+		RunTimeout:                 spec.RunTimeout,
+		EVMChainID:                 spec.EVMChainID,
+		FromAddresses:              spec.FromAddresses,
 	}
 }
 
@@ -458,6 +482,7 @@ type JobResource struct {
 	SchemaVersion          uint32                  `json:"schemaVersion"`
 	GasLimit               clnull.Uint32           `json:"gasLimit"`
 	ForwardingAllowed      bool                    `json:"forwardingAllowed"`
+	Paused                 bool                    `json:"paused"`
 	MaxTaskDuration        models.Interval         `json:"maxTaskDuration"`
 	ExternalJobID          uuid.UUID               `json:"externalJobID"`
 	DirectRequestSpec      *DirectRequestSpec      `json:"directRequestSpec"`
@@ -485,6 +510,7 @@ func NewJobResource(j job.Job) *JobResource {
 		SchemaVersion:     j.SchemaVersion,
 		GasLimit:          j.GasLimit,
 		ForwardingAllowed: j.ForwardingAllowed,
+		Paused:            j.Paused,
 		MaxTaskDuration:   j.MaxTaskDuration,
 		PipelineSpec:      NewPipelineSpec(j.PipelineSpec),
 		ExternalJobID:     j.ExternalJobID,
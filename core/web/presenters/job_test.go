@@ -110,6 +110,7 @@ func TestJob(t *testing.T) {
 						"fluxMonitorSpec": null,
 						"gasLimit": 1000,
 						"forwardingAllowed": false,
+						"paused": false,
 						"keeperSpec": null,
                         "cronSpec": null,
                         "vrfSpec": null,
@@ -128,8 +129,11 @@ func TestJob(t *testing.T) {
 			job: job.Job{
 				ID: 1,
 				FluxMonitorSpec: &job.FluxMonitorSpec{
-					ContractAddress:   contractAddress,
-					Threshold:         0.5,
+					ContractAddress: contractAddress,
+					Threshold:       0.5,
+					DeviationTiers: job.FluxMonitorDeviationTiers{
+						{Threshold: 0.25, Days: []string{"Mon"}, From: "09:30", To: "16:00", Timezone: "America/New_York"},
+					},
 					IdleTimerPeriod:   1 * time.Minute,
 					IdleTimerDisabled: false,
 					PollTimerPeriod:   1 * time.Second,
@@ -169,6 +173,7 @@ func TestJob(t *testing.T) {
 							"contractAddress": "%s",
 							"threshold": 0.5,
 							"absoluteThreshold": 0,
+							"deviationTiers": [{"threshold": 0.25, "absoluteThreshold": 0, "days": ["Mon"], "from": "09:30", "to": "16:00", "timezone": "America/New_York"}],
 							"idleTimerPeriod": "1m0s",
 							"idleTimerDisabled": false,
 							"pollTimerPeriod": "1s",
@@ -176,6 +181,7 @@ func TestJob(t *testing.T) {
               				"drumbeatEnabled": false,
               				"drumbeatRandomDelay": null,
               				"drumbeatSchedule": null,
+							"heartbeatSchedule": null,
 							"minPayment": "1",
 							"createdAt":"2000-01-01T00:00:00Z",
 							"updatedAt":"2000-01-01T00:00:00Z",
@@ -183,6 +189,7 @@ func TestJob(t *testing.T) {
 						},
 						"gasLimit": null,
 						"forwardingAllowed": false,
+						"paused": false,
 						"offChainReportingOracleSpec": null,
 						"offChainReporting2OracleSpec": null,
 						"directRequestSpec": null,
@@ -273,6 +280,7 @@ func TestJob(t *testing.T) {
 						"fluxMonitorSpec": null,
 						"gasLimit": 123,
 						"forwardingAllowed": true,
+						"paused": false,
 						"directRequestSpec": null,
 						"keeperSpec": null,
                         "cronSpec": null,
@@ -334,6 +342,7 @@ func TestJob(t *testing.T) {
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
 						"forwardingAllowed": false,
+						"paused": false,
 						"directRequestSpec": null,
 						"cronSpec": null,
 						"webhookSpec": null,
@@ -355,9 +364,12 @@ func TestJob(t *testing.T) {
 			job: job.Job{
 				ID: 1,
 				CronSpec: &job.CronSpec{
-					CronSchedule: cronSchedule,
-					CreatedAt:    timestamp,
-					UpdatedAt:    timestamp,
+					CronSchedule:  cronSchedule,
+					Timezone:      "America/New_York",
+					OverlapPolicy: job.CronOverlapSkip,
+					CatchUpWindow: 24 * time.Hour,
+					CreatedAt:     timestamp,
+					UpdatedAt:     timestamp,
 				},
 				ExternalJobID: uuid.MustParse("0EEC7E1D-D0D2-476C-A1A8-72DFB6633F46"),
 				PipelineSpec: &pipeline.Spec{
@@ -387,12 +399,16 @@ func TestJob(t *testing.T) {
                         },
                         "cronSpec": {
                             "schedule": "%s",
+                            "timezone": "America/New_York",
+                            "overlapPolicy": "skip",
+                            "catchUpWindow": "24h0m0s",
                             "createdAt":"2000-01-01T00:00:00Z",
                             "updatedAt":"2000-01-01T00:00:00Z"
                         },
                         "fluxMonitorSpec": null,
 						"gasLimit": null,
 						"forwardingAllowed": false,
+						"paused": false,
                         "directRequestSpec": null,
                         "keeperSpec": null,
                         "offChainReportingOracleSpec": null,
@@ -449,6 +465,7 @@ func TestJob(t *testing.T) {
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
 						"forwardingAllowed": false,
+						"paused": false,
 						"directRequestSpec": null,
 						"keeperSpec": null,
 						"cronSpec": null,
@@ -469,16 +486,23 @@ func TestJob(t *testing.T) {
 			job: job.Job{
 				ID: 1,
 				BlockhashStoreSpec: &job.BlockhashStoreSpec{
-					ID:                    1,
-					CoordinatorV1Address:  &v1CoordAddress,
-					CoordinatorV2Address:  &v2CoordAddress,
-					WaitBlocks:            123,
-					LookbackBlocks:        223,
-					BlockhashStoreAddress: contractAddress,
-					PollPeriod:            25 * time.Second,
-					RunTimeout:            10 * time.Second,
-					EVMChainID:            utils.NewBigI(4),
-					FromAddresses:         []ethkey.EIP55Address{fromAddress},
+					ID:                   1,
+					CoordinatorV1Address: &v1CoordAddress,
+					CoordinatorV2Address: &v2CoordAddress,
+					Coordinators: job.BlockhashStoreCoordinators{
+						{Address: v2CoordAddress, Version: "v2"},
+					},
+					WaitBlocks:                123,
+					LookbackBlocks:            223,
+					BlockhashStoreAddress:     contractAddress,
+					StoreBlockhashesBatchSize: 10,
+					PollPeriod:                25 * time.Second,
+					RunTimeout:                10 * time.Second,
+					EVMChainID:                utils.NewBigI(4),
+					FromAddresses:             []ethkey.EIP55Address{fromAddress},
+					TrustedBlockhashStores: job.BlockhashStoreTargets{
+						{EVMChainID: utils.NewBigI(137), Address: batchBHSAddress},
+					},
 				},
 				PipelineSpec: &pipeline.Spec{
 					ID:           1,
@@ -504,6 +528,7 @@ func TestJob(t *testing.T) {
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
 						"forwardingAllowed": false,
+						"paused": false,
 						"cronSpec": null,
 						"offChainReportingOracleSpec": null,
 						"offChainReporting2OracleSpec": null,
@@ -513,9 +538,13 @@ func TestJob(t *testing.T) {
 						"blockhashStoreSpec": {
 							"coordinatorV1Address": "0x16988483b46e695f6c8D58e6e1461DC703e008e1",
 							"coordinatorV2Address": "0x2C409DD6D4eBDdA190B5174Cc19616DD13884262",
+							"coordinators": [{"address": "0x2C409DD6D4eBDdA190B5174Cc19616DD13884262", "version": "v2"}],
 							"waitBlocks": 123,
 							"lookbackBlocks": 223,
 							"blockhashStoreAddress": "0x9E40733cC9df84636505f4e6Db28DCa0dC5D1bba",
+							"batchBlockhashStoreAddress": null,
+							"storeBlockhashesBatchSize": 10,
+							"trustedBlockhashStores": [{"evmChainID": "137", "address": "0xF6bB415b033D19EFf24A872a4785c6e1C4426103", "fromAddresses": null}],
 							"pollPeriod": 25000000000,
 							"runTimeout": 10000000000,
 							"evmChainID": "4",
@@ -579,6 +608,7 @@ func TestJob(t *testing.T) {
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
 						"forwardingAllowed": false,
+						"paused": false,
 						"cronSpec": null,
 						"offChainReportingOracleSpec": null,
 						"offChainReporting2OracleSpec": null,
@@ -648,6 +678,7 @@ func TestJob(t *testing.T) {
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
 						"forwardingAllowed": false,
+						"paused": false,
 						"cronSpec": null,
 						"offChainReportingOracleSpec": null,
 						"offChainReporting2OracleSpec": null,
@@ -712,6 +743,7 @@ func TestJob(t *testing.T) {
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
 						"forwardingAllowed": false,
+						"paused": false,
 						"cronSpec": null,
 						"offChainReportingOracleSpec": null,
 						"offChainReporting2OracleSpec": null,
@@ -796,6 +828,7 @@ func TestJob(t *testing.T) {
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
 						"forwardingAllowed": false,
+						"paused": false,
 						"directRequestSpec": null,
 						"cronSpec": null,
 						"webhookSpec": null,
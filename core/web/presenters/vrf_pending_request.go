@@ -0,0 +1,51 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/vrf"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// VRFPendingRequestResource is a VRF v2 pending request JSONAPI resource.
+type VRFPendingRequestResource struct {
+	JAID
+	JobID              int32       `json:"jobId"`
+	EVMChainID         utils.Big   `json:"evmChainId"`
+	RequestID          utils.Big   `json:"requestId"`
+	SubID              uint64      `json:"subId"`
+	ConfirmedAtBlock   uint64      `json:"confirmedAtBlock"`
+	RequestTxHash      common.Hash `json:"requestTxHash"`
+	RequestBlockNumber uint64      `json:"requestBlockNumber"`
+	Attempts           int         `json:"attempts"`
+	LastTryAt          *time.Time  `json:"lastTryAt"`
+	LastError          *string     `json:"lastError"`
+	CreatedAt          time.Time   `json:"createdAt"`
+	UpdatedAt          time.Time   `json:"updatedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r VRFPendingRequestResource) GetName() string {
+	return "vrf_pending_requests"
+}
+
+// NewVRFPendingRequestResource returns a new VRFPendingRequestResource for the request.
+func NewVRFPendingRequestResource(req vrf.PendingRequestV2) VRFPendingRequestResource {
+	return VRFPendingRequestResource{
+		JAID:               NewJAIDInt64(req.ID),
+		JobID:              req.JobID,
+		EVMChainID:         req.EVMChainID,
+		RequestID:          req.RequestID,
+		SubID:              req.SubID,
+		ConfirmedAtBlock:   req.ConfirmedAtBlock,
+		RequestTxHash:      req.RequestTxHash,
+		RequestBlockNumber: req.RequestBlockNumber,
+		Attempts:           req.Attempts,
+		LastTryAt:          req.LastTryAt.Ptr(),
+		LastError:          req.LastError.Ptr(),
+		CreatedAt:          req.CreatedAt,
+		UpdatedAt:          req.UpdatedAt,
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
 
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
@@ -16,9 +17,11 @@ type ReplayController struct {
 }
 
 // ReplayFromBlock causes the node to process blocks again from the given block number
+// If the "filter" query string parameter is provided, only the logs of that log poller filter are replayed.
 // Example:
 //
 //	"<application>/v2/replay_from_block/:number"
+//	"<application>/v2/replay_from_block/:number?filter=:name"
 func (bdc *ReplayController) ReplayFromBlock(c *gin.Context) {
 	if c.Param("number") == "" {
 		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("missing 'number' parameter"))
@@ -59,7 +62,23 @@ func (bdc *ReplayController) ReplayFromBlock(c *gin.Context) {
 	}
 	chainID := chain.ID()
 
-	if err := bdc.App.ReplayFromBlock(chainID, uint64(blockNumber), force); err != nil {
+	if filter := c.Query("filter"); filter != "" {
+		if force {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("'force' cannot be used with 'filter', which only replays the log poller"))
+			return
+		}
+		if err := bdc.App.ReplayLogPollerFilter(chainID, filter, uint64(blockNumber)); err != nil {
+			switch {
+			case errors.Is(err, logpoller.ErrFilterNotFound):
+				jsonAPIError(c, http.StatusNotFound, err)
+			case errors.Is(err, logpoller.ErrInvalidReplayBlock):
+				jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			default:
+				jsonAPIError(c, http.StatusInternalServerError, err)
+			}
+			return
+		}
+	} else if err := bdc.App.ReplayFromBlock(chainID, uint64(blockNumber), force); err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
@@ -125,6 +125,21 @@ func (r *CronSpecResolver) Schedule() string {
 	return r.spec.CronSchedule
 }
 
+// Timezone resolves the spec's timezone.
+func (r *CronSpecResolver) Timezone() string {
+	return r.spec.Timezone
+}
+
+// OverlapPolicy resolves the spec's overlap policy.
+func (r *CronSpecResolver) OverlapPolicy() string {
+	return string(r.spec.OverlapPolicy)
+}
+
+// CatchUpWindow resolves the spec's catch up window.
+func (r *CronSpecResolver) CatchUpWindow() string {
+	return r.spec.CatchUpWindow.String()
+}
+
 // CreatedAt resolves the spec's created at timestamp.
 func (r *CronSpecResolver) CreatedAt() graphql.Time {
 	return graphql.Time{Time: r.spec.CreatedAt}
@@ -204,6 +219,15 @@ func (r *FluxMonitorSpecResolver) CreatedAt() graphql.Time {
 	return graphql.Time{Time: r.spec.CreatedAt}
 }
 
+// DeviationTiers resolves the spec's deviation tiers.
+func (r *FluxMonitorSpecResolver) DeviationTiers() []*FluxMonitorDeviationTierResolver {
+	resolvers := make([]*FluxMonitorDeviationTierResolver, 0, len(r.spec.DeviationTiers))
+	for _, t := range r.spec.DeviationTiers {
+		resolvers = append(resolvers, &FluxMonitorDeviationTierResolver{tier: t})
+	}
+	return resolvers
+}
+
 // AbsoluteThreshold resolves the spec's absolute threshold.
 func (r *FluxMonitorSpecResolver) DrumbeatEnabled() bool {
 	return r.spec.DrumbeatEnabled
@@ -229,6 +253,15 @@ func (r *FluxMonitorSpecResolver) DrumbeatSchedule() *string {
 	return nil
 }
 
+// HeartbeatSchedule resolves the spec's heartbeat schedule.
+func (r *FluxMonitorSpecResolver) HeartbeatSchedule() *string {
+	if r.spec.HeartbeatSchedule != "" {
+		return &r.spec.HeartbeatSchedule
+	}
+
+	return nil
+}
+
 // EVMChainID resolves the spec's evm chain id.
 func (r *FluxMonitorSpecResolver) EVMChainID() *string {
 	if r.spec.EVMChainID == nil {
@@ -275,6 +308,53 @@ func (r *FluxMonitorSpecResolver) Threshold() float64 {
 	return float64(r.spec.Threshold)
 }
 
+// FluxMonitorDeviationTierResolver exposes a deviation tier of a FluxMonitorSpec.
+type FluxMonitorDeviationTierResolver struct {
+	tier job.FluxMonitorDeviationTier
+}
+
+// AbsoluteThreshold resolves the tier's absolute deviation threshold.
+func (r *FluxMonitorDeviationTierResolver) AbsoluteThreshold() float64 {
+	return float64(r.tier.AbsoluteThreshold)
+}
+
+// Days resolves the days of the week the tier's window starts on.
+func (r *FluxMonitorDeviationTierResolver) Days() []string {
+	if r.tier.Days == nil {
+		return []string{}
+	}
+	return r.tier.Days
+}
+
+// From resolves the time of day the tier's window starts at.
+func (r *FluxMonitorDeviationTierResolver) From() *string {
+	if r.tier.From == "" {
+		return nil
+	}
+	return &r.tier.From
+}
+
+// Threshold resolves the tier's deviation threshold.
+func (r *FluxMonitorDeviationTierResolver) Threshold() float64 {
+	return float64(r.tier.Threshold)
+}
+
+// Timezone resolves the time zone of the tier's window.
+func (r *FluxMonitorDeviationTierResolver) Timezone() *string {
+	if r.tier.Timezone == "" {
+		return nil
+	}
+	return &r.tier.Timezone
+}
+
+// To resolves the time of day the tier's window ends at.
+func (r *FluxMonitorDeviationTierResolver) To() *string {
+	if r.tier.To == "" {
+		return nil
+	}
+	return &r.tier.To
+}
+
 type KeeperSpecResolver struct {
 	spec job.KeeperSpec
 }
@@ -739,6 +819,11 @@ func (r *VRFSpecResolver) VRFOwnerAddress() *string {
 	return &vrfOwnerAddress
 }
 
+// LogPollerEnabled resolves the spec's log poller enabled flag.
+func (r *VRFSpecResolver) LogPollerEnabled() bool {
+	return r.spec.LogPollerEnabled
+}
+
 type WebhookSpecResolver struct {
 	spec job.WebhookSpec
 }
@@ -771,6 +856,15 @@ func (b *BlockhashStoreSpecResolver) CoordinatorV2Address() *string {
 	return &addr
 }
 
+// Coordinators returns the job's Coordinators param.
+func (b *BlockhashStoreSpecResolver) Coordinators() []*BlockhashStoreCoordinatorResolver {
+	resolvers := make([]*BlockhashStoreCoordinatorResolver, 0, len(b.spec.Coordinators))
+	for _, c := range b.spec.Coordinators {
+		resolvers = append(resolvers, &BlockhashStoreCoordinatorResolver{coordinator: c})
+	}
+	return resolvers
+}
+
 // WaitBlocks returns the job's WaitBlocks param.
 func (b *BlockhashStoreSpecResolver) WaitBlocks() int32 {
 	return b.spec.WaitBlocks
@@ -786,6 +880,29 @@ func (b *BlockhashStoreSpecResolver) BlockhashStoreAddress() string {
 	return b.spec.BlockhashStoreAddress.String()
 }
 
+// BatchBlockhashStoreAddress returns the job's BatchBlockhashStoreAddress param, if any.
+func (b *BlockhashStoreSpecResolver) BatchBlockhashStoreAddress() *string {
+	if b.spec.BatchBlockhashStoreAddress == nil {
+		return nil
+	}
+	addr := b.spec.BatchBlockhashStoreAddress.String()
+	return &addr
+}
+
+// StoreBlockhashesBatchSize returns the job's StoreBlockhashesBatchSize param.
+func (b *BlockhashStoreSpecResolver) StoreBlockhashesBatchSize() int32 {
+	return int32(b.spec.StoreBlockhashesBatchSize)
+}
+
+// TrustedBlockhashStores returns the job's TrustedBlockhashStores param.
+func (b *BlockhashStoreSpecResolver) TrustedBlockhashStores() []*BlockhashStoreTargetResolver {
+	resolvers := make([]*BlockhashStoreTargetResolver, 0, len(b.spec.TrustedBlockhashStores))
+	for _, t := range b.spec.TrustedBlockhashStores {
+		resolvers = append(resolvers, &BlockhashStoreTargetResolver{target: t})
+	}
+	return resolvers
+}
+
 // PollPeriod return's the job's PollPeriod param.
 func (b *BlockhashStoreSpecResolver) PollPeriod() string {
 	return b.spec.PollPeriod.String()
@@ -819,6 +936,53 @@ func (b *BlockhashStoreSpecResolver) CreatedAt() graphql.Time {
 	return graphql.Time{Time: b.spec.CreatedAt}
 }
 
+// BlockhashStoreCoordinatorResolver exposes a coordinator watched by a BlockhashStoreSpec.
+type BlockhashStoreCoordinatorResolver struct {
+	coordinator job.BlockhashStoreCoordinator
+}
+
+// Address returns the address of the coordinator.
+func (b *BlockhashStoreCoordinatorResolver) Address() string {
+	return b.coordinator.Address.String()
+}
+
+// Version returns the version of the coordinator.
+func (b *BlockhashStoreCoordinatorResolver) Version() string {
+	return b.coordinator.Version
+}
+
+// BlockhashStoreTargetResolver exposes a trusted blockhash store of a BlockhashStoreSpec.
+type BlockhashStoreTargetResolver struct {
+	target job.BlockhashStoreTarget
+}
+
+// EVMChainID returns the chain ID of the trusted blockhash store.
+func (b *BlockhashStoreTargetResolver) EVMChainID() *string {
+	if b.target.EVMChainID == nil {
+		return nil
+	}
+
+	chainID := b.target.EVMChainID.String()
+	return &chainID
+}
+
+// Address returns the address of the trusted blockhash store.
+func (b *BlockhashStoreTargetResolver) Address() string {
+	return b.target.Address.String()
+}
+
+// FromAddresses returns the addresses to store blockhashes from, if any.
+func (b *BlockhashStoreTargetResolver) FromAddresses() *[]string {
+	if b.target.FromAddresses == nil {
+		return nil
+	}
+	var addresses []string
+	for _, a := range b.target.FromAddresses {
+		addresses = append(addresses, a.Address().String())
+	}
+	return &addresses
+}
+
 // BlockHeaderFeederSpecResolver exposes the job parameters for a BlockHeaderFeederSpec.
 type BlockHeaderFeederSpecResolver struct {
 	spec job.BlockHeaderFeederSpec
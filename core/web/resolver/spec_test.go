@@ -36,8 +36,11 @@ func TestResolver_CronSpec(t *testing.T) {
 				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", id).Return(job.Job{
 					Type: job.Cron,
 					CronSpec: &job.CronSpec{
-						CronSchedule: "CRON_TZ=UTC 0 0 1 1 *",
-						CreatedAt:    f.Timestamp(),
+						CronSchedule:  "0 0 1 1 *",
+						Timezone:      "America/New_York",
+						OverlapPolicy: job.CronOverlapQueueOne,
+						CatchUpWindow: 24 * time.Hour,
+						CreatedAt:     f.Timestamp(),
 					},
 				}, nil)
 			},
@@ -49,6 +52,9 @@ func TestResolver_CronSpec(t *testing.T) {
 								__typename
 								... on CronSpec {
 									schedule
+									timezone
+									overlapPolicy
+									catchUpWindow
 									createdAt
 								}
 							}
@@ -61,7 +67,10 @@ func TestResolver_CronSpec(t *testing.T) {
 					"job": {
 						"spec": {
 							"__typename": "CronSpec",
-							"schedule": "CRON_TZ=UTC 0 0 1 1 *",
+							"schedule": "0 0 1 1 *",
+							"timezone": "America/New_York",
+							"overlapPolicy": "queue-one",
+							"catchUpWindow": "24h0m0s",
 							"createdAt": "2021-01-01T00:00:00Z"
 						}
 					}
@@ -162,6 +171,7 @@ func TestResolver_FluxMonitorSpec(t *testing.T) {
 						CreatedAt:         f.Timestamp(),
 						EVMChainID:        utils.NewBigI(42),
 						DrumbeatEnabled:   false,
+						HeartbeatSchedule: "CRON_TZ=UTC 0 0 * * * *",
 						IdleTimerDisabled: false,
 						IdleTimerPeriod:   time.Duration(1 * time.Hour),
 						MinPayment:        assets.NewLinkFromJuels(1000),
@@ -184,6 +194,7 @@ func TestResolver_FluxMonitorSpec(t *testing.T) {
 									drumbeatRandomDelay
 									drumbeatSchedule
 									evmChainID
+									heartbeatSchedule
 									idleTimerDisabled
 									idleTimerPeriod
 									minPayment
@@ -207,6 +218,7 @@ func TestResolver_FluxMonitorSpec(t *testing.T) {
 							"drumbeatRandomDelay": null,
 							"drumbeatSchedule": null,
 							"evmChainID": "42",
+							"heartbeatSchedule": "CRON_TZ=UTC 0 0 * * * *",
 							"idleTimerDisabled": false,
 							"idleTimerPeriod": "1h0m0s",
 							"minPayment": "1000",
@@ -286,6 +298,75 @@ func TestResolver_FluxMonitorSpec(t *testing.T) {
 				}
 			`,
 		},
+		{
+			name:          "flux monitor spec with deviation tiers",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", id).Return(job.Job{
+					Type: job.FluxMonitor,
+					FluxMonitorSpec: &job.FluxMonitorSpec{
+						ContractAddress: contractAddress,
+						CreatedAt:       f.Timestamp(),
+						Threshold:       2,
+						DeviationTiers: job.FluxMonitorDeviationTiers{
+							{Threshold: 0.5, Days: []string{"Mon", "Fri"}, From: "09:30", To: "16:00", Timezone: "America/New_York"},
+							{Threshold: 1, AbsoluteThreshold: 0.25},
+						},
+					},
+				}, nil)
+			},
+			query: `
+				query GetJob {
+					job(id: "1") {
+						... on Job {
+							spec {
+								__typename
+								... on FluxMonitorSpec {
+									threshold
+									deviationTiers {
+										absoluteThreshold
+										days
+										from
+										threshold
+										timezone
+										to
+									}
+								}
+							}
+						}
+					}
+				}
+			`,
+			result: `
+				{
+					"job": {
+						"spec": {
+							"__typename": "FluxMonitorSpec",
+							"threshold": 2,
+							"deviationTiers": [
+								{
+									"absoluteThreshold": 0,
+									"days": ["Mon", "Fri"],
+									"from": "09:30",
+									"threshold": 0.5,
+									"timezone": "America/New_York",
+									"to": "16:00"
+								},
+								{
+									"absoluteThreshold": 0.25,
+									"days": [],
+									"from": null,
+									"threshold": 1,
+									"timezone": null,
+									"to": null
+								}
+							]
+						}
+					}
+				}
+			`,
+		},
 	}
 
 	RunGQLTests(t, testCases)
@@ -622,6 +703,7 @@ func TestResolver_VRFSpec(t *testing.T) {
 						BackoffInitialDelay:           time.Minute,
 						BackoffMaxDelay:               time.Hour,
 						GasLanePrice:                  assets.GWei(200),
+						LogPollerEnabled:              true,
 					},
 				}, nil)
 			},
@@ -648,6 +730,7 @@ func TestResolver_VRFSpec(t *testing.T) {
 									backoffInitialDelay
 									backoffMaxDelay
 									gasLanePrice
+									logPollerEnabled
 								}
 							}
 						}
@@ -674,7 +757,8 @@ func TestResolver_VRFSpec(t *testing.T) {
 							"chunkSize": 25,
 							"backoffInitialDelay": "1m0s",
 							"backoffMaxDelay": "1h0m0s",
-							"gasLanePrice": "200 gwei"
+							"gasLanePrice": "200 gwei",
+							"logPollerEnabled": true
 						}
 					}
 				}
@@ -752,6 +836,9 @@ func TestResolver_BlockhashStoreSpec(t *testing.T) {
 	blockhashStoreAddress, err := ethkey.NewEIP55Address("0xb26A6829D454336818477B946f03Fb21c9706f3A")
 	require.NoError(t, err)
 
+	batchBlockhashStoreAddress, err := ethkey.NewEIP55Address("0xd23BAE30019853Caf1D08b4C03291b10AD7743Df")
+	require.NoError(t, err)
+
 	testCases := []GQLTestCase{
 		{
 			name:          "blockhash store spec",
@@ -761,16 +848,24 @@ func TestResolver_BlockhashStoreSpec(t *testing.T) {
 				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", id).Return(job.Job{
 					Type: job.BlockhashStore,
 					BlockhashStoreSpec: &job.BlockhashStoreSpec{
-						CoordinatorV1Address:  &coordinatorV1Address,
-						CoordinatorV2Address:  &coordinatorV2Address,
-						CreatedAt:             f.Timestamp(),
-						EVMChainID:            utils.NewBigI(42),
-						FromAddresses:         []ethkey.EIP55Address{fromAddress1, fromAddress2},
-						PollPeriod:            1 * time.Minute,
-						RunTimeout:            37 * time.Second,
-						WaitBlocks:            100,
-						LookbackBlocks:        200,
-						BlockhashStoreAddress: blockhashStoreAddress,
+						CoordinatorV1Address: &coordinatorV1Address,
+						CoordinatorV2Address: &coordinatorV2Address,
+						Coordinators: job.BlockhashStoreCoordinators{
+							{Address: coordinatorV2Address, Version: "v2"},
+						},
+						CreatedAt:                  f.Timestamp(),
+						EVMChainID:                 utils.NewBigI(42),
+						FromAddresses:              []ethkey.EIP55Address{fromAddress1, fromAddress2},
+						PollPeriod:                 1 * time.Minute,
+						RunTimeout:                 37 * time.Second,
+						WaitBlocks:                 100,
+						LookbackBlocks:             200,
+						BlockhashStoreAddress:      blockhashStoreAddress,
+						BatchBlockhashStoreAddress: &batchBlockhashStoreAddress,
+						StoreBlockhashesBatchSize:  10,
+						TrustedBlockhashStores: job.BlockhashStoreTargets{
+							{EVMChainID: utils.NewBigI(137), Address: batchBlockhashStoreAddress, FromAddresses: []ethkey.EIP55Address{fromAddress2}},
+						},
 					},
 				}, nil)
 			},
@@ -783,6 +878,10 @@ func TestResolver_BlockhashStoreSpec(t *testing.T) {
 								... on BlockhashStoreSpec {
 									coordinatorV1Address
 									coordinatorV2Address
+									coordinators {
+										address
+										version
+									}
 									createdAt
 									evmChainID
 									fromAddresses
@@ -791,6 +890,13 @@ func TestResolver_BlockhashStoreSpec(t *testing.T) {
 									waitBlocks
 									lookbackBlocks
 									blockhashStoreAddress
+									batchBlockhashStoreAddress
+									storeBlockhashesBatchSize
+									trustedBlockhashStores {
+										evmChainID
+										address
+										fromAddresses
+									}
 								}
 							}
 						}
@@ -804,6 +910,7 @@ func TestResolver_BlockhashStoreSpec(t *testing.T) {
 							"__typename": "BlockhashStoreSpec",
 							"coordinatorV1Address": "0x613a38AC1659769640aaE063C651F48E0250454C",
 							"coordinatorV2Address": "0x2fcA960AF066cAc46085588a66dA2D614c7Cd337",
+							"coordinators": [{"address": "0x2fcA960AF066cAc46085588a66dA2D614c7Cd337", "version": "v2"}],
 							"createdAt": "2021-01-01T00:00:00Z",
 							"evmChainID": "42",
 							"fromAddresses": ["0x3cCad4715152693fE3BC4460591e3D3Fbd071b42", "0xD479d7c994D298cA05bF270136ED9627b7E684D3"],
@@ -811,7 +918,10 @@ func TestResolver_BlockhashStoreSpec(t *testing.T) {
 							"runTimeout": "37s",
 							"waitBlocks": 100,
 							"lookbackBlocks": 200,
-							"blockhashStoreAddress": "0xb26A6829D454336818477B946f03Fb21c9706f3A"
+							"blockhashStoreAddress": "0xb26A6829D454336818477B946f03Fb21c9706f3A",
+							"batchBlockhashStoreAddress": "0xd23BAE30019853Caf1D08b4C03291b10AD7743Df",
+							"storeBlockhashesBatchSize": 10,
+							"trustedBlockhashStores": [{"evmChainID": "137", "address": "0xd23BAE30019853Caf1D08b4C03291b10AD7743Df", "fromAddresses": ["0xD479d7c994D298cA05bF270136ED9627b7E684D3"]}]
 						}
 					}
 				}
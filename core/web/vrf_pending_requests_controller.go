@@ -0,0 +1,36 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/vrf"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// VRFPendingRequestsController lists the requests VRF v2 jobs have not fulfilled yet.
+type VRFPendingRequestsController struct {
+	App chainlink.Application
+}
+
+// Index lists VRF v2 pending requests, along with why their last fulfillment attempt failed.
+// Example:
+//
+//	"<application>/vrf/pending_requests"
+func (vc *VRFPendingRequestsController) Index(c *gin.Context, size, page, offset int) {
+	orm := vrf.NewPendingRequestsORM(vc.App.GetSqlxDB(), vc.App.GetLogger(), vc.App.GetConfig().Database())
+	reqs, count, err := orm.FindPendingRequests(offset, size)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	var resources []presenters.VRFPendingRequestResource
+	for _, req := range reqs {
+		resources = append(resources, presenters.NewVRFPendingRequestResource(req))
+	}
+
+	paginatedResponse(c, "vrf_pending_requests", size, page, resources, count, err)
+}
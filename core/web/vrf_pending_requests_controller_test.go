@@ -0,0 +1,60 @@
+package web_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/vrf"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestVRFPendingRequestsController_Index(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+
+	jb, _ := cltest.MustInsertWebhookSpec(t, app.GetSqlxDB())
+	orm := vrf.NewPendingRequestsORM(app.GetSqlxDB(), logger.TestLogger(t), app.GetConfig().Database())
+	for i := int64(1); i <= 3; i++ {
+		require.NoError(t, orm.UpsertPendingRequest(&vrf.PendingRequestV2{
+			JobID:              jb.ID,
+			EVMChainID:         *utils.NewBig(testutils.FixtureChainID),
+			RequestID:          *utils.NewBigI(i),
+			SubID:              1,
+			ConfirmedAtBlock:   10,
+			RequestTxHash:      testutils.Random32Byte(),
+			RequestBlockNumber: 7,
+			RawLog:             []byte(`{}`),
+		}))
+	}
+
+	resp, cleanup := client.Get("/v2/vrf/pending_requests?size=2")
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	body := cltest.ParseResponseBody(t, resp)
+	metaCount, err := cltest.ParseJSONAPIResponseMetaCount(body)
+	require.NoError(t, err)
+	assert.Equal(t, 3, metaCount)
+
+	var links jsonapi.Links
+	var reqs []presenters.VRFPendingRequestResource
+	require.NoError(t, web.ParsePaginatedResponse(body, &reqs, &links))
+	assert.NotEmpty(t, links["next"].Href)
+	require.Len(t, reqs, 2)
+	assert.Equal(t, jb.ID, reqs[0].JobID)
+	assert.Equal(t, "1", reqs[0].RequestID.String())
+	assert.Equal(t, uint64(10), reqs[0].ConfirmedAtBlock)
+	assert.Nil(t, reqs[0].LastError)
+}